@@ -343,6 +343,37 @@ kubeapps: ingress.tls
 {{- end -}}
 {{- end -}}
 
+{{/*
+Returns the rules needed to list the installed packages of the enabled packaging plugins,
+with which the notifications of the new versions are checked.
+*/}}
+{{- define "kubeapps.kubeappsapis.notificationsReadRules" -}}
+{{- if .Values.packaging.helm.enabled }}
+# needed by the helm plug-in to list the releases
+- apiGroups: [""]
+  resources: ["secrets"]
+  verbs: ["get", "list"]
+{{- end }}
+{{- if .Values.packaging.flux.enabled }}
+# needed by the fluxv2 plug-in to list the releases and their charts
+- apiGroups: ["helm.toolkit.fluxcd.io"]
+  resources: ["helmreleases"]
+  verbs: ["get", "list"]
+- apiGroups: ["source.toolkit.fluxcd.io"]
+  resources: ["helmcharts"]
+  verbs: ["get"]
+{{- end }}
+{{- if .Values.packaging.carvel.enabled }}
+# needed by the kapp-controller plug-in to list the package installs and their packages
+- apiGroups: ["packaging.carvel.dev"]
+  resources: ["packageinstalls"]
+  verbs: ["get", "list"]
+- apiGroups: ["data.packaging.carvel.dev"]
+  resources: ["packages", "packagemetadatas"]
+  verbs: ["get", "list"]
+{{- end }}
+{{- end -}}
+
 {{/*
 # Calculate the kubeappsapis enabledPlugins.
 */}}
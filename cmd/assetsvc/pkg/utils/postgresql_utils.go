@@ -5,13 +5,16 @@ package utils
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	_ "github.com/lib/pq"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"github.com/vmware-tanzu/kubeapps/pkg/dbutils"
+	"github.com/vmware-tanzu/kubeapps/pkg/versionfilter"
 )
 
 // TODO(mnelson): standardise error API for package.
@@ -33,6 +36,9 @@ func NewPGManager(config dbutils.Config, globalReposNamespace string) (AssetMana
 }
 
 func (m *PostgresAssetManager) GetAllChartCategories(cq ChartQuery) ([]*models.ChartCategory, error) {
+	if cq.hasVersionConstraints() {
+		return m.countChartsMatchingVersionConstraints(cq, chartCategory)
+	}
 	whereQuery, whereQueryParams := m.GenerateWhereClause(cq)
 	dbQuery := fmt.Sprintf("SELECT (info ->> 'category') AS name, COUNT( (info ->> 'category')) AS count FROM %s %s GROUP BY (info ->> 'category') ORDER BY (info ->> 'category') ASC", dbutils.ChartTable, whereQuery)
 
@@ -43,7 +49,39 @@ func (m *PostgresAssetManager) GetAllChartCategories(cq ChartQuery) ([]*models.C
 	return chartsCategories, nil
 }
 
+// GetAllChartRepos returns the number of charts matching the query in each
+// repository, as name and count pairs.
+func (m *PostgresAssetManager) GetAllChartRepos(cq ChartQuery) ([]*models.ChartCategory, error) {
+	if cq.hasVersionConstraints() {
+		return m.countChartsMatchingVersionConstraints(cq, chartRepoName)
+	}
+	whereQuery, whereQueryParams := m.GenerateWhereClause(cq)
+	dbQuery := fmt.Sprintf("SELECT repo_name AS name, COUNT(repo_name) AS count FROM %s %s GROUP BY repo_name ORDER BY repo_name ASC", dbutils.ChartTable, whereQuery)
+
+	chartsRepos, err := m.QueryAllChartCategories(dbQuery, whereQueryParams...)
+	if err != nil {
+		return nil, err
+	}
+	return chartsRepos, nil
+}
+
 func (m *PostgresAssetManager) GetPaginatedChartList(whereQuery string, whereQueryParams []interface{}, startItemNumber, pageSize int) ([]*models.Chart, error) {
+	return m.getOrderedPaginatedChartList(whereQuery, whereQueryParams, OrderByName, startItemNumber, pageSize)
+}
+
+// orderByClause returns the ORDER BY clause for the given order of the chart list.
+func orderByClause(orderBy string) string {
+	switch orderBy {
+	case OrderByLastUpdated:
+		return "ORDER BY (info->'chartVersions'->0->>'created')::timestamptz DESC NULLS LAST, (info->>'name') ASC"
+	case OrderByRepo:
+		return "ORDER BY repo_name ASC, (info->>'name') ASC"
+	default:
+		return "ORDER BY (info->>'name') ASC"
+	}
+}
+
+func (m *PostgresAssetManager) getOrderedPaginatedChartList(whereQuery string, whereQueryParams []interface{}, orderBy string, startItemNumber, pageSize int) ([]*models.Chart, error) {
 	paginationClause := ""
 	if pageSize > 0 {
 		paginationClause = fmt.Sprintf("LIMIT %d", pageSize)
@@ -52,7 +90,7 @@ func (m *PostgresAssetManager) GetPaginatedChartList(whereQuery string, whereQue
 		paginationClause = fmt.Sprintf("%s OFFSET %d", paginationClause, startItemNumber)
 	}
 
-	dbQuery := fmt.Sprintf("SELECT info FROM %s %s ORDER BY (info->>'name') ASC %s", dbutils.ChartTable, whereQuery, paginationClause)
+	dbQuery := fmt.Sprintf("SELECT info FROM %s %s %s %s", dbutils.ChartTable, whereQuery, orderByClause(orderBy), paginationClause)
 	charts, err := m.QueryAllCharts(dbQuery, whereQueryParams...)
 	if err != nil {
 		return nil, err
@@ -173,14 +211,134 @@ func (m *PostgresAssetManager) GetChartFilesWithFallback(namespace, filesID stri
 }
 
 func (m *PostgresAssetManager) GetPaginatedChartListWithFilters(cq ChartQuery, startItemNumber, pageSize int) ([]*models.Chart, error) {
+	if cq.hasVersionConstraints() {
+		return m.getPaginatedChartListMatchingVersionConstraints(cq, startItemNumber, pageSize)
+	}
 	whereQuery, whereQueryParams := m.GenerateWhereClause(cq)
-	charts, err := m.GetPaginatedChartList(whereQuery, whereQueryParams, startItemNumber, pageSize)
+	charts, err := m.getOrderedPaginatedChartList(whereQuery, whereQueryParams, cq.OrderBy, startItemNumber, pageSize)
 	if err != nil {
 		return nil, err
 	}
 	return charts, nil
 }
 
+// getChartsMatchingVersionConstraints returns every chart matching the query,
+// with only the chart versions matching its version constraints. Semver
+// constraints cannot be evaluated by Postgres, so the charts matching the rest
+// of the query are filtered here instead.
+func (m *PostgresAssetManager) getChartsMatchingVersionConstraints(cq ChartQuery) ([]*models.Chart, error) {
+	whereQuery, whereQueryParams := m.GenerateWhereClause(cq)
+	charts, err := m.getOrderedPaginatedChartList(whereQuery, whereQueryParams, cq.OrderBy, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkgVersion, appVersion := versionfilter.New(cq.VersionConstraint), versionfilter.New(cq.AppVersionConstraint)
+	matchingCharts := []*models.Chart{}
+	for _, chart := range charts {
+		chart.ChartVersions = versionfilter.ChartVersions(chart.ChartVersions, pkgVersion, appVersion)
+		if len(chart.ChartVersions) > 0 {
+			matchingCharts = append(matchingCharts, chart)
+		}
+	}
+	// The latest matching version may not be the latest version of the chart.
+	if cq.OrderBy == OrderByLastUpdated {
+		sort.SliceStable(matchingCharts, func(i, j int) bool {
+			return matchingCharts[i].ChartVersions[0].Created.After(matchingCharts[j].ChartVersions[0].Created)
+		})
+	}
+	return matchingCharts, nil
+}
+
+// GetPaginatedChartListWithFacets returns a page of the charts matching the
+// query together with the facets of every matching chart. When the query has
+// version constraints, the charts are loaded only once for both.
+func (m *PostgresAssetManager) GetPaginatedChartListWithFacets(cq ChartQuery, startItemNumber, pageSize int) ([]*models.Chart, ChartFacets, error) {
+	if !cq.hasVersionConstraints() {
+		charts, err := m.GetPaginatedChartListWithFilters(cq, startItemNumber, pageSize)
+		if err != nil {
+			return nil, ChartFacets{}, err
+		}
+		categories, err := m.GetAllChartCategories(cq)
+		if err != nil {
+			return nil, ChartFacets{}, err
+		}
+		repos, err := m.GetAllChartRepos(cq)
+		if err != nil {
+			return nil, ChartFacets{}, err
+		}
+		return charts, ChartFacets{Categories: categories, Repos: repos}, nil
+	}
+
+	charts, err := m.getChartsMatchingVersionConstraints(cq)
+	if err != nil {
+		return nil, ChartFacets{}, err
+	}
+	facets := ChartFacets{
+		Categories: countCharts(charts, chartCategory),
+		Repos:      countCharts(charts, chartRepoName),
+	}
+	return paginateCharts(charts, startItemNumber, pageSize), facets, nil
+}
+
+func (m *PostgresAssetManager) getPaginatedChartListMatchingVersionConstraints(cq ChartQuery, startItemNumber, pageSize int) ([]*models.Chart, error) {
+	charts, err := m.getChartsMatchingVersionConstraints(cq)
+	if err != nil {
+		return nil, err
+	}
+	return paginateCharts(charts, startItemNumber, pageSize), nil
+}
+
+// paginateCharts returns the page of the charts starting at startItemNumber,
+// or every chart from there if pageSize is 0.
+func paginateCharts(charts []*models.Chart, startItemNumber, pageSize int) []*models.Chart {
+	if startItemNumber >= len(charts) {
+		return []*models.Chart{}
+	}
+	charts = charts[startItemNumber:]
+	if pageSize > 0 && len(charts) > pageSize {
+		charts = charts[:pageSize]
+	}
+	return charts
+}
+
+func chartCategory(chart *models.Chart) string {
+	return chart.Category
+}
+
+func chartRepoName(chart *models.Chart) string {
+	if chart.Repo == nil {
+		return ""
+	}
+	return chart.Repo.Name
+}
+
+// countChartsMatchingVersionConstraints returns the number of charts matching
+// the query for each value of key, sorted by value.
+func (m *PostgresAssetManager) countChartsMatchingVersionConstraints(cq ChartQuery, key func(*models.Chart) string) ([]*models.ChartCategory, error) {
+	charts, err := m.getChartsMatchingVersionConstraints(cq)
+	if err != nil {
+		return nil, err
+	}
+	return countCharts(charts, key), nil
+}
+
+// countCharts returns the number of charts for each value of key, sorted by
+// value.
+func countCharts(charts []*models.Chart, key func(*models.Chart) string) []*models.ChartCategory {
+	countsByName := map[string]int{}
+	for _, chart := range charts {
+		countsByName[key(chart)]++
+	}
+	counts := []*models.ChartCategory{}
+	for name, count := range countsByName {
+		counts = append(counts, &models.ChartCategory{Name: name, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Name < counts[j].Name
+	})
+	return counts
+}
+
 func (m *PostgresAssetManager) GenerateWhereClause(cq ChartQuery) (string, []interface{}) {
 	whereClauses := []string{}
 	whereQueryParams := []interface{}{}
@@ -198,9 +356,14 @@ func (m *PostgresAssetManager) GenerateWhereClause(cq ChartQuery) (string, []int
 			"(info->>'name' = $%d)", len(whereQueryParams),
 		))
 	}
-	if cq.Version != "" && cq.AppVersion != "" {
-		parametrizedJsonbLiteral := fmt.Sprintf(`[{"version":"%s","app_version":"%s"}]`, cq.Version, cq.AppVersion)
-		whereQueryParams = append(whereQueryParams, parametrizedJsonbLiteral)
+	if cq.Version != "" || cq.AppVersion != "" {
+		// Only the given fields are compared, so that either version can be
+		// matched on its own.
+		parametrizedJsonbLiteral, _ := json.Marshal([]struct {
+			Version    string `json:"version,omitempty"`
+			AppVersion string `json:"app_version,omitempty"`
+		}{{cq.Version, cq.AppVersion}})
+		whereQueryParams = append(whereQueryParams, string(parametrizedJsonbLiteral))
 		whereClauses = append(whereClauses, fmt.Sprintf("(info->'chartVersions' @> $%d::jsonb)", len(whereQueryParams)))
 	}
 
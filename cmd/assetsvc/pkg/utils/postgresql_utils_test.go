@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -273,6 +274,243 @@ func Test_GetAllChartCategories(t *testing.T) {
 		})
 	}
 }
+func Test_GetAllChartRepos(t *testing.T) {
+	pgManager, mock, cleanup := getMockManager(t)
+	defer cleanup()
+
+	expectedChartRepos := []*models.ChartCategory{
+		{Name: "bitnami", Count: 2},
+		{Name: "other-repo", Count: 1},
+	}
+	rows := sqlmock.NewRows([]string{"name", "count"})
+	for _, chartRepo := range expectedChartRepos {
+		rows.AddRow(chartRepo.Name, chartRepo.Count)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT repo_name AS name, COUNT(repo_name) AS count FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (info->>'category' = $3) GROUP BY repo_name")).
+		WithArgs("other-namespace", "kubeapps", "cat1").
+		WillReturnRows(rows)
+
+	chartRepos, err := pgManager.GetAllChartRepos(ChartQuery{Namespace: "other-namespace", Categories: []string{"cat1"}})
+	if err != nil {
+		t.Fatalf("Found error %v", err)
+	}
+	if !cmp.Equal(chartRepos, expectedChartRepos) {
+		t.Errorf("Unexpected result %v", cmp.Diff(chartRepos, expectedChartRepos))
+	}
+}
+
+func Test_GetChartsWithFilters_orderBy(t *testing.T) {
+	tests := []struct {
+		name          string
+		orderBy       string
+		expectedOrder string
+	}{
+		{
+			name:          "orders by name by default",
+			orderBy:       OrderByName,
+			expectedOrder: "ORDER BY (info->>'name') ASC",
+		},
+		{
+			name:          "orders by the latest version creation date",
+			orderBy:       OrderByLastUpdated,
+			expectedOrder: "ORDER BY (info->'chartVersions'->0->>'created')::timestamptz DESC NULLS LAST, (info->>'name') ASC",
+		},
+		{
+			name:          "orders by repository",
+			orderBy:       OrderByRepo,
+			expectedOrder: "ORDER BY repo_name ASC, (info->>'name') ASC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pgManager, mock, cleanup := getMockManager(t)
+			defer cleanup()
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) "+tt.expectedOrder+" LIMIT 10")).
+				WithArgs("namespace", "kubeapps").
+				WillReturnRows(sqlmock.NewRows([]string{"info"}))
+
+			_, err := pgManager.GetPaginatedChartListWithFilters(ChartQuery{Namespace: "namespace", OrderBy: tt.orderBy}, 0, 10)
+			if err != nil {
+				t.Errorf("Found error %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("%+v", err)
+			}
+		})
+	}
+}
+
+func Test_GetChartsWithFilters_versionConstraints(t *testing.T) {
+	postgresql := models.Chart{
+		Name: "postgresql",
+		Repo: &models.Repo{Name: "bitnami"},
+		ChartVersions: []models.ChartVersion{
+			{Version: "11.0.0", AppVersion: "14.4.0"},
+			{Version: "10.1.0", AppVersion: "11.14.0"},
+			{Version: "10.0.0", AppVersion: "11.13.0"},
+		},
+	}
+	wordpress := models.Chart{
+		Name: "wordpress",
+		Repo: &models.Repo{Name: "bitnami"},
+		ChartVersions: []models.ChartVersion{
+			{Version: "15.0.0", AppVersion: "14.0.0"},
+		},
+	}
+	keycloak := models.Chart{
+		Name: "keycloak",
+		Repo: &models.Repo{Name: "other-repo"},
+		ChartVersions: []models.ChartVersion{
+			{Version: "9.0.0", AppVersion: "18.0.0"},
+			{Version: "2.0.0", AppVersion: "11.0.0"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		cq             ChartQuery
+		startItem      int
+		pageSize       int
+		expectedCharts []*models.Chart
+	}{
+		{
+			name: "returns the charts with matching app versions, with only those versions",
+			cq:   ChartQuery{Namespace: "namespace", AppVersionConstraint: "<12"},
+			expectedCharts: []*models.Chart{
+				{Name: "postgresql", Repo: postgresql.Repo, ChartVersions: postgresql.ChartVersions[1:]},
+				{Name: "keycloak", Repo: keycloak.Repo, ChartVersions: keycloak.ChartVersions[1:]},
+			},
+		},
+		{
+			name: "returns the charts matching both constraints",
+			cq:   ChartQuery{Namespace: "namespace", VersionConstraint: "~10.1", AppVersionConstraint: "<12"},
+			expectedCharts: []*models.Chart{
+				{Name: "postgresql", Repo: postgresql.Repo, ChartVersions: postgresql.ChartVersions[1:2]},
+			},
+		},
+		{
+			name:      "paginates the matching charts",
+			cq:        ChartQuery{Namespace: "namespace", AppVersionConstraint: "<12"},
+			startItem: 1,
+			pageSize:  1,
+			expectedCharts: []*models.Chart{
+				{Name: "keycloak", Repo: keycloak.Repo, ChartVersions: keycloak.ChartVersions[1:]},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pgManager, mock, cleanup := getMockManager(t)
+			defer cleanup()
+
+			rows := sqlmock.NewRows([]string{"info"})
+			for _, chart := range []models.Chart{postgresql, wordpress, keycloak} {
+				chartJSON, err := json.Marshal(chart)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				rows.AddRow(chartJSON)
+			}
+			// The whole list is requested, as the constraints are matched
+			// once the charts are retrieved.
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY (info->>'name') ASC ")).
+				WithArgs("namespace", "kubeapps").
+				WillReturnRows(rows)
+
+			charts, err := pgManager.GetPaginatedChartListWithFilters(tt.cq, tt.startItem, tt.pageSize)
+			if err != nil {
+				t.Fatalf("Found error %v", err)
+			}
+			if !cmp.Equal(charts, tt.expectedCharts) {
+				t.Errorf("Unexpected result %v", cmp.Diff(charts, tt.expectedCharts))
+			}
+		})
+	}
+}
+
+func Test_GetAllChartRepos_versionConstraints(t *testing.T) {
+	pgManager, mock, cleanup := getMockManager(t)
+	defer cleanup()
+
+	rows := sqlmock.NewRows([]string{"info"})
+	for _, chart := range []models.Chart{
+		{Name: "keycloak", Repo: &models.Repo{Name: "other-repo"}, ChartVersions: []models.ChartVersion{{Version: "2.0.0", AppVersion: "11.0.0"}}},
+		{Name: "postgresql", Repo: &models.Repo{Name: "bitnami"}, ChartVersions: []models.ChartVersion{{Version: "10.1.0", AppVersion: "11.14.0"}}},
+		{Name: "wordpress", Repo: &models.Repo{Name: "bitnami"}, ChartVersions: []models.ChartVersion{{Version: "15.0.0", AppVersion: "6.0.0"}}},
+	} {
+		chartJSON, err := json.Marshal(chart)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		rows.AddRow(chartJSON)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2)")).
+		WithArgs("namespace", "kubeapps").
+		WillReturnRows(rows)
+
+	chartRepos, err := pgManager.GetAllChartRepos(ChartQuery{Namespace: "namespace", AppVersionConstraint: ">=11"})
+	if err != nil {
+		t.Fatalf("Found error %v", err)
+	}
+	expectedChartRepos := []*models.ChartCategory{
+		{Name: "bitnami", Count: 1},
+		{Name: "other-repo", Count: 1},
+	}
+	if !cmp.Equal(chartRepos, expectedChartRepos) {
+		t.Errorf("Unexpected result %v", cmp.Diff(chartRepos, expectedChartRepos))
+	}
+}
+
+func Test_GetPaginatedChartListWithFacets_versionConstraints(t *testing.T) {
+	pgManager, mock, cleanup := getMockManager(t)
+	defer cleanup()
+
+	rows := sqlmock.NewRows([]string{"info"})
+	for _, chart := range []models.Chart{
+		{Name: "keycloak", Category: "Security", Repo: &models.Repo{Name: "other-repo"}, ChartVersions: []models.ChartVersion{{Version: "2.0.0", AppVersion: "11.0.0"}}},
+		{Name: "postgresql", Category: "Database", Repo: &models.Repo{Name: "bitnami"}, ChartVersions: []models.ChartVersion{{Version: "10.1.0", AppVersion: "11.14.0"}}},
+		{Name: "wordpress", Category: "CMS", Repo: &models.Repo{Name: "bitnami"}, ChartVersions: []models.ChartVersion{{Version: "15.0.0", AppVersion: "6.0.0"}}},
+	} {
+		chartJSON, err := json.Marshal(chart)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		rows.AddRow(chartJSON)
+	}
+	// The charts are queried only once for both the page and the facets.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY (info->>'name') ASC ")).
+		WithArgs("namespace", "kubeapps").
+		WillReturnRows(rows)
+
+	charts, facets, err := pgManager.GetPaginatedChartListWithFacets(ChartQuery{Namespace: "namespace", AppVersionConstraint: ">=11"}, 0, 1)
+	if err != nil {
+		t.Fatalf("Found error %v", err)
+	}
+	expectedCharts := []*models.Chart{
+		{Name: "keycloak", Category: "Security", Repo: &models.Repo{Name: "other-repo"}, ChartVersions: []models.ChartVersion{{Version: "2.0.0", AppVersion: "11.0.0"}}},
+	}
+	if !cmp.Equal(charts, expectedCharts) {
+		t.Errorf("Unexpected result %v", cmp.Diff(charts, expectedCharts))
+	}
+	expectedFacets := ChartFacets{
+		Categories: []*models.ChartCategory{
+			{Name: "Database", Count: 1},
+			{Name: "Security", Count: 1},
+		},
+		Repos: []*models.ChartCategory{
+			{Name: "bitnami", Count: 1},
+			{Name: "other-repo", Count: 1},
+		},
+	}
+	if !cmp.Equal(facets, expectedFacets) {
+		t.Errorf("Unexpected result %v", cmp.Diff(facets, expectedFacets))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func Test_GetPaginatedChartList(t *testing.T) {
 	availableCharts := []*models.Chart{
 		{ID: "bar", ChartVersions: []models.ChartVersion{{Digest: "456"}}},
@@ -473,8 +711,8 @@ func Test_GenerateWhereClause(t *testing.T) {
 			repos:          []string{""},
 			categories:     []string{""},
 			query:          "",
-			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2)", //needs both version and appVersion
-			expectedParams: []interface{}{string(""), string("kubeapps")},
+			expectedClause: `WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (info->'chartVersions' @> $3::jsonb)`,
+			expectedParams: []interface{}{string(""), string("kubeapps"), string(`[{"version":"1.0.0"}]`)},
 		},
 		{
 			name:           "returns where clause - single param - appVersion",
@@ -485,8 +723,8 @@ func Test_GenerateWhereClause(t *testing.T) {
 			repos:          []string{""},
 			categories:     []string{""},
 			query:          "",
-			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2)", //needs both version and appVersion
-			expectedParams: []interface{}{string(""), string("kubeapps")},
+			expectedClause: `WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (info->'chartVersions' @> $3::jsonb)`,
+			expectedParams: []interface{}{string(""), string("kubeapps"), string(`[{"app_version":"0.1.0"}]`)},
 		},
 		{
 			name:           "returns where clause - single param - version AND appVersion",
@@ -16,17 +16,48 @@ type AssetManager interface {
 	GetChartFiles(namespace, filesID string) (models.ChartFiles, error)
 	GetPaginatedChartListWithFilters(cq ChartQuery, startItemNumber, pageSize int) ([]*models.Chart, error)
 	GetAllChartCategories(cq ChartQuery) ([]*models.ChartCategory, error)
+	GetAllChartRepos(cq ChartQuery) ([]*models.ChartCategory, error)
+	GetPaginatedChartListWithFacets(cq ChartQuery, startItemNumber, pageSize int) ([]*models.Chart, ChartFacets, error)
 }
 
-// ChartQuery is a container for passing the supported query parameters for generating the WHERE query
+// ChartFacets are the number of charts matching a query for each category and
+// each repository.
+type ChartFacets struct {
+	Categories []*models.ChartCategory
+	Repos      []*models.ChartCategory
+}
+
+// Supported orders of the paginated chart list
+const (
+	// OrderByName orders the charts by name, the default.
+	OrderByName = ""
+	// OrderByLastUpdated orders the charts by the creation date of their
+	// latest version, most recent first, then by name.
+	OrderByLastUpdated = "last_updated"
+	// OrderByRepo orders the charts by repository name, then by name.
+	OrderByRepo = "repo"
+)
+
+// ChartQuery is a container for passing the supported query parameters for generating the WHERE query,
+// together with the order of the paginated chart list.
+// Version and AppVersion match the charts with that exact version, whereas
+// VersionConstraint and AppVersionConstraint are semver constraints, such as
+// ">=1.2 <2", which also restrict the chart versions returned to those matching.
 type ChartQuery struct {
-	Namespace   string
-	ChartName   string
-	Version     string
-	AppVersion  string
-	SearchQuery string
-	Repos       []string
-	Categories  []string
+	Namespace            string
+	ChartName            string
+	Version              string
+	AppVersion           string
+	VersionConstraint    string
+	AppVersionConstraint string
+	SearchQuery          string
+	Repos                []string
+	Categories           []string
+	OrderBy              string
+}
+
+func (cq ChartQuery) hasVersionConstraints() bool {
+	return cq.VersionConstraint != "" || cq.AppVersionConstraint != ""
 }
 
 func NewManager(databaseType string, config dbutils.Config, globalReposNamespace string) (AssetManager, error) {
@@ -68,11 +68,19 @@ func setFlags(c *cobra.Command) {
 	c.Flags().StringSliceVar(&serveOpts.PluginDirs, "plugin-dir", []string{"."}, "A directory to be scanned for .so plugins. May be specified multiple times.")
 	c.Flags().StringVar(&serveOpts.ClustersConfigPath, "clusters-config-path", "", "Configuration for clusters")
 	c.Flags().StringVar(&serveOpts.PluginConfigPath, "plugin-config-path", "", "Configuration for plugins")
+	c.Flags().StringVar(&serveOpts.RemotePluginsConfigPath, "remote-plugins-config-path", "", "Configuration for out-of-process plugins served over gRPC")
 	c.Flags().StringVar(&serveOpts.PinnipedProxyURL, "pinniped-proxy-url", "http://kubeapps-internal-pinniped-proxy.kubeapps:3333", "internal url to be used for requests to clusters configured for credential proxying via pinniped")
 	c.Flags().StringVar(&serveOpts.GlobalReposNamespace, "global-repos-namespace", "kubeapps", "Namespace of global repositories")
 	c.Flags().BoolVar(&serveOpts.UnsafeLocalDevKubeconfig, "unsafe-local-dev-kubeconfig", false, "if true, it will use the local kubeconfig at the KUBECONFIG env var instead of using the inCluster configuration.")
 	c.Flags().Float32Var(&serveOpts.QPS, "kube-api-qps", 10.0, "set Kubernetes API client QPS limit")
 	c.Flags().IntVar(&serveOpts.Burst, "kube-api-burst", 15, "set Kubernetes API client Burst limit")
+	c.Flags().StringVar(&serveOpts.TracingEndpoint, "tracing-otlp-endpoint", "", "OTLP gRPC endpoint (host:port) to which traces are exported. Tracing is disabled if empty.")
+	c.Flags().BoolVar(&serveOpts.TracingInsecure, "tracing-otlp-insecure", false, "if true, traces are exported to the OTLP endpoint without TLS.")
+	c.Flags().Float64Var(&serveOpts.TracingSampleRatio, "tracing-sample-ratio", 1.0, "ratio of the requests which are traced, between 0 and 1")
+	c.Flags().StringVar(&serveOpts.AuditLogPath, "audit-log-path", "", "File to which the audit events of package and repository mutations are appended as JSON lines, or '-' for stdout.")
+	c.Flags().StringVar(&serveOpts.AuditWebhookURL, "audit-webhook-url", "", "URL to which the audit events of package and repository mutations are posted as JSON.")
+	c.Flags().StringVar(&serveOpts.NotificationsConfigPath, "notifications-config-path", "", "Configuration of the webhooks notified of the new versions of the installed packages. Notifications are disabled if empty.")
+	c.Flags().IntVar(&serveOpts.MetricsPort, "metrics-port", 0, "The port on which the Prometheus metrics are served on /metrics. Metrics are not served if 0.")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -29,8 +29,15 @@ func TestParseFlagsCorrect(t *testing.T) {
 				"--global-repos-namespace", "kubeapps-global",
 				"--unsafe-local-dev-kubeconfig", "true",
 				"--plugin-config-path", "foo05",
+				"--remote-plugins-config-path", "foo06",
 				"--kube-api-qps", "1.0",
 				"--kube-api-burst", "1",
+				"--tracing-otlp-endpoint", "otel-collector:4317",
+				"--tracing-otlp-insecure", "true",
+				"--tracing-sample-ratio", "0.5",
+				"--audit-log-path", "-",
+				"--audit-webhook-url", "https://audit.example.com",
+				"--metrics-port", "9090",
 			},
 			core.ServeOptions{
 				Port:                     901,
@@ -40,8 +47,15 @@ func TestParseFlagsCorrect(t *testing.T) {
 				UnsafeLocalDevKubeconfig: true,
 				GlobalReposNamespace:     "kubeapps-global",
 				PluginConfigPath:         "foo05",
+				RemotePluginsConfigPath:  "foo06",
 				QPS:                      1.0,
 				Burst:                    1,
+				TracingEndpoint:          "otel-collector:4317",
+				TracingInsecure:          true,
+				TracingSampleRatio:       0.5,
+				AuditLogPath:             "-",
+				AuditWebhookURL:          "https://audit.example.com",
+				MetricsPort:              9090,
 			},
 		},
 	}
@@ -0,0 +1,417 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records an audit event for each request mutating installed
+// packages, their resources or package repositories, whichever plugin handles
+// it.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	resources "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// User identifies the user who sent the request.
+type User struct {
+	Username string   `json:"username"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// Plugin identifies the plugin which handled the request.
+type Plugin struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Resource identifies a resource of an installed package.
+type Resource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// Event is the audit record of a single mutation.
+type Event struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	User   User      `json:"user"`
+	// UserError is set when the user could not be resolved from the request.
+	UserError string  `json:"userError,omitempty"`
+	Plugin    *Plugin `json:"plugin,omitempty"`
+	Cluster   string  `json:"cluster,omitempty"`
+	Namespace string  `json:"namespace,omitempty"`
+	// Identifier is the identifier of the installed package or repository
+	// reference which is mutated.
+	Identifier string `json:"identifier,omitempty"`
+	// AvailablePackage is the identifier of the package being installed.
+	AvailablePackage string `json:"availablePackage,omitempty"`
+	Version          string `json:"version,omitempty"`
+	Revision         int32  `json:"revision,omitempty"`
+	// ValuesHash is the sha256 of the values of the request, so that the
+	// values can be compared without storing possible secrets.
+	ValuesHash string `json:"valuesHash,omitempty"`
+	URL        string `json:"url,omitempty"`
+	// Resource is the resource of the installed package which is mutated.
+	Resource *Resource `json:"resource,omitempty"`
+	// Replicas is the number of replicas to which the resource is scaled.
+	Replicas *int32 `json:"replicas,omitempty"`
+	Outcome  string `json:"outcome"`
+	Code     string `json:"code"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Sink is the destination of the audit events.
+type Sink interface {
+	Write(ctx context.Context, event Event) error
+}
+
+// UserResolver returns the user authenticated by the bearer token.
+type UserResolver func(ctx context.Context, token string) (User, error)
+
+const (
+	// queueSize is the number of events which can be waiting to be written
+	// to the sinks before new events wait for the queue.
+	queueSize = 1000
+
+	// defaultEnqueueTimeout is how long an event waits for the queue, delaying
+	// the response to the request, before it is dropped.
+	defaultEnqueueTimeout = 5 * time.Second
+
+	dropReasonQueueFull = "queue_full"
+	dropReasonClosed    = "closed"
+)
+
+// droppedEventsTotal is the number of audit events which could not be
+// queued, so that the losses of the audit log can be alerted on.
+var droppedEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "kubeapps_apis",
+	Subsystem: "audit",
+	Name:      "dropped_events_total",
+	Help:      "Total number of audit events dropped without being written, by reason.",
+}, []string{"reason"})
+
+// Auditor records the audit events of the mutations to each of its sinks.
+// The events are queued and written in the background, so that the requests
+// are not delayed by the user resolution nor by slow sinks. If the sinks do
+// not keep up and the queue is full, the requests wait for the queue for up
+// to the enqueue timeout, after which their events are dropped and counted
+// in the kubeapps_apis_audit_dropped_events_total metric.
+type Auditor struct {
+	sinks          []Sink
+	resolveUser    UserResolver
+	timeNow        func() time.Time
+	sinkTimeout    time.Duration
+	enqueueTimeout time.Duration
+
+	// mutex guards the queue from being closed while events are queued.
+	mutex  sync.RWMutex
+	closed bool
+	queue  chan queuedEvent
+	done   chan struct{}
+}
+
+// queuedEvent is an event waiting to be written, together with the token
+// of the user who sent the request, which is resolved in the background.
+type queuedEvent struct {
+	event Event
+	token string
+}
+
+// NewAuditor returns an auditor writing the events to the given sinks, with
+// users resolved by resolveUser. The auditor must be closed so that the
+// queued events are written.
+func NewAuditor(resolveUser UserResolver, sinks ...Sink) *Auditor {
+	a := &Auditor{
+		sinks:          sinks,
+		resolveUser:    resolveUser,
+		timeNow:        time.Now,
+		sinkTimeout:    10 * time.Second,
+		enqueueTimeout: defaultEnqueueTimeout,
+		queue:          make(chan queuedEvent, queueSize),
+		done:           make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// UnaryServerInterceptor is a gRPC UnaryServerInterceptor recording an audit
+// event for each mutation once it has been handled, with its outcome.
+func (a *Auditor) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	a.RecordRequest(ctx, req, res, err)
+	return res, err
+}
+
+// RecordRequest records an audit event for the request if it is a mutation,
+// with the outcome of the given response and error. It is used for the
+// mutations which are not sent through the gRPC server, such as each of the
+// packages of an imported bundle. Nothing is recorded by a nil Auditor.
+func (a *Auditor) RecordRequest(ctx context.Context, req, res interface{}, err error) {
+	if a == nil {
+		return
+	}
+	event, ok := eventForRequest(req)
+	if !ok {
+		return
+	}
+
+	event.Time = a.timeNow().UTC()
+	if r, ok := res.(*packages.CreateInstalledPackageResponse); ok && err == nil {
+		event.Identifier = r.GetInstalledPackageRef().GetIdentifier()
+	}
+	setOutcome(&event, err)
+
+	token, tokenErr := extractToken(ctx)
+	if tokenErr != nil {
+		event.UserError = tokenErr.Error()
+	}
+	a.enqueue(queuedEvent{event: event, token: token})
+}
+
+// Record records an audit event for a mutation which was not requested by a
+// user, such as those run by kubeapps-apis itself, with the given outcome.
+// The event must identify the user on behalf of which the mutation was run.
+// Nothing is recorded by a nil Auditor.
+func (a *Auditor) Record(event Event, err error) {
+	if a == nil {
+		return
+	}
+	event.Time = a.timeNow().UTC()
+	setOutcome(&event, err)
+	a.enqueue(queuedEvent{event: event})
+}
+
+// Close stops recording events and waits for the queued events to be
+// written.
+func (a *Auditor) Close() {
+	a.mutex.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.queue)
+	}
+	a.mutex.Unlock()
+	<-a.done
+}
+
+func setOutcome(event *Event, err error) {
+	event.Code = status.Code(err).String()
+	if err != nil {
+		event.Outcome = OutcomeFailure
+		event.Error = status.Convert(err).Message()
+	} else {
+		event.Outcome = OutcomeSuccess
+	}
+}
+
+// enqueue queues the event to be written. If the sinks are not keeping up,
+// the request is delayed for up to the enqueue timeout, after which the
+// event is dropped rather than delaying the request indefinitely.
+func (a *Auditor) enqueue(e queuedEvent) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	if a.closed {
+		droppedEventsTotal.WithLabelValues(dropReasonClosed).Inc()
+		log.Errorf("dropped audit event for %s on %q: auditor closed", e.event.Action, e.event.Identifier)
+		return
+	}
+	select {
+	case a.queue <- e:
+		return
+	default:
+	}
+	timer := time.NewTimer(a.enqueueTimeout)
+	defer timer.Stop()
+	select {
+	case a.queue <- e:
+	case <-timer.C:
+		droppedEventsTotal.WithLabelValues(dropReasonQueueFull).Inc()
+		log.Errorf("dropped audit event for %s on %q: %d events have been waiting to be written for %s", e.event.Action, e.event.Identifier, queueSize, a.enqueueTimeout)
+	}
+}
+
+// run resolves the user and writes each queued event until the auditor is
+// closed.
+func (a *Auditor) run() {
+	defer close(a.done)
+	for e := range a.queue {
+		if e.token != "" {
+			e.event.User, e.event.UserError = a.user(e.token)
+		}
+		a.write(e.event)
+	}
+}
+
+func (a *Auditor) user(token string) (User, string) {
+	if a.resolveUser == nil {
+		return User{}, "no user resolver configured"
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.sinkTimeout)
+	defer cancel()
+	user, err := a.resolveUser(ctx, token)
+	if err != nil {
+		return User{}, err.Error()
+	}
+	return user, ""
+}
+
+// write sends the event to each sink. The request has already been handled,
+// so a failure to write is logged rather than returned to the user.
+func (a *Auditor) write(event Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.sinkTimeout)
+	defer cancel()
+	for _, sink := range a.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			log.Errorf("failed to write audit event for %s on %q: %v", event.Action, event.Identifier, err)
+		}
+	}
+}
+
+// eventForRequest returns the event, without the outcome, for the requests
+// which mutate installed packages or package repositories.
+func eventForRequest(req interface{}) (Event, bool) {
+	switch r := req.(type) {
+	case *packages.CreateInstalledPackageRequest:
+		return Event{
+			Action:           "CreateInstalledPackage",
+			Plugin:           pluginFor(r.GetAvailablePackageRef().GetPlugin()),
+			Cluster:          r.GetTargetContext().GetCluster(),
+			Namespace:        r.GetTargetContext().GetNamespace(),
+			AvailablePackage: r.GetAvailablePackageRef().GetIdentifier(),
+			Version:          r.GetPkgVersionReference().GetVersion(),
+			ValuesHash:       valuesHash(r.GetValues()),
+		}, true
+	case *packages.UpdateInstalledPackageRequest:
+		return Event{
+			Action:     "UpdateInstalledPackage",
+			Plugin:     pluginFor(r.GetInstalledPackageRef().GetPlugin()),
+			Cluster:    r.GetInstalledPackageRef().GetContext().GetCluster(),
+			Namespace:  r.GetInstalledPackageRef().GetContext().GetNamespace(),
+			Identifier: r.GetInstalledPackageRef().GetIdentifier(),
+			Version:    r.GetPkgVersionReference().GetVersion(),
+			ValuesHash: valuesHash(r.GetValues()),
+		}, true
+	case *packages.DeleteInstalledPackageRequest:
+		return Event{
+			Action:     "DeleteInstalledPackage",
+			Plugin:     pluginFor(r.GetInstalledPackageRef().GetPlugin()),
+			Cluster:    r.GetInstalledPackageRef().GetContext().GetCluster(),
+			Namespace:  r.GetInstalledPackageRef().GetContext().GetNamespace(),
+			Identifier: r.GetInstalledPackageRef().GetIdentifier(),
+		}, true
+	case *packages.RollbackInstalledPackageRequest:
+		return Event{
+			Action:     "RollbackInstalledPackage",
+			Plugin:     pluginFor(r.GetInstalledPackageRef().GetPlugin()),
+			Cluster:    r.GetInstalledPackageRef().GetContext().GetCluster(),
+			Namespace:  r.GetInstalledPackageRef().GetContext().GetNamespace(),
+			Identifier: r.GetInstalledPackageRef().GetIdentifier(),
+			Revision:   r.GetRevision(),
+		}, true
+	case *packages.AddPackageRepositoryRequest:
+		return Event{
+			Action:     "AddPackageRepository",
+			Plugin:     pluginFor(r.GetPlugin()),
+			Cluster:    r.GetContext().GetCluster(),
+			Namespace:  r.GetContext().GetNamespace(),
+			Identifier: r.GetName(),
+			URL:        r.GetUrl(),
+		}, true
+	case *packages.UpdatePackageRepositoryRequest:
+		return Event{
+			Action:     "UpdatePackageRepository",
+			Plugin:     pluginFor(r.GetPackageRepoRef().GetPlugin()),
+			Cluster:    r.GetPackageRepoRef().GetContext().GetCluster(),
+			Namespace:  r.GetPackageRepoRef().GetContext().GetNamespace(),
+			Identifier: r.GetPackageRepoRef().GetIdentifier(),
+			URL:        r.GetUrl(),
+		}, true
+	case *packages.DeletePackageRepositoryRequest:
+		return Event{
+			Action:     "DeletePackageRepository",
+			Plugin:     pluginFor(r.GetPackageRepoRef().GetPlugin()),
+			Cluster:    r.GetPackageRepoRef().GetContext().GetCluster(),
+			Namespace:  r.GetPackageRepoRef().GetContext().GetNamespace(),
+			Identifier: r.GetPackageRepoRef().GetIdentifier(),
+		}, true
+	case *resources.ScaleResourceRequest:
+		replicas := r.GetReplicas()
+		return Event{
+			Action:     "ScaleResource",
+			Plugin:     pluginFor(r.GetInstalledPackageRef().GetPlugin()),
+			Cluster:    r.GetInstalledPackageRef().GetContext().GetCluster(),
+			Namespace:  r.GetInstalledPackageRef().GetContext().GetNamespace(),
+			Identifier: r.GetInstalledPackageRef().GetIdentifier(),
+			Resource:   resourceFor(r.GetResourceRef()),
+			Replicas:   &replicas,
+		}, true
+	case *resources.RestartResourceRequest:
+		return Event{
+			Action:     "RestartResource",
+			Plugin:     pluginFor(r.GetInstalledPackageRef().GetPlugin()),
+			Cluster:    r.GetInstalledPackageRef().GetContext().GetCluster(),
+			Namespace:  r.GetInstalledPackageRef().GetContext().GetNamespace(),
+			Identifier: r.GetInstalledPackageRef().GetIdentifier(),
+			Resource:   resourceFor(r.GetResourceRef()),
+		}, true
+	}
+	return Event{}, false
+}
+
+func pluginFor(plugin *plugins.Plugin) *Plugin {
+	if plugin == nil {
+		return nil
+	}
+	return &Plugin{Name: plugin.GetName(), Version: plugin.GetVersion()}
+}
+
+func resourceFor(ref *packages.ResourceRef) *Resource {
+	if ref == nil {
+		return nil
+	}
+	return &Resource{
+		APIVersion: ref.GetApiVersion(),
+		Kind:       ref.GetKind(),
+		Name:       ref.GetName(),
+		Namespace:  ref.GetNamespace(),
+	}
+}
+
+func valuesHash(values string) string {
+	if values == "" {
+		return ""
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(values)))
+}
+
+// extractToken returns the bearer token sent in the authorization metadata.
+func extractToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["authorization"]) == 0 {
+		return "", fmt.Errorf("missing authorization metadata")
+	}
+	if !strings.HasPrefix(md["authorization"][0], "Bearer ") {
+		return "", fmt.Errorf("malformed authorization metadata")
+	}
+	return strings.TrimPrefix(md["authorization"][0], "Bearer "), nil
+}
@@ -0,0 +1,464 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	resources "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	testTime   = time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	testPlugin = &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"}
+	testUser   = User{Username: "alice", UID: "1234", Groups: []string{"system:authenticated"}}
+)
+
+// memorySink keeps the events written to it.
+type memorySink struct {
+	events []Event
+}
+
+func (s *memorySink) Write(ctx context.Context, event Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+// newTokenReviewClient returns a fake client authenticating only the token
+// "abc" as the test user.
+func newTokenReviewClient() *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "abc" {
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: testUser.Username,
+					UID:      testUser.UID,
+					Groups:   testUser.Groups,
+				},
+			}
+		} else {
+			review.Status = authenticationv1.TokenReviewStatus{Error: "invalid token"}
+		}
+		return true, review, nil
+	})
+	return client
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	installedRef := &packages.InstalledPackageReference{
+		Context:    &packages.Context{Cluster: "default", Namespace: "team-a"},
+		Identifier: "my-apache",
+		Plugin:     testPlugin,
+	}
+	zeroReplicas := int32(0)
+
+	testCases := []struct {
+		name          string
+		token         string
+		request       interface{}
+		response      interface{}
+		err           error
+		expectedEvent *Event
+	}{
+		{
+			name:  "it records the installation of a package",
+			token: "abc",
+			request: &packages.CreateInstalledPackageRequest{
+				AvailablePackageRef: &packages.AvailablePackageReference{
+					Identifier: "bitnami/apache",
+					Plugin:     testPlugin,
+				},
+				TargetContext:       &packages.Context{Cluster: "default", Namespace: "team-a"},
+				Name:                "my-apache",
+				PkgVersionReference: &packages.VersionReference{Version: "8.6.1"},
+				Values:              "replicaCount: 2",
+			},
+			response: &packages.CreateInstalledPackageResponse{InstalledPackageRef: installedRef},
+			expectedEvent: &Event{
+				Time:             testTime,
+				Action:           "CreateInstalledPackage",
+				User:             testUser,
+				Plugin:           &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				Cluster:          "default",
+				Namespace:        "team-a",
+				Identifier:       "my-apache",
+				AvailablePackage: "bitnami/apache",
+				Version:          "8.6.1",
+				ValuesHash:       "sha256:62fcd501e151823279e567114234a95014cb20e8c460154ab94aaa63e672aef3",
+				Outcome:          OutcomeSuccess,
+				Code:             codes.OK.String(),
+			},
+		},
+		{
+			name:  "it records a failed rollback",
+			token: "abc",
+			request: &packages.RollbackInstalledPackageRequest{
+				InstalledPackageRef: installedRef,
+				Revision:            2,
+			},
+			err: status.Errorf(codes.NotFound, "revision 2 not found"),
+			expectedEvent: &Event{
+				Time:       testTime,
+				Action:     "RollbackInstalledPackage",
+				User:       testUser,
+				Plugin:     &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				Cluster:    "default",
+				Namespace:  "team-a",
+				Identifier: "my-apache",
+				Revision:   2,
+				Outcome:    OutcomeFailure,
+				Code:       codes.NotFound.String(),
+				Error:      "revision 2 not found",
+			},
+		},
+		{
+			name:  "it records the deletion of a repository when the user cannot be resolved",
+			token: "def",
+			request: &packages.DeletePackageRepositoryRequest{
+				PackageRepoRef: &packages.PackageRepositoryReference{
+					Context:    &packages.Context{Cluster: "default", Namespace: "kubeapps"},
+					Identifier: "bitnami",
+					Plugin:     testPlugin,
+				},
+			},
+			response: &packages.DeletePackageRepositoryResponse{},
+			expectedEvent: &Event{
+				Time:       testTime,
+				Action:     "DeletePackageRepository",
+				UserError:  "token not authenticated: invalid token",
+				Plugin:     &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				Cluster:    "default",
+				Namespace:  "kubeapps",
+				Identifier: "bitnami",
+				Outcome:    OutcomeSuccess,
+				Code:       codes.OK.String(),
+			},
+		},
+		{
+			name:  "it records the scaling of a resource of an installed package",
+			token: "abc",
+			request: &resources.ScaleResourceRequest{
+				InstalledPackageRef: installedRef,
+				ResourceRef: &packages.ResourceRef{
+					ApiVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "my-apache",
+					Namespace:  "team-a",
+				},
+				Replicas: 0,
+			},
+			response: &resources.ScaleResourceResponse{},
+			expectedEvent: &Event{
+				Time:       testTime,
+				Action:     "ScaleResource",
+				User:       testUser,
+				Plugin:     &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				Cluster:    "default",
+				Namespace:  "team-a",
+				Identifier: "my-apache",
+				Resource: &Resource{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "my-apache",
+					Namespace:  "team-a",
+				},
+				Replicas: &zeroReplicas,
+				Outcome:  OutcomeSuccess,
+				Code:     codes.OK.String(),
+			},
+		},
+		{
+			name:  "it records a forbidden restart of a resource of an installed package",
+			token: "abc",
+			request: &resources.RestartResourceRequest{
+				InstalledPackageRef: installedRef,
+				ResourceRef: &packages.ResourceRef{
+					ApiVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       "my-apache",
+					Namespace:  "team-a",
+				},
+			},
+			err: status.Errorf(codes.PermissionDenied, "forbidden"),
+			expectedEvent: &Event{
+				Time:       testTime,
+				Action:     "RestartResource",
+				User:       testUser,
+				Plugin:     &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				Cluster:    "default",
+				Namespace:  "team-a",
+				Identifier: "my-apache",
+				Resource: &Resource{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       "my-apache",
+					Namespace:  "team-a",
+				},
+				Outcome: OutcomeFailure,
+				Code:    codes.PermissionDenied.String(),
+				Error:   "forbidden",
+			},
+		},
+		{
+			name:     "it does not record requests which do not mutate",
+			token:    "abc",
+			request:  &packages.GetInstalledPackageDetailRequest{InstalledPackageRef: installedRef},
+			response: &packages.GetInstalledPackageDetailResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &memorySink{}
+			auditor := NewAuditor(NewTokenReviewUserResolver(newTokenReviewClient()), sink)
+			auditor.timeNow = func() time.Time { return testTime }
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+				"authorization": "Bearer " + tc.token,
+			}))
+			response, err := auditor.UnaryServerInterceptor(ctx, tc.request, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return tc.response, tc.err
+			})
+
+			// The response and error are returned unchanged.
+			if got, want := err, tc.err; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+			if got, want := response, tc.response; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+
+			// Closing the auditor waits for the queued events to be written.
+			auditor.Close()
+
+			var expectedEvents []Event
+			if tc.expectedEvent != nil {
+				expectedEvents = append(expectedEvents, *tc.expectedEvent)
+			}
+			if got, want := sink.events, expectedEvents; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	sink := &memorySink{}
+	auditor := NewAuditor(NewTokenReviewUserResolver(newTokenReviewClient()), sink)
+	auditor.timeNow = func() time.Time { return testTime }
+
+	serviceAccount := User{Username: "system:serviceaccount:kubeapps:kubeapps-apis"}
+	auditor.Record(Event{
+		Action:     "UpdateInstalledPackage",
+		User:       serviceAccount,
+		Plugin:     &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+		Cluster:    "default",
+		Namespace:  "team-a",
+		Identifier: "my-apache",
+		Version:    "8.6.2",
+	}, status.Errorf(codes.Internal, "upgrade failed"))
+	auditor.Close()
+
+	expectedEvents := []Event{{
+		Time:       testTime,
+		Action:     "UpdateInstalledPackage",
+		User:       serviceAccount,
+		Plugin:     &Plugin{Name: "helm.packages", Version: "v1alpha1"},
+		Cluster:    "default",
+		Namespace:  "team-a",
+		Identifier: "my-apache",
+		Version:    "8.6.2",
+		Outcome:    OutcomeFailure,
+		Code:       codes.Internal.String(),
+		Error:      "upgrade failed",
+	}}
+	if got, want := sink.events, expectedEvents; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+// blockingSink blocks each write until it is released.
+type blockingSink struct {
+	release chan struct{}
+	written int
+}
+
+func (s *blockingSink) Write(ctx context.Context, event Event) error {
+	<-s.release
+	s.written++
+	return nil
+}
+
+func TestUnaryServerInterceptorDoesNotWaitForSinks(t *testing.T) {
+	sink := &blockingSink{release: make(chan struct{})}
+	auditor := NewAuditor(nil, sink)
+
+	request := &packages.DeleteInstalledPackageRequest{
+		InstalledPackageRef: &packages.InstalledPackageReference{Identifier: "my-apache"},
+	}
+	handled := make(chan struct{})
+	go func() {
+		defer close(handled)
+		_, err := auditor.UnaryServerInterceptor(context.Background(), request, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &packages.DeleteInstalledPackageResponse{}, nil
+		})
+		if err != nil {
+			t.Errorf("%+v", err)
+		}
+	}()
+
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the request was delayed by the sink")
+	}
+
+	close(sink.release)
+	auditor.Close()
+	if got, want := sink.written, 1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
+
+func TestRecordDropsEventsWhenTheQueueIsFull(t *testing.T) {
+	sink := &blockingSink{release: make(chan struct{})}
+	auditor := NewAuditor(nil, sink)
+	auditor.enqueueTimeout = 10 * time.Millisecond
+	queueFull := droppedEventsTotal.WithLabelValues(dropReasonQueueFull)
+	before := testutil.ToFloat64(queueFull)
+
+	// The first event is blocked in the sink, the others fill the queue
+	// until an event is dropped.
+	recorded := 0
+	for testutil.ToFloat64(queueFull) == before {
+		if recorded > queueSize+10 {
+			t.Fatalf("no event was dropped")
+		}
+		auditor.Record(Event{Action: "UpdateInstalledPackage"}, nil)
+		recorded++
+	}
+	dropped := int(testutil.ToFloat64(queueFull) - before)
+
+	close(sink.release)
+	auditor.Close()
+	if got, want := sink.written, recorded-dropped; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+	if sink.written < queueSize {
+		t.Errorf("got: %d written events, want at least %d", sink.written, queueSize)
+	}
+
+	closed := droppedEventsTotal.WithLabelValues(dropReasonClosed)
+	before = testutil.ToFloat64(closed)
+	auditor.Record(Event{Action: "UpdateInstalledPackage"}, nil)
+	if got, want := testutil.ToFloat64(closed), before+1; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	events := []Event{
+		{Time: testTime, Action: "DeleteInstalledPackage", User: testUser, Identifier: "my-apache", Outcome: OutcomeSuccess, Code: "OK"},
+		{Time: testTime, Action: "DeleteInstalledPackage", User: testUser, Identifier: "my-nginx", Outcome: OutcomeFailure, Code: "NotFound"},
+	}
+	for _, event := range events {
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	lines := bytes.Split(bytes.TrimSpace(contents), []byte("\n"))
+	got := []Event{}
+	for _, line := range lines {
+		event := Event{}
+		if err := json.Unmarshal(line, &event); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		got = append(got, event)
+	}
+	if want := events; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statusCode  int
+		expectedErr bool
+	}{
+		{
+			name:       "it posts the event",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:        "it returns an error if the webhook fails",
+			statusCode:  http.StatusInternalServerError,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var received Event
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got, want := r.Header.Get("Content-Type"), "application/json"; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if err := json.Unmarshal(body, &received); err != nil {
+					t.Fatalf("%+v", err)
+				}
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			event := Event{Time: testTime, Action: "AddPackageRepository", User: testUser, Identifier: "bitnami", URL: "https://charts.bitnami.com/bitnami", Outcome: OutcomeSuccess, Code: "OK"}
+			err := NewWebhookSink(server.URL, nil).Write(context.Background(), event)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := received, event; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// StdoutPath is the audit log path used to write the events to stdout.
+const StdoutPath = "-"
+
+// jsonSink writes each event as a line of JSON.
+type jsonSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONSink returns a sink writing each event as a line of JSON to w.
+func NewJSONSink(w io.Writer) Sink {
+	return &jsonSink{w: w}
+}
+
+// NewFileSink returns a sink appending the events as lines of JSON to the
+// file at path, or to stdout when path is StdoutPath.
+func NewFileSink(path string) (Sink, error) {
+	if path == StdoutPath {
+		return NewJSONSink(os.Stdout), nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the audit log %q: %w", path, err)
+	}
+	return NewJSONSink(f), nil
+}
+
+func (s *jsonSink) Write(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// webhookSink posts each event as JSON to a URL.
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a sink posting each event as JSON to url.
+func NewWebhookSink(url string, client *http.Client) Sink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &webhookSink{url: url, client: client}
+}
+
+func (s *webhookSink) Write(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("audit webhook %q returned status %d", s.url, res.StatusCode)
+	}
+	return nil
+}
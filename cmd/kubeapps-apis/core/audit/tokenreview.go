@@ -0,0 +1,36 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NewTokenReviewUserResolver returns a resolver authenticating the bearer
+// tokens with a TokenReview, created with the given client. The client must
+// be allowed to create tokenreviews, so it is the client of kubeapps-apis
+// itself rather than of the user.
+func NewTokenReviewUserResolver(client kubernetes.Interface) UserResolver {
+	return func(ctx context.Context, token string) (User, error) {
+		review, err := client.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{Token: token},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return User{}, fmt.Errorf("unable to review the token: %w", err)
+		}
+		if !review.Status.Authenticated {
+			return User{}, fmt.Errorf("token not authenticated: %s", review.Status.Error)
+		}
+		return User{
+			Username: review.Status.User.Username,
+			UID:      review.Status.User.UID,
+			Groups:   review.Status.User.Groups,
+		}, nil
+	}
+}
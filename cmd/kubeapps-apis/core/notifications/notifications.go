@@ -0,0 +1,350 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package notifications notifies about the new versions available for the
+// installed packages, whichever plugin handles them.
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/metadata"
+	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	UpgradeTypePatch = "patch"
+	UpgradeTypeMinor = "minor"
+	UpgradeTypeMajor = "major"
+
+	DefaultIntervalSeconds int32 = 900
+
+	// DefaultTokenPath is the token of the service account of kubeapps-apis.
+	DefaultTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// The number of installed packages requested per page.
+	pageSize = 100
+)
+
+// Config is the configuration of the notifications, read from the file
+// passed with --notifications-config-path.
+type Config struct {
+	// IntervalSeconds is the interval at which the installed packages are
+	// checked for new versions.
+	IntervalSeconds int32 `json:"intervalSeconds"`
+	// TokenPath is the file with the bearer token used to list the installed
+	// packages, so that only those visible with it are checked.
+	TokenPath string `json:"tokenPath"`
+	// Contexts are the clusters and namespaces checked for new versions. The
+	// installed packages of all the namespaces of the default cluster are
+	// checked when empty.
+	Contexts []ContextConfig `json:"contexts"`
+	// Webhooks are the destinations of the notifications.
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// ContextConfig is a cluster and namespace checked for new versions. All the
+// namespaces are checked when the namespace is empty.
+type ContextConfig struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+}
+
+// Notification is sent when a new version is available for an installed
+// package.
+type Notification struct {
+	Plugin    string `json:"plugin"`
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// PackageName is the display name of the package installed.
+	PackageName string `json:"packageName,omitempty"`
+	FromVersion string `json:"fromVersion"`
+	ToVersion   string `json:"toVersion"`
+	// UpgradeType is "patch", "minor" or "major", depending on the first
+	// part of the version which changes.
+	UpgradeType string `json:"upgradeType"`
+	// MatchesUpgradePolicy is whether ToVersion is the latest version
+	// matching the version constraint of the installed package, rather than
+	// the latest version.
+	MatchesUpgradePolicy bool `json:"matchesUpgradePolicy"`
+	// LatestVersion is the latest version of the package, which may not
+	// match the version constraint of the installed package.
+	LatestVersion string    `json:"latestVersion,omitempty"`
+	Time          time.Time `json:"time"`
+}
+
+// Sink is the destination of the notifications.
+type Sink interface {
+	// Name identifies the sink in the notified versions, so that a
+	// notification is only sent again to the sinks it failed to be sent to.
+	Name() string
+	Send(ctx context.Context, notification Notification) error
+}
+
+// Store persists the notified versions, so that the notifications are not
+// sent again when kubeapps-apis restarts.
+type Store interface {
+	Load(ctx context.Context) (map[string]string, error)
+	Save(ctx context.Context, notified map[string]string) error
+}
+
+// Notifier sends a notification to each of its sinks, once, for each new
+// version of the installed packages.
+type Notifier struct {
+	packagesServer packages.PackagesServiceServer
+	config         Config
+	sinks          []Sink
+	store          Store
+	timeNow        func() time.Time
+	readToken      func() (string, error)
+	// notified is the version notified for each installed package and sink.
+	notified map[string]string
+}
+
+// ParseConfig reads the configuration of the notifications from a YAML or
+// JSON file.
+func ParseConfig(path string) (Config, error) {
+	var config Config
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("unable to open the notifications config at %q: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("unable to parse the notifications config at %q: %w", path, err)
+	}
+	if config.IntervalSeconds <= 0 {
+		config.IntervalSeconds = DefaultIntervalSeconds
+	}
+	if config.TokenPath == "" {
+		config.TokenPath = DefaultTokenPath
+	}
+	if len(config.Contexts) == 0 {
+		config.Contexts = []ContextConfig{{}}
+	}
+	for i, w := range config.Webhooks {
+		if w.URL == "" {
+			return Config{}, fmt.Errorf("the url of the notifications webhook %d is required", i)
+		}
+		for _, t := range w.UpgradeTypes {
+			if t != UpgradeTypePatch && t != UpgradeTypeMinor && t != UpgradeTypeMajor {
+				return Config{}, fmt.Errorf("invalid upgrade type %q of the notifications webhook %d", t, i)
+			}
+		}
+		if _, err := parseTemplate(w.Template); err != nil {
+			return Config{}, fmt.Errorf("invalid template of the notifications webhook %d: %w", i, err)
+		}
+	}
+	return config, nil
+}
+
+// NewNotifier returns a notifier checking the installed packages of the
+// packages server. The store is optional.
+func NewNotifier(packagesServer packages.PackagesServiceServer, config Config, store Store, sinks ...Sink) *Notifier {
+	return &Notifier{
+		packagesServer: packagesServer,
+		config:         config,
+		sinks:          sinks,
+		store:          store,
+		timeNow:        time.Now,
+		readToken: func() (string, error) {
+			token, err := ioutil.ReadFile(config.TokenPath)
+			return string(token), err
+		},
+	}
+}
+
+// Run checks the installed packages for new versions at the configured
+// interval, until the context is done.
+func (n *Notifier) Run(ctx context.Context) {
+	log.Infof("+core notifying new versions every %ds", n.config.IntervalSeconds)
+	ticker := time.NewTicker(time.Duration(n.config.IntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := n.Check(ctx); err != nil {
+			log.Errorf("Unable to check the installed packages for new versions: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check sends the notifications for the new versions of the installed
+// packages which have not been notified yet.
+func (n *Notifier) Check(ctx context.Context) error {
+	if n.notified == nil {
+		n.notified = map[string]string{}
+		if n.store != nil {
+			notified, err := n.store.Load(ctx)
+			if err != nil {
+				n.notified = nil
+				return fmt.Errorf("unable to load the notified versions: %w", err)
+			}
+			for k, v := range notified {
+				n.notified[k] = v
+			}
+		}
+	}
+
+	token, err := n.readToken()
+	if err != nil {
+		return fmt.Errorf("unable to read the token: %w", err)
+	}
+	// The plugins authenticate the requests with the incoming metadata, as
+	// for the requests of users.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+
+	changed := false
+	// listed are the keys of the installed packages listed in all the
+	// contexts, for each sink.
+	listed := map[string]bool{}
+	listedAll := true
+	for _, c := range n.config.Contexts {
+		summaries, err := n.installedPackageSummaries(ctx, c)
+		if err != nil {
+			log.Errorf("Unable to list the installed packages of %+v: %v", c, err)
+			listedAll = false
+			continue
+		}
+		for _, summary := range summaries {
+			notification, ok := newNotification(summary, n.timeNow().UTC())
+			for _, sink := range n.sinks {
+				key := notificationKey(summary.GetInstalledPackageRef(), sink.Name())
+				listed[key] = true
+				if !ok || n.notified[key] == notification.ToVersion {
+					continue
+				}
+				// The notification is sent again at the next check if it
+				// fails, to this sink only.
+				if err := sink.Send(ctx, notification); err != nil {
+					log.Errorf("Unable to send the notification of %s/%s %s to %q: %v", notification.Namespace, notification.Name, notification.ToVersion, sink.Name(), err)
+					continue
+				}
+				n.notified[key] = notification.ToVersion
+				changed = true
+			}
+		}
+	}
+
+	// The versions notified for the installed packages which no longer
+	// exist, or to the sinks no longer configured, are forgotten, unless
+	// some installed packages could not be listed.
+	if listedAll {
+		for key := range n.notified {
+			if !listed[key] {
+				delete(n.notified, key)
+				changed = true
+			}
+		}
+	}
+
+	if changed && n.store != nil {
+		if err := n.store.Save(ctx, n.notified); err != nil {
+			return fmt.Errorf("unable to save the notified versions: %w", err)
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) installedPackageSummaries(ctx context.Context, c ContextConfig) ([]*packages.InstalledPackageSummary, error) {
+	summaries := []*packages.InstalledPackageSummary{}
+	pageToken := ""
+	for {
+		response, err := n.packagesServer.GetInstalledPackageSummaries(ctx, &packages.GetInstalledPackageSummariesRequest{
+			Context: &packages.Context{
+				Cluster:   c.Cluster,
+				Namespace: c.Namespace,
+			},
+			PaginationOptions: &packages.PaginationOptions{
+				PageToken: pageToken,
+				PageSize:  pageSize,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, response.GetInstalledPackageSummaries()...)
+		pageToken = response.GetNextPageToken()
+		if pageToken == "" {
+			return summaries, nil
+		}
+	}
+}
+
+// notificationKey identifies an installed package and a sink in the notified
+// versions.
+func notificationKey(ref *packages.InstalledPackageReference, sink string) string {
+	key, _ := json.Marshal([]string{
+		ref.GetPlugin().GetName(),
+		ref.GetContext().GetCluster(),
+		ref.GetContext().GetNamespace(),
+		ref.GetIdentifier(),
+		sink,
+	})
+	return string(key)
+}
+
+// newNotification returns the notification of the new version of the
+// installed package, if any. The latest version matching the version
+// constraint of the installed package is preferred to the latest version.
+func newNotification(summary *packages.InstalledPackageSummary, now time.Time) (Notification, bool) {
+	fromVersion := summary.GetCurrentVersion().GetPkgVersion()
+	latestMatching := summary.GetLatestMatchingVersion().GetPkgVersion()
+	latest := summary.GetLatestVersion().GetPkgVersion()
+
+	toVersion, matchesUpgradePolicy := latestMatching, true
+	upgradeType := classifyUpgrade(fromVersion, toVersion)
+	if upgradeType == "" {
+		toVersion, matchesUpgradePolicy = latest, false
+		upgradeType = classifyUpgrade(fromVersion, toVersion)
+	}
+	if upgradeType == "" {
+		return Notification{}, false
+	}
+
+	ref := summary.GetInstalledPackageRef()
+	return Notification{
+		Plugin:               ref.GetPlugin().GetName(),
+		Cluster:              ref.GetContext().GetCluster(),
+		Namespace:            ref.GetContext().GetNamespace(),
+		Name:                 summary.GetName(),
+		PackageName:          summary.GetPkgDisplayName(),
+		FromVersion:          fromVersion,
+		ToVersion:            toVersion,
+		UpgradeType:          upgradeType,
+		MatchesUpgradePolicy: matchesUpgradePolicy,
+		LatestVersion:        latest,
+		Time:                 now,
+	}, true
+}
+
+// classifyUpgrade returns the type of the upgrade from a version to another,
+// or an empty string if the latter is not a newer semantic version.
+func classifyUpgrade(fromVersion, toVersion string) string {
+	from, err := semver.NewVersion(fromVersion)
+	if err != nil {
+		return ""
+	}
+	to, err := semver.NewVersion(toVersion)
+	if err != nil || !to.GreaterThan(from) {
+		return ""
+	}
+	switch {
+	case to.Major() != from.Major():
+		return UpgradeTypeMajor
+	case to.Minor() != from.Minor():
+		return UpgradeTypeMinor
+	default:
+		return UpgradeTypePatch
+	}
+}
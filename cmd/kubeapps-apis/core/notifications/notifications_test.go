@@ -0,0 +1,510 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var (
+	testTime   = time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	testPlugin = &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"}
+)
+
+// memorySink keeps the notifications sent to it.
+type memorySink struct {
+	name          string
+	notifications []Notification
+	err           error
+}
+
+func (s *memorySink) Name() string {
+	return s.name
+}
+
+func (s *memorySink) Send(ctx context.Context, notification Notification) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.notifications = append(s.notifications, notification)
+	return nil
+}
+
+// memoryStore keeps the notified versions saved to it.
+type memoryStore struct {
+	notified map[string]string
+}
+
+func (s *memoryStore) Load(ctx context.Context) (map[string]string, error) {
+	notified := map[string]string{}
+	for k, v := range s.notified {
+		notified[k] = v
+	}
+	return notified, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, notified map[string]string) error {
+	s.notified = map[string]string{}
+	for k, v := range notified {
+		s.notified[k] = v
+	}
+	return nil
+}
+
+// summariesServer returns its installed package summaries one per page, to
+// the requests authenticated with the token "abc".
+type summariesServer struct {
+	packages.UnimplementedPackagesServiceServer
+	summaries []*packages.InstalledPackageSummary
+}
+
+func (s *summariesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if got := md.Get("authorization"); len(got) != 1 || got[0] != "Bearer abc" {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid authorization metadata")
+	}
+	page := 0
+	if token := request.GetPaginationOptions().GetPageToken(); token != "" {
+		page, _ = strconv.Atoi(token)
+	}
+	response := &packages.GetInstalledPackageSummariesResponse{}
+	if page < len(s.summaries) {
+		response.InstalledPackageSummaries = s.summaries[page : page+1]
+	}
+	if page+1 < len(s.summaries) {
+		response.NextPageToken = strconv.Itoa(page + 1)
+	}
+	return response, nil
+}
+
+func installedPackageSummary(name, current, latestMatching, latest string) *packages.InstalledPackageSummary {
+	summary := &packages.InstalledPackageSummary{
+		InstalledPackageRef: &packages.InstalledPackageReference{
+			Context:    &packages.Context{Cluster: "default", Namespace: "team-a"},
+			Identifier: name,
+			Plugin:     testPlugin,
+		},
+		Name:           name,
+		PkgDisplayName: "apache",
+		CurrentVersion: &packages.PackageAppVersion{PkgVersion: current},
+		LatestVersion:  &packages.PackageAppVersion{PkgVersion: latest},
+	}
+	if latestMatching != "" {
+		summary.LatestMatchingVersion = &packages.PackageAppVersion{PkgVersion: latestMatching}
+	}
+	return summary
+}
+
+func TestClassifyUpgrade(t *testing.T) {
+	testCases := []struct {
+		from, to string
+		expected string
+	}{
+		{"1.2.3", "1.2.4", UpgradeTypePatch},
+		{"1.2.3", "1.3.0", UpgradeTypeMinor},
+		{"1.2.3", "2.0.0", UpgradeTypeMajor},
+		{"v1.2.3", "1.2.4-rc.1", UpgradeTypePatch},
+		{"1.2.3", "1.2.3", ""},
+		{"1.2.3", "1.2.2", ""},
+		{"1.2.3", "", ""},
+		{"latest", "1.2.3", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s to %s", tc.from, tc.to), func(t *testing.T) {
+			if got, want := classifyUpgrade(tc.from, tc.to), tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNewNotification(t *testing.T) {
+	testCases := []struct {
+		name     string
+		summary  *packages.InstalledPackageSummary
+		expected *Notification
+	}{
+		{
+			name:    "it notifies the latest matching version",
+			summary: installedPackageSummary("my-apache", "1.2.3", "1.2.5", "2.0.0"),
+			expected: &Notification{
+				Plugin:               "helm.packages",
+				Cluster:              "default",
+				Namespace:            "team-a",
+				Name:                 "my-apache",
+				PackageName:          "apache",
+				FromVersion:          "1.2.3",
+				ToVersion:            "1.2.5",
+				UpgradeType:          UpgradeTypePatch,
+				MatchesUpgradePolicy: true,
+				LatestVersion:        "2.0.0",
+				Time:                 testTime,
+			},
+		},
+		{
+			name:    "it notifies the latest version when the latest matching version is not newer",
+			summary: installedPackageSummary("my-apache", "1.2.3", "1.2.3", "2.0.0"),
+			expected: &Notification{
+				Plugin:        "helm.packages",
+				Cluster:       "default",
+				Namespace:     "team-a",
+				Name:          "my-apache",
+				PackageName:   "apache",
+				FromVersion:   "1.2.3",
+				ToVersion:     "2.0.0",
+				UpgradeType:   UpgradeTypeMajor,
+				LatestVersion: "2.0.0",
+				Time:          testTime,
+			},
+		},
+		{
+			name:    "it does not notify an up to date package",
+			summary: installedPackageSummary("my-apache", "2.0.0", "", "2.0.0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			notification, ok := newNotification(tc.summary, testTime)
+			if got, want := ok, tc.expected != nil; got != want {
+				t.Fatalf("got: %t, want: %t", got, want)
+			}
+			if tc.expected == nil {
+				return
+			}
+			if got, want := notification, *tc.expected; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	server := &summariesServer{
+		summaries: []*packages.InstalledPackageSummary{
+			installedPackageSummary("my-apache", "1.2.3", "1.2.5", "1.2.5"),
+			installedPackageSummary("my-nginx", "1.0.0", "", "1.0.0"),
+			installedPackageSummary("my-redis", "1.0.0", "", "1.1.0"),
+		},
+	}
+	sink := &memorySink{name: "a"}
+	store := &memoryStore{notified: map[string]string{
+		notificationKey(server.summaries[2].InstalledPackageRef, "a"): "1.1.0",
+	}}
+	notifier := NewNotifier(server, Config{Contexts: []ContextConfig{{}}}, store, sink)
+	notifier.timeNow = func() time.Time { return testTime }
+	notifier.readToken = func() (string, error) { return "abc", nil }
+
+	// my-redis was already notified before, according to the store.
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := len(sink.notifications), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+	if got, want := sink.notifications[0].Name, "my-apache"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := store.notified[notificationKey(server.summaries[0].InstalledPackageRef, "a")], "1.2.5"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	// Nothing new is notified again.
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := len(sink.notifications), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+
+	// A newer version is notified.
+	server.summaries[0] = installedPackageSummary("my-apache", "1.2.3", "1.2.6", "1.2.6")
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := len(sink.notifications), 2; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+	if got, want := sink.notifications[1].ToVersion, "1.2.6"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestCheckRetriesFailedNotifications(t *testing.T) {
+	server := &summariesServer{
+		summaries: []*packages.InstalledPackageSummary{
+			installedPackageSummary("my-apache", "1.2.3", "1.2.5", "1.2.5"),
+		},
+	}
+	sink := &memorySink{name: "a", err: fmt.Errorf("unavailable")}
+	otherSink := &memorySink{name: "b"}
+	notifier := NewNotifier(server, Config{Contexts: []ContextConfig{{}}}, nil, sink, otherSink)
+	notifier.readToken = func() (string, error) { return "abc", nil }
+
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	sink.err = nil
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := len(sink.notifications), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+	// The notification is not sent again to the sink it was sent to.
+	if got, want := len(otherSink.notifications), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+}
+
+// failingSummariesServer fails to return the installed package summaries
+// when err is set.
+type failingSummariesServer struct {
+	summariesServer
+	err error
+}
+
+func (s *failingSummariesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.summariesServer.GetInstalledPackageSummaries(ctx, request)
+}
+
+func TestCheckForgetsRemovedPackages(t *testing.T) {
+	server := &failingSummariesServer{
+		summariesServer: summariesServer{
+			summaries: []*packages.InstalledPackageSummary{
+				installedPackageSummary("my-apache", "1.2.3", "1.2.5", "1.2.5"),
+				installedPackageSummary("my-redis", "1.0.0", "", "1.1.0"),
+			},
+		},
+	}
+	apacheKey := notificationKey(server.summaries[0].InstalledPackageRef, "a")
+	redisKey := notificationKey(server.summaries[1].InstalledPackageRef, "a")
+	removedSinkKey := notificationKey(server.summaries[1].InstalledPackageRef, "removed")
+	store := &memoryStore{notified: map[string]string{
+		removedSinkKey: "1.1.0",
+	}}
+	notifier := NewNotifier(server, Config{Contexts: []ContextConfig{{}}}, store, &memorySink{name: "a"})
+	notifier.readToken = func() (string, error) { return "abc", nil }
+
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := store.notified, map[string]string{apacheKey: "1.2.5", redisKey: "1.1.0"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// Nothing is forgotten while the installed packages cannot be listed.
+	server.summaries = server.summaries[:1]
+	server.err = status.Errorf(codes.Unavailable, "unavailable")
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := store.notified, map[string]string{apacheKey: "1.2.5", redisKey: "1.1.0"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	server.err = nil
+	if err := notifier.Check(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := store.notified, map[string]string{apacheKey: "1.2.5"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    string
+		expected  Config
+		expectErr bool
+	}{
+		{
+			name:   "it sets the defaults",
+			config: `webhooks: [{url: "https://example.com/hook"}]`,
+			expected: Config{
+				IntervalSeconds: DefaultIntervalSeconds,
+				TokenPath:       DefaultTokenPath,
+				Contexts:        []ContextConfig{{}},
+				Webhooks:        []WebhookConfig{{URL: "https://example.com/hook"}},
+			},
+		},
+		{
+			name: "it parses the webhooks",
+			config: `
+intervalSeconds: 60
+contexts:
+  - cluster: default
+    namespace: team-a
+webhooks:
+  - url: https://example.com/hook
+    headers:
+      Authorization: Bearer xyz
+    template: '{"text": {{ json .Name }}}'
+    upgradeTypes: ["minor", "major"]
+`,
+			expected: Config{
+				IntervalSeconds: 60,
+				TokenPath:       DefaultTokenPath,
+				Contexts:        []ContextConfig{{Cluster: "default", Namespace: "team-a"}},
+				Webhooks: []WebhookConfig{{
+					URL:          "https://example.com/hook",
+					Headers:      map[string]string{"Authorization": "Bearer xyz"},
+					Template:     `{"text": {{ json .Name }}}`,
+					UpgradeTypes: []string{"minor", "major"},
+				}},
+			},
+		},
+		{
+			name:      "it errors without the url of a webhook",
+			config:    `webhooks: [{template: "{{ .Name }}"}]`,
+			expectErr: true,
+		},
+		{
+			name:      "it errors with an invalid upgrade type",
+			config:    `webhooks: [{url: "https://example.com/hook", upgradeTypes: ["prerelease"]}]`,
+			expectErr: true,
+		},
+		{
+			name:      "it errors with an invalid template",
+			config:    `webhooks: [{url: "https://example.com/hook", template: "{{ .Name "}]`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "notifications.yaml")
+			if err := ioutil.WriteFile(path, []byte(tc.config), 0600); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			config, err := ParseConfig(path)
+			if got, want := err != nil, tc.expectErr; got != want {
+				t.Fatalf("got error: %v, want error: %t", err, want)
+			}
+			if tc.expectErr {
+				return
+			}
+			if got, want := config, tc.expected; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	notification := Notification{
+		Namespace:   "team-a",
+		Name:        "my-apache",
+		FromVersion: "1.2.3",
+		ToVersion:   "1.3.0",
+		UpgradeType: UpgradeTypeMinor,
+		Time:        testTime,
+	}
+
+	testCases := []struct {
+		name                string
+		config              WebhookConfig
+		expectedBody        string
+		expectedContentType string
+	}{
+		{
+			name:                "it posts the notification as JSON without a template",
+			expectedBody:        `{"plugin":"","cluster":"","namespace":"team-a","name":"my-apache","fromVersion":"1.2.3","toVersion":"1.3.0","upgradeType":"minor","matchesUpgradePolicy":false,"time":"2022-06-01T10:00:00Z"}`,
+			expectedContentType: "application/json",
+		},
+		{
+			name: "it posts the payload of the template",
+			config: WebhookConfig{
+				Template:    `{"text": {{ printf "%s %s is available (%s)" .Name .ToVersion .UpgradeType | json }}}`,
+				ContentType: "application/vnd.example+json",
+			},
+			expectedBody:        `{"text": "my-apache 1.3.0 is available (minor)"}`,
+			expectedContentType: "application/vnd.example+json",
+		},
+		{
+			name: "it does not post the upgrade types which are not notified",
+			config: WebhookConfig{
+				UpgradeTypes: []string{UpgradeTypeMajor},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var body, contentType, auth string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				body = string(b)
+				contentType = r.Header.Get("Content-Type")
+				auth = r.Header.Get("Authorization")
+			}))
+			defer ts.Close()
+
+			tc.config.URL = ts.URL
+			tc.config.Headers = map[string]string{"Authorization": "Bearer xyz"}
+			sink, err := NewWebhookSink(tc.config, nil)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if err := sink.Send(context.Background(), notification); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := body, tc.expectedBody; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := contentType, tc.expectedContentType; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if tc.expectedBody != "" && auth != "Bearer xyz" {
+				t.Errorf("got: %q, want: %q", auth, "Bearer xyz")
+			}
+		})
+	}
+}
+
+func TestConfigMapStore(t *testing.T) {
+	store := NewConfigMapStore(fake.NewSimpleClientset(), "kubeapps")
+
+	notified, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := notified, map[string]string{}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	for _, saved := range []map[string]string{{"a": "1.0.0"}, {"a": "1.1.0", "b": "2.0.0"}} {
+		if err := store.Save(context.Background(), saved); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		notified, err := store.Load(context.Background())
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := notified, saved; !cmp.Equal(want, got) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+	}
+}
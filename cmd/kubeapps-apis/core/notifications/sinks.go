@@ -0,0 +1,119 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+)
+
+// WebhookConfig is a destination of the notifications.
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Headers are added to each request, for instance to authenticate it.
+	Headers map[string]string `json:"headers"`
+	// Template is the Go template of the payload, executed with the
+	// Notification and the "json" function quoting a value as JSON. The
+	// payload is the JSON of the Notification when empty.
+	Template string `json:"template"`
+	// ContentType defaults to "application/json".
+	ContentType string `json:"contentType"`
+	// UpgradeTypes are the types of upgrades notified. All of them are
+	// notified when empty.
+	UpgradeTypes []string `json:"upgradeTypes"`
+}
+
+// webhookSink posts each notification to a URL.
+type webhookSink struct {
+	config   WebhookConfig
+	template *template.Template
+	client   *http.Client
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	return template.New("payload").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+}
+
+// NewWebhookSink returns a sink posting each notification to the URL of the
+// webhook, with the payload of its template.
+func NewWebhookSink(config WebhookConfig, client *http.Client) (Sink, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	t, err := parseTemplate(config.Template)
+	if err != nil {
+		return nil, err
+	}
+	if config.ContentType == "" {
+		config.ContentType = "application/json"
+	}
+	return &webhookSink{config: config, template: t, client: client}, nil
+}
+
+func (s *webhookSink) notifies(upgradeType string) bool {
+	if len(s.config.UpgradeTypes) == 0 {
+		return true
+	}
+	for _, t := range s.config.UpgradeTypes {
+		if t == upgradeType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *webhookSink) payload(notification Notification) ([]byte, error) {
+	if s.template == nil {
+		return json.Marshal(notification)
+	}
+	var buf bytes.Buffer
+	if err := s.template.Execute(&buf, notification); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Name is the URL of the webhook.
+func (s *webhookSink) Name() string {
+	return s.config.URL
+}
+
+func (s *webhookSink) Send(ctx context.Context, notification Notification) error {
+	if !s.notifies(notification.UpgradeType) {
+		return nil
+	}
+	body, err := s.payload(notification)
+	if err != nil {
+		return fmt.Errorf("unable to render the payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", s.config.ContentType)
+	for k, v := range s.config.Headers {
+		req.Header.Set(k, v)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("notifications webhook %q returned status %d", s.config.URL, res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// The ConfigMap storing the notified versions and the lease held by the
+	// replica of kubeapps-apis sending the notifications.
+	stateConfigMapName = "kubeapps-apis-notifications"
+	leaseName          = "kubeapps-apis-notifications"
+
+	notifiedKey = "notified.json"
+)
+
+// configMapStore persists the notified versions in a ConfigMap.
+type configMapStore struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+// NewConfigMapStore returns a store persisting the notified versions in a
+// ConfigMap of the namespace.
+func NewConfigMapStore(client kubernetes.Interface, namespace string) Store {
+	return &configMapStore{client: client, namespace: namespace}
+}
+
+func (s *configMapStore) Load(ctx context.Context) (map[string]string, error) {
+	notified := map[string]string{}
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, stateConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return notified, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if data, ok := cm.Data[notifiedKey]; ok {
+		if err := json.Unmarshal([]byte(data), &notified); err != nil {
+			return nil, err
+		}
+	}
+	return notified, nil
+}
+
+func (s *configMapStore) Save(ctx context.Context, notified map[string]string) error {
+	data, err := json.Marshal(notified)
+	if err != nil {
+		return err
+	}
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, stateConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      stateConfigMapName,
+				Namespace: s.namespace,
+			},
+			Data: map[string]string{notifiedKey: string(data)},
+		}, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[notifiedKey] = string(data)
+	_, err = s.client.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// RunWithLeaderElection runs the notifier on the replica of kubeapps-apis
+// holding the lease in the namespace, so that each notification is sent by
+// a single replica, until the context is done.
+func RunWithLeaderElection(ctx context.Context, n *Notifier, client kubernetes.Interface, namespace string) error {
+	return k8sutils.RunWithLeaderElection(ctx, client, namespace, leaseName, func(ctx context.Context) {
+		// The notified versions are loaded again, as another replica may
+		// have sent notifications meanwhile.
+		n.notified = nil
+		n.Run(ctx)
+	})
+}
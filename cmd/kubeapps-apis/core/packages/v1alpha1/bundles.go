@@ -0,0 +1,506 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/audit"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// The interval used by the flux plugin when none is requested.
+	defaultFluxInterval = "1m"
+
+	// The name of the Secret with the values of a Carvel PackageInstall, as
+	// created by the kapp-controller plugin and the kctrl CLI.
+	carvelValuesSecretName = "%s-%s-values"
+)
+
+// bundlesServer implements the API defined in proto/kubeappsapis/core/packages/v1alpha1/bundles.proto
+type bundlesServer struct {
+	packages.UnimplementedBundlesServiceServer
+
+	// packagesServer is the core packages server, used to route the requests
+	// for each installed package to its plugin.
+	packagesServer packages.PackagesServiceServer
+
+	// auditor records the installed packages created by an import, as these
+	// are not requested through the gRPC server. It is nil when the audit log
+	// is disabled.
+	auditor *audit.Auditor
+}
+
+func NewBundlesServer(packagesServer packages.PackagesServiceServer, auditor *audit.Auditor) *bundlesServer {
+	return &bundlesServer{
+		packagesServer: packagesServer,
+		auditor:        auditor,
+	}
+}
+
+// exportedPackage holds the details of an installed package needed to
+// generate its declarative definition.
+type exportedPackage struct {
+	ref       *packages.InstalledPackageReference
+	installed *packages.InstalledPackageDetail
+	available *packages.AvailablePackageDetail
+	version   string
+	values    map[string]interface{}
+	// repoName and chartName are only set for Helm charts.
+	repoName  string
+	chartName string
+}
+
+// ExportInstalledPackages returns the declarative definition of the requested installed packages.
+func (s bundlesServer) ExportInstalledPackages(ctx context.Context, request *packages.ExportInstalledPackagesRequest) (*packages.ExportInstalledPackagesResponse, error) {
+	log.Infof("+core ExportInstalledPackages (format=%s, packages=%d)", request.GetFormat(), len(request.GetInstalledPackageRefs()))
+
+	if len(request.GetInstalledPackageRefs()) == 0 {
+		return nil, statuserror.FieldErrorf("installed_package_refs", "At least one installed package is required")
+	}
+	if request.GetFormat() == packages.ExportInstalledPackagesRequest_EXPORT_FORMAT_UNSPECIFIED {
+		return nil, statuserror.FieldErrorf("format", "The export format is required")
+	}
+
+	exported := make([]exportedPackage, len(request.GetInstalledPackageRefs()))
+	for i, ref := range request.GetInstalledPackageRefs() {
+		pkg, err := s.getExportedPackage(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		isChart := pkg.chartName != ""
+		switch request.GetFormat() {
+		case packages.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX, packages.ExportInstalledPackagesRequest_EXPORT_FORMAT_HELMFILE:
+			if !isChart {
+				return nil, statuserror.FieldErrorf("format", "The installed package %q is not a Helm chart and cannot be exported as %s", ref.GetIdentifier(), request.GetFormat())
+			}
+		case packages.ExportInstalledPackagesRequest_EXPORT_FORMAT_CARVEL:
+			if isChart {
+				return nil, statuserror.FieldErrorf("format", "The installed package %q is a Helm chart and cannot be exported as %s", ref.GetIdentifier(), request.GetFormat())
+			}
+		default:
+			return nil, statuserror.FieldErrorf("format", "Unsupported export format %s", request.GetFormat())
+		}
+		exported[i] = *pkg
+	}
+
+	response := &packages.ExportInstalledPackagesResponse{
+		ExportedPackages: make([]*packages.ExportedPackage, len(exported)),
+		Packages:         make([]*packages.BundledPackage, len(exported)),
+	}
+	for i, pkg := range exported {
+		response.Packages[i] = &packages.BundledPackage{
+			Name:                  pkg.installed.GetName(),
+			AvailablePackageRef:   pkg.installed.GetAvailablePackageRef(),
+			PkgVersionReference:   pkg.installed.GetPkgVersionReference(),
+			Values:                pkg.installed.GetValuesApplied(),
+			ReconciliationOptions: pkg.installed.GetReconciliationOptions(),
+		}
+	}
+
+	if request.GetFormat() == packages.ExportInstalledPackagesRequest_EXPORT_FORMAT_HELMFILE {
+		for i, pkg := range exported {
+			manifest, err := helmfileManifest([]exportedPackage{pkg})
+			if err != nil {
+				return nil, err
+			}
+			response.ExportedPackages[i] = &packages.ExportedPackage{
+				InstalledPackageRef: pkg.ref,
+				Manifest:            manifest,
+			}
+		}
+		bundle, err := helmfileManifest(exported)
+		if err != nil {
+			return nil, err
+		}
+		response.Bundle = bundle
+		return response, nil
+	}
+
+	// Flux and Carvel definitions are multi-document YAML files. Resources
+	// shared by several packages, such as a HelmRepository, are only
+	// included once in the bundle.
+	bundleDocs := []string{}
+	seenDocs := map[string]bool{}
+	for i, pkg := range exported {
+		var objects []map[string]interface{}
+		if request.GetFormat() == packages.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX {
+			objects = fluxObjects(pkg)
+		} else {
+			objects = carvelObjects(pkg)
+		}
+		docs := make([]string, len(objects))
+		for j, obj := range objects {
+			doc, err := yaml.Marshal(obj)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Unable to marshal the definition of the installed package %q: %v", pkg.installed.GetName(), err)
+			}
+			docs[j] = string(doc)
+			if !seenDocs[docs[j]] {
+				seenDocs[docs[j]] = true
+				bundleDocs = append(bundleDocs, docs[j])
+			}
+		}
+		response.ExportedPackages[i] = &packages.ExportedPackage{
+			InstalledPackageRef: pkg.ref,
+			Manifest:            joinYAMLDocuments(docs),
+		}
+	}
+	response.Bundle = joinYAMLDocuments(bundleDocs)
+	return response, nil
+}
+
+// ImportInstalledPackages creates the installed packages of a bundle in the target context.
+func (s bundlesServer) ImportInstalledPackages(ctx context.Context, request *packages.ImportInstalledPackagesRequest) (*packages.ImportInstalledPackagesResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetTargetContext().GetCluster(), request.GetTargetContext().GetNamespace())
+	log.Infof("+core ImportInstalledPackages %s packages=%d", contextMsg, len(request.GetPackages()))
+
+	if request.GetTargetContext().GetNamespace() == "" {
+		return nil, statuserror.FieldErrorf("target_context.namespace", "The target namespace is required")
+	}
+	if len(request.GetPackages()) == 0 {
+		return nil, statuserror.FieldErrorf("packages", "At least one package is required")
+	}
+
+	// Each package is imported independently, so that a failure does not
+	// prevent the import of the rest, and the result reported for each.
+	results := make([]*packages.ImportedPackageResult, len(request.GetPackages()))
+	for i, pkg := range request.GetPackages() {
+		results[i] = s.importPackage(ctx, request.GetTargetContext(), pkg)
+	}
+	return &packages.ImportInstalledPackagesResponse{
+		Results: results,
+	}, nil
+}
+
+// importPackage creates the installed package of the bundle in the target
+// context, unless it already exists there.
+func (s bundlesServer) importPackage(ctx context.Context, targetContext *packages.Context, pkg *packages.BundledPackage) *packages.ImportedPackageResult {
+	result := &packages.ImportedPackageResult{
+		Name: pkg.GetName(),
+	}
+	failed := func(format string, a ...interface{}) *packages.ImportedPackageResult {
+		result.Status = packages.ImportedPackageResult_IMPORT_STATUS_FAILED
+		result.Message = fmt.Sprintf(format, a...)
+		log.Errorf("Unable to import the package %q: %s", pkg.GetName(), result.Message)
+		return result
+	}
+
+	if pkg.GetName() == "" {
+		return failed("The package has no name")
+	}
+	if pkg.GetAvailablePackageRef().GetPlugin() == nil {
+		return failed("The package has no available package reference with its plugin")
+	}
+
+	// The identifier of an installed package is its name for every plugin.
+	installedRef := &packages.InstalledPackageReference{
+		Context:    targetContext,
+		Identifier: pkg.GetName(),
+		Plugin:     pkg.GetAvailablePackageRef().GetPlugin(),
+	}
+	_, err := s.packagesServer.GetInstalledPackageDetail(ctx, &packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: installedRef,
+	})
+	if err == nil {
+		result.Status = packages.ImportedPackageResult_IMPORT_STATUS_ALREADY_EXISTS
+		result.InstalledPackageRef = installedRef
+		result.Message = "The installed package already exists in the target context"
+		return result
+	} else if status.Code(err) != codes.NotFound {
+		return failed("Unable to check whether the installed package exists: %v", status.Convert(err).Message())
+	}
+
+	availableRef, err := s.resolveAvailablePackageRef(ctx, targetContext, pkg.GetAvailablePackageRef())
+	if err != nil {
+		return failed("%v", status.Convert(err).Message())
+	}
+	result.AvailablePackageRef = availableRef
+
+	createRequest := &packages.CreateInstalledPackageRequest{
+		AvailablePackageRef:   availableRef,
+		TargetContext:         targetContext,
+		Name:                  pkg.GetName(),
+		PkgVersionReference:   pkg.GetPkgVersionReference(),
+		Values:                pkg.GetValues(),
+		ReconciliationOptions: pkg.GetReconciliationOptions(),
+	}
+	response, err := s.packagesServer.CreateInstalledPackage(ctx, createRequest)
+	s.auditor.RecordRequest(ctx, createRequest, response, err)
+	if status.Code(err) == codes.AlreadyExists {
+		// Created concurrently since it was checked above.
+		result.Status = packages.ImportedPackageResult_IMPORT_STATUS_ALREADY_EXISTS
+		result.InstalledPackageRef = installedRef
+		result.Message = "The installed package already exists in the target context"
+		return result
+	} else if err != nil {
+		return failed("%v", status.Convert(err).Message())
+	}
+
+	result.Status = packages.ImportedPackageResult_IMPORT_STATUS_CREATED
+	result.InstalledPackageRef = response.GetInstalledPackageRef()
+	return result
+}
+
+// resolveAvailablePackageRef returns the reference of the available package
+// to be installed in the target context. A repository in the target
+// namespace is preferred over the one of the bundle, which is usually a
+// global repository available in every namespace.
+func (s bundlesServer) resolveAvailablePackageRef(ctx context.Context, targetContext *packages.Context, bundledRef *packages.AvailablePackageReference) (*packages.AvailablePackageReference, error) {
+	contexts := []*packages.Context{targetContext}
+	if bundledContext := bundledRef.GetContext(); bundledContext != nil &&
+		(bundledContext.GetCluster() != targetContext.GetCluster() || bundledContext.GetNamespace() != targetContext.GetNamespace()) {
+		contexts = append(contexts, bundledContext)
+	}
+
+	var err error
+	for _, c := range contexts {
+		ref := &packages.AvailablePackageReference{
+			Context:    c,
+			Identifier: bundledRef.GetIdentifier(),
+			Plugin:     bundledRef.GetPlugin(),
+		}
+		var response *packages.GetAvailablePackageDetailResponse
+		response, err = s.packagesServer.GetAvailablePackageDetail(ctx, &packages.GetAvailablePackageDetailRequest{
+			AvailablePackageRef: ref,
+		})
+		if err == nil {
+			if resolvedRef := response.GetAvailablePackageDetail().GetAvailablePackageRef(); resolvedRef != nil {
+				return resolvedRef, nil
+			}
+			return ref, nil
+		}
+		log.Infof("The available package %q was not found in the namespace %q of the cluster %q: %v", ref.GetIdentifier(), c.GetNamespace(), c.GetCluster(), err)
+	}
+	return nil, statuserror.Wrapf(err, "Unable to find the available package %q in the target context nor in the one of the bundle: %v", bundledRef.GetIdentifier(), status.Convert(err).Message())
+}
+
+// getExportedPackage retrieves, through the plugin of the installed package,
+// the installed package and the available package it was installed from.
+func (s bundlesServer) getExportedPackage(ctx context.Context, ref *packages.InstalledPackageReference) (*exportedPackage, error) {
+	installedResponse, err := s.packagesServer.GetInstalledPackageDetail(ctx, &packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: ref,
+	})
+	if err != nil {
+		return nil, err
+	}
+	installed := installedResponse.GetInstalledPackageDetail()
+
+	version := installed.GetPkgVersionReference().GetVersion()
+	if version == "" {
+		version = installed.GetCurrentVersion().GetPkgVersion()
+	}
+
+	availableResponse, err := s.packagesServer.GetAvailablePackageDetail(ctx, &packages.GetAvailablePackageDetailRequest{
+		AvailablePackageRef: installed.GetAvailablePackageRef(),
+		PkgVersion:          installed.GetCurrentVersion().GetPkgVersion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	available := availableResponse.GetAvailablePackageDetail()
+
+	values := map[string]interface{}{}
+	if installed.GetValuesApplied() != "" {
+		if err := yaml.Unmarshal([]byte(installed.GetValuesApplied()), &values); err != nil {
+			return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonValuesInvalid, "Unable to parse the values of the installed package %q: %v", ref.GetIdentifier(), err)
+		}
+	}
+
+	pkg := &exportedPackage{
+		ref:       ref,
+		installed: installed,
+		available: available,
+		version:   version,
+		values:    values,
+	}
+
+	// Only Helm charts are served from a repository URL, with an identifier
+	// made of the repository and chart names.
+	if available.GetRepoUrl() != "" {
+		if repoName, chartName, err := pkgutils.SplitChartIdentifier(available.GetAvailablePackageRef().GetIdentifier()); err == nil {
+			pkg.repoName = repoName
+			pkg.chartName = chartName
+		}
+	}
+	return pkg, nil
+}
+
+// fluxObjects returns the HelmRepository and HelmRelease of a chart.
+func fluxObjects(pkg exportedPackage) []map[string]interface{} {
+	repoNamespace := pkg.available.GetAvailablePackageRef().GetContext().GetNamespace()
+	if repoNamespace == "" {
+		repoNamespace = pkg.installed.GetInstalledPackageRef().GetContext().GetNamespace()
+	}
+	repository := map[string]interface{}{
+		"apiVersion": "source.toolkit.fluxcd.io/v1beta2",
+		"kind":       "HelmRepository",
+		"metadata": map[string]interface{}{
+			"name":      pkg.repoName,
+			"namespace": repoNamespace,
+		},
+		"spec": map[string]interface{}{
+			"url":      pkg.available.GetRepoUrl(),
+			"interval": defaultFluxInterval,
+		},
+	}
+
+	chartSpec := map[string]interface{}{
+		"chart": pkg.chartName,
+		"sourceRef": map[string]interface{}{
+			"kind":      "HelmRepository",
+			"name":      pkg.repoName,
+			"namespace": repoNamespace,
+		},
+	}
+	if pkg.version != "" {
+		chartSpec["version"] = pkg.version
+	}
+	releaseSpec := map[string]interface{}{
+		"chart": map[string]interface{}{
+			"spec": chartSpec,
+		},
+		"interval": defaultFluxInterval,
+	}
+	if opts := pkg.installed.GetReconciliationOptions(); opts != nil {
+		if opts.GetInterval() > 0 {
+			releaseSpec["interval"] = fmt.Sprintf("%ds", opts.GetInterval())
+		}
+		if opts.GetSuspend() {
+			releaseSpec["suspend"] = true
+		}
+		if opts.GetServiceAccountName() != "" {
+			releaseSpec["serviceAccountName"] = opts.GetServiceAccountName()
+		}
+	}
+	if len(pkg.values) > 0 {
+		releaseSpec["values"] = pkg.values
+	}
+	release := map[string]interface{}{
+		"apiVersion": "helm.toolkit.fluxcd.io/v2beta1",
+		"kind":       "HelmRelease",
+		"metadata": map[string]interface{}{
+			"name":      pkg.installed.GetName(),
+			"namespace": pkg.installed.GetInstalledPackageRef().GetContext().GetNamespace(),
+		},
+		"spec": releaseSpec,
+	}
+	return []map[string]interface{}{repository, release}
+}
+
+// carvelObjects returns the PackageInstall of a Carvel package, preceded by
+// the Secret with its values, if any.
+func carvelObjects(pkg exportedPackage) []map[string]interface{} {
+	name := pkg.installed.GetName()
+	namespace := pkg.installed.GetInstalledPackageRef().GetContext().GetNamespace()
+	objects := []map[string]interface{}{}
+
+	packageRef := map[string]interface{}{
+		"refName": pkg.available.GetAvailablePackageRef().GetIdentifier(),
+	}
+	if pkg.version != "" {
+		packageRef["versionSelection"] = map[string]interface{}{
+			"constraints": pkg.version,
+		}
+	}
+	spec := map[string]interface{}{
+		"packageRef": packageRef,
+	}
+	if opts := pkg.installed.GetReconciliationOptions(); opts != nil {
+		if opts.GetInterval() > 0 {
+			spec["syncPeriod"] = fmt.Sprintf("%ds", opts.GetInterval())
+		}
+		if opts.GetSuspend() {
+			spec["paused"] = true
+		}
+		if opts.GetServiceAccountName() != "" {
+			spec["serviceAccountName"] = opts.GetServiceAccountName()
+		}
+	}
+
+	if len(pkg.values) > 0 {
+		secretName := fmt.Sprintf(carvelValuesSecretName, name, namespace)
+		objects = append(objects, map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":      secretName,
+				"namespace": namespace,
+			},
+			"type": "Opaque",
+			"stringData": map[string]interface{}{
+				"values.yaml": pkg.installed.GetValuesApplied(),
+			},
+		})
+		spec["values"] = []interface{}{
+			map[string]interface{}{
+				"secretRef": map[string]interface{}{
+					"name": secretName,
+				},
+			},
+		}
+	}
+
+	objects = append(objects, map[string]interface{}{
+		"apiVersion": "packaging.carvel.dev/v1alpha1",
+		"kind":       "PackageInstall",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": spec,
+	})
+	return objects
+}
+
+// helmfileManifest returns a helmfile with a release for each chart and the
+// repositories they are installed from.
+func helmfileManifest(pkgs []exportedPackage) (string, error) {
+	repositories := []interface{}{}
+	seenRepos := map[string]bool{}
+	releases := []interface{}{}
+	for _, pkg := range pkgs {
+		if !seenRepos[pkg.repoName] {
+			seenRepos[pkg.repoName] = true
+			repositories = append(repositories, map[string]interface{}{
+				"name": pkg.repoName,
+				"url":  pkg.available.GetRepoUrl(),
+			})
+		}
+		release := map[string]interface{}{
+			"name":      pkg.installed.GetName(),
+			"namespace": pkg.installed.GetInstalledPackageRef().GetContext().GetNamespace(),
+			"chart":     fmt.Sprintf("%s/%s", pkg.repoName, pkg.chartName),
+		}
+		if pkg.version != "" {
+			release["version"] = pkg.version
+		}
+		if len(pkg.values) > 0 {
+			release["values"] = []interface{}{pkg.values}
+		}
+		releases = append(releases, release)
+	}
+
+	helmfile, err := yaml.Marshal(map[string]interface{}{
+		"repositories": repositories,
+		"releases":     releases,
+	})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to marshal the helmfile: %v", err)
+	}
+	return string(helmfile), nil
+}
+
+func joinYAMLDocuments(docs []string) string {
+	return strings.Join(docs, "---\n")
+}
@@ -0,0 +1,536 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/audit"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditSink keeps the audit events written to it.
+type auditSink struct {
+	events []audit.Event
+}
+
+func (s *auditSink) Write(ctx context.Context, event audit.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+// makeCarvelTestPackagingPlugin returns a plugin whose packages are not Helm
+// charts, as they are not served from a repository URL.
+func makeCarvelTestPackagingPlugin(pluginName string) pkgPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+
+	packagingPluginServer.AvailablePackageDetail = plugin_test.MakeAvailablePackageDetail("tetris", pluginDetails)
+	packagingPluginServer.AvailablePackageDetail.AvailablePackageRef.Identifier = "tetris.example.com"
+	packagingPluginServer.AvailablePackageDetail.RepoUrl = ""
+	packagingPluginServer.InstalledPackageDetail = plugin_test.MakeInstalledPackageDetail("tetris", pluginDetails)
+	packagingPluginServer.InstalledPackageDetail.ReconciliationOptions = &corev1.ReconciliationOptions{
+		Interval:           30,
+		ServiceAccountName: "default",
+	}
+
+	return pkgPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+func TestExportInstalledPackages(t *testing.T) {
+	mockedCarvelPackagingPlugin := makeCarvelTestPackagingPlugin("carvel")
+	installedPackageRef := func(plugin *plugins.Plugin) *corev1.InstalledPackageReference {
+		return &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   "",
+				Namespace: plugin_test.DefaultReleaseNamespace,
+			},
+			Identifier: plugin_test.DefaultReleaseName,
+			Plugin:     plugin,
+		}
+	}
+
+	bundledPackage := func(pluginWithServer pkgPluginWithServer) *corev1.BundledPackage {
+		installed := pluginWithServer.server.(*plugin_test.TestPackagingPluginServer).InstalledPackageDetail
+		return &corev1.BundledPackage{
+			Name:                  plugin_test.DefaultReleaseName,
+			AvailablePackageRef:   installed.AvailablePackageRef,
+			PkgVersionReference:   &corev1.VersionReference{Version: plugin_test.DefaultReleaseVersion},
+			Values:                plugin_test.DefaultReleaseValues,
+			ReconciliationOptions: installed.ReconciliationOptions,
+		}
+	}
+
+	fluxManifest := `apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: HelmRepository
+metadata:
+  name: repo-1
+  namespace: my-namespace-1
+spec:
+  interval: 1m
+  url: https://example.com/repo
+---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: my-release-1
+  namespace: my-release-namespace-1
+spec:
+  chart:
+    spec:
+      chart: package-id
+      sourceRef:
+        kind: HelmRepository
+        name: repo-1
+        namespace: my-namespace-1
+      version: 1.2.3
+  interval: 1m
+  values:
+    value: new
+`
+
+	helmfileManifest := `releases:
+- chart: repo-1/package-id
+  name: my-release-1
+  namespace: my-release-namespace-1
+  values:
+  - value: new
+  version: 1.2.3
+repositories:
+- name: repo-1
+  url: https://example.com/repo
+`
+
+	carvelManifest := `apiVersion: v1
+kind: Secret
+metadata:
+  name: my-release-1-my-release-namespace-1-values
+  namespace: my-release-namespace-1
+stringData:
+  values.yaml: '{"value":"new"}'
+type: Opaque
+---
+apiVersion: packaging.carvel.dev/v1alpha1
+kind: PackageInstall
+metadata:
+  name: my-release-1
+  namespace: my-release-namespace-1
+spec:
+  packageRef:
+    refName: tetris.example.com
+    versionSelection:
+      constraints: 1.2.3
+  serviceAccountName: default
+  syncPeriod: 30s
+  values:
+  - secretRef:
+      name: my-release-1-my-release-namespace-1-values
+`
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		request           *corev1.ExportInstalledPackagesRequest
+		expectedResponse  *corev1.ExportInstalledPackagesResponse
+		expectedStatus    codes.Code
+		expectedReason    string
+	}{
+		{
+			name:              "it exports a chart as a Flux HelmRelease and HelmRepository",
+			configuredPlugins: []pkgPluginWithServer{mockedPackagingPlugin1},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedPackagingPlugin1.plugin)},
+				Format:               corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX,
+			},
+			expectedResponse: &corev1.ExportInstalledPackagesResponse{
+				ExportedPackages: []*corev1.ExportedPackage{
+					{InstalledPackageRef: installedPackageRef(mockedPackagingPlugin1.plugin), Manifest: fluxManifest},
+				},
+				Bundle:   fluxManifest,
+				Packages: []*corev1.BundledPackage{bundledPackage(mockedPackagingPlugin1)},
+			},
+		},
+		{
+			name:              "it includes a HelmRepository shared by several packages only once in the bundle",
+			configuredPlugins: []pkgPluginWithServer{mockedPackagingPlugin1, mockedPackagingPlugin2},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{
+					installedPackageRef(mockedPackagingPlugin1.plugin),
+					installedPackageRef(mockedPackagingPlugin2.plugin),
+				},
+				Format: corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX,
+			},
+			expectedResponse: &corev1.ExportInstalledPackagesResponse{
+				ExportedPackages: []*corev1.ExportedPackage{
+					{InstalledPackageRef: installedPackageRef(mockedPackagingPlugin1.plugin), Manifest: fluxManifest},
+					{InstalledPackageRef: installedPackageRef(mockedPackagingPlugin2.plugin), Manifest: fluxManifest},
+				},
+				Bundle:   fluxManifest,
+				Packages: []*corev1.BundledPackage{bundledPackage(mockedPackagingPlugin1), bundledPackage(mockedPackagingPlugin2)},
+			},
+		},
+		{
+			name:              "it exports a chart as a helmfile",
+			configuredPlugins: []pkgPluginWithServer{mockedPackagingPlugin1},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedPackagingPlugin1.plugin)},
+				Format:               corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_HELMFILE,
+			},
+			expectedResponse: &corev1.ExportInstalledPackagesResponse{
+				ExportedPackages: []*corev1.ExportedPackage{
+					{InstalledPackageRef: installedPackageRef(mockedPackagingPlugin1.plugin), Manifest: helmfileManifest},
+				},
+				Bundle:   helmfileManifest,
+				Packages: []*corev1.BundledPackage{bundledPackage(mockedPackagingPlugin1)},
+			},
+		},
+		{
+			name:              "it exports a Carvel package as a PackageInstall and its values Secret",
+			configuredPlugins: []pkgPluginWithServer{mockedCarvelPackagingPlugin},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedCarvelPackagingPlugin.plugin)},
+				Format:               corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_CARVEL,
+			},
+			expectedResponse: &corev1.ExportInstalledPackagesResponse{
+				ExportedPackages: []*corev1.ExportedPackage{
+					{InstalledPackageRef: installedPackageRef(mockedCarvelPackagingPlugin.plugin), Manifest: carvelManifest},
+				},
+				Bundle:   carvelManifest,
+				Packages: []*corev1.BundledPackage{bundledPackage(mockedCarvelPackagingPlugin)},
+			},
+		},
+		{
+			name:              "it returns an invalid argument error when exporting a chart as a PackageInstall",
+			configuredPlugins: []pkgPluginWithServer{mockedPackagingPlugin1},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedPackagingPlugin1.plugin)},
+				Format:               corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_CARVEL,
+			},
+			expectedStatus: codes.InvalidArgument,
+			expectedReason: statuserror.ReasonInvalidField,
+		},
+		{
+			name:              "it returns an invalid argument error when exporting a Carvel package as a HelmRelease",
+			configuredPlugins: []pkgPluginWithServer{mockedCarvelPackagingPlugin},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedCarvelPackagingPlugin.plugin)},
+				Format:               corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX,
+			},
+			expectedStatus: codes.InvalidArgument,
+			expectedReason: statuserror.ReasonInvalidField,
+		},
+		{
+			name:              "it returns an invalid argument error without installed packages",
+			configuredPlugins: []pkgPluginWithServer{mockedPackagingPlugin1},
+			request: &corev1.ExportInstalledPackagesRequest{
+				Format: corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX,
+			},
+			expectedStatus: codes.InvalidArgument,
+			expectedReason: statuserror.ReasonInvalidField,
+		},
+		{
+			name:              "it returns an invalid argument error without a format",
+			configuredPlugins: []pkgPluginWithServer{mockedPackagingPlugin1},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedPackagingPlugin1.plugin)},
+			},
+			expectedStatus: codes.InvalidArgument,
+			expectedReason: statuserror.ReasonInvalidField,
+		},
+		{
+			name:              "it returns the error of the plugin when the installed package is not found",
+			configuredPlugins: []pkgPluginWithServer{mockedNotFoundPackagingPlugin},
+			request: &corev1.ExportInstalledPackagesRequest{
+				InstalledPackageRefs: []*corev1.InstalledPackageReference{installedPackageRef(mockedNotFoundPackagingPlugin.plugin)},
+				Format:               corev1.ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX,
+			},
+			expectedStatus: codes.NotFound,
+		},
+	}
+
+	ignoreUnexported := []cmp.Option{
+		ignoreUnexportedOpts,
+		cmpopts.IgnoreUnexported(corev1.ExportInstalledPackagesResponse{}, corev1.ExportedPackage{}, corev1.BundledPackage{}, corev1.ReconciliationOptions{}),
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewBundlesServer(&packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}, nil)
+
+			response, err := server.ExportInstalledPackages(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatus; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedReason != "" {
+				if got, want := statuserror.Reason(err), tc.expectedReason; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			}
+			if tc.expectedStatus != codes.OK {
+				return
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexported...) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported...))
+			}
+		})
+	}
+}
+
+// importTestPackagingPluginServer is a plugin with the given installed
+// packages and whose available packages are only in the given namespace.
+type importTestPackagingPluginServer struct {
+	*plugin_test.TestPackagingPluginServer
+	installedPackages  []string
+	availableNamespace string
+}
+
+func (s importTestPackagingPluginServer) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
+	for _, name := range s.installedPackages {
+		if name == request.GetInstalledPackageRef().GetIdentifier() {
+			return s.TestPackagingPluginServer.GetInstalledPackageDetail(ctx, request)
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "Installed package not found")
+}
+
+func (s importTestPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
+	if request.GetAvailablePackageRef().GetContext().GetNamespace() != s.availableNamespace {
+		return nil, status.Errorf(codes.NotFound, "Available package not found")
+	}
+	return &corev1.GetAvailablePackageDetailResponse{
+		AvailablePackageDetail: &corev1.AvailablePackageDetail{
+			AvailablePackageRef: request.GetAvailablePackageRef(),
+		},
+	}, nil
+}
+
+func makeImportTestPackagingPlugin(pluginName, availableNamespace string, installedPackages ...string) pkgPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+	packagingPluginServer.InstalledPackageDetail = plugin_test.MakeInstalledPackageDetail("pkg-1", pluginDetails)
+
+	return pkgPluginWithServer{
+		plugin: pluginDetails,
+		server: importTestPackagingPluginServer{
+			TestPackagingPluginServer: packagingPluginServer,
+			installedPackages:         installedPackages,
+			availableNamespace:        availableNamespace,
+		},
+	}
+}
+
+func TestImportInstalledPackages(t *testing.T) {
+	const globalNamespace = "kubeapps"
+	targetContext := &corev1.Context{Cluster: "staging", Namespace: "my-ns"}
+	plugin := &plugins.Plugin{Name: "mock", Version: "v1alpha1"}
+	bundledPackage := &corev1.BundledPackage{
+		Name: "my-release",
+		AvailablePackageRef: &corev1.AvailablePackageReference{
+			Context:    &corev1.Context{Cluster: "dev", Namespace: globalNamespace},
+			Identifier: plugin_test.DefaultId,
+			Plugin:     plugin,
+		},
+		PkgVersionReference: &corev1.VersionReference{Version: plugin_test.DefaultPkgVersion},
+		Values:              plugin_test.DefaultReleaseValues,
+	}
+	installedPackageRef := &corev1.InstalledPackageReference{
+		Context:    targetContext,
+		Identifier: "my-release",
+		Plugin:     plugin,
+	}
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		request           *corev1.ImportInstalledPackagesRequest
+		expectedResponse  *corev1.ImportInstalledPackagesResponse
+		expectedAudited   []string
+		expectedStatus    codes.Code
+	}{
+		{
+			name:              "it creates the installed package from an available package in the target namespace",
+			configuredPlugins: []pkgPluginWithServer{makeImportTestPackagingPlugin("mock", targetContext.Namespace)},
+			request: &corev1.ImportInstalledPackagesRequest{
+				TargetContext: targetContext,
+				Packages:      []*corev1.BundledPackage{bundledPackage},
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportedPackageResult{
+					{
+						Name:                "my-release",
+						Status:              corev1.ImportedPackageResult_IMPORT_STATUS_CREATED,
+						InstalledPackageRef: installedPackageRef,
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    targetContext,
+							Identifier: plugin_test.DefaultId,
+							Plugin:     plugin,
+						},
+					},
+				},
+			},
+			expectedAudited: []string{"my-ns/my-release: success"},
+		},
+		{
+			name:              "it creates the installed package from the available package of the bundle when not in the target namespace",
+			configuredPlugins: []pkgPluginWithServer{makeImportTestPackagingPlugin("mock", globalNamespace)},
+			request: &corev1.ImportInstalledPackagesRequest{
+				TargetContext: targetContext,
+				Packages:      []*corev1.BundledPackage{bundledPackage},
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportedPackageResult{
+					{
+						Name:                "my-release",
+						Status:              corev1.ImportedPackageResult_IMPORT_STATUS_CREATED,
+						InstalledPackageRef: installedPackageRef,
+						AvailablePackageRef: bundledPackage.AvailablePackageRef,
+					},
+				},
+			},
+			expectedAudited: []string{"my-ns/my-release: success"},
+		},
+		{
+			name:              "it leaves an existing installed package untouched",
+			configuredPlugins: []pkgPluginWithServer{makeImportTestPackagingPlugin("mock", globalNamespace, "my-release")},
+			request: &corev1.ImportInstalledPackagesRequest{
+				TargetContext: targetContext,
+				Packages:      []*corev1.BundledPackage{bundledPackage},
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportedPackageResult{
+					{
+						Name:                "my-release",
+						Status:              corev1.ImportedPackageResult_IMPORT_STATUS_ALREADY_EXISTS,
+						InstalledPackageRef: installedPackageRef,
+					},
+				},
+			},
+			expectedAudited: []string{},
+		},
+		{
+			name:              "it reports a failure for each package which cannot be imported, and imports the rest",
+			configuredPlugins: []pkgPluginWithServer{makeImportTestPackagingPlugin("mock", "other-ns")},
+			request: &corev1.ImportInstalledPackagesRequest{
+				TargetContext: targetContext,
+				Packages: []*corev1.BundledPackage{
+					bundledPackage,
+					{Name: "no-plugin"},
+					{
+						Name: "other-release",
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Namespace: "other-ns"},
+							Identifier: plugin_test.DefaultId,
+							Plugin:     plugin,
+						},
+					},
+				},
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportedPackageResult{
+					{
+						Name:   "my-release",
+						Status: corev1.ImportedPackageResult_IMPORT_STATUS_FAILED,
+					},
+					{
+						Name:   "no-plugin",
+						Status: corev1.ImportedPackageResult_IMPORT_STATUS_FAILED,
+					},
+					{
+						Name:   "other-release",
+						Status: corev1.ImportedPackageResult_IMPORT_STATUS_CREATED,
+						InstalledPackageRef: &corev1.InstalledPackageReference{
+							Context:    targetContext,
+							Identifier: "other-release",
+							Plugin:     plugin,
+						},
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Namespace: "other-ns"},
+							Identifier: plugin_test.DefaultId,
+							Plugin:     plugin,
+						},
+					},
+				},
+			},
+			expectedAudited: []string{"my-ns/other-release: success"},
+		},
+		{
+			name:              "it returns an invalid argument error without a target namespace",
+			configuredPlugins: []pkgPluginWithServer{makeImportTestPackagingPlugin("mock", globalNamespace)},
+			request: &corev1.ImportInstalledPackagesRequest{
+				Packages: []*corev1.BundledPackage{bundledPackage},
+			},
+			expectedStatus: codes.InvalidArgument,
+		},
+		{
+			name:              "it returns an invalid argument error without packages",
+			configuredPlugins: []pkgPluginWithServer{makeImportTestPackagingPlugin("mock", globalNamespace)},
+			request: &corev1.ImportInstalledPackagesRequest{
+				TargetContext: targetContext,
+			},
+			expectedStatus: codes.InvalidArgument,
+		},
+	}
+
+	ignoreUnexported := []cmp.Option{
+		ignoreUnexportedOpts,
+		cmpopts.IgnoreUnexported(corev1.ImportInstalledPackagesResponse{}, corev1.ImportedPackageResult{}),
+		cmpopts.IgnoreFields(corev1.ImportedPackageResult{}, "Message"),
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &auditSink{}
+			auditor := audit.NewAuditor(nil, sink)
+			server := NewBundlesServer(&packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}, auditor)
+
+			response, err := server.ImportInstalledPackages(context.Background(), tc.request)
+			auditor.Close()
+
+			if got, want := status.Code(err), tc.expectedStatus; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedStatus != codes.OK {
+				return
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexported...) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported...))
+			}
+			for _, result := range response.GetResults() {
+				if result.GetStatus() != corev1.ImportedPackageResult_IMPORT_STATUS_CREATED && result.GetMessage() == "" {
+					t.Errorf("expected a message for the result of %q", result.GetName())
+				}
+			}
+
+			// An audit event is recorded for each installed package which
+			// the import attempted to create.
+			audited := []string{}
+			for _, event := range sink.events {
+				if event.Action != "CreateInstalledPackage" {
+					t.Errorf("got: %q, want: %q", event.Action, "CreateInstalledPackage")
+				}
+				audited = append(audited, fmt.Sprintf("%s/%s: %s", event.Namespace, event.Identifier, event.Outcome))
+			}
+			if got, want := audited, tc.expectedAudited; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	pluginsv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/plugins/v1alpha1"
 	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -67,6 +68,7 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 
 	pkgs := []*packages.AvailablePackageSummary{}
 	categories := []string{}
+	var facets *packages.AvailablePackageFacets
 	var pkgWithOffsets summaryWithOffsets
 	for pkgWithOffsets = range summariesWithOffsets {
 		if pkgWithOffsets.err != nil {
@@ -74,6 +76,7 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 		}
 		pkgs = append(pkgs, pkgWithOffsets.availablePackageSummary)
 		categories = append(categories, pkgWithOffsets.categories...)
+		facets = pkgWithOffsets.facets
 		if pageSize > 0 && len(pkgs) >= int(pageSize) {
 			break
 		}
@@ -96,9 +99,16 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 	// Delete duplicate categories and sort by name
 	From(categories).Distinct().OrderBy(func(i interface{}) interface{} { return i }).ToSlice(&categories)
 
+	// The plugins only return facets with their first page of results, which
+	// are only all requested for the first page of the combined results.
+	if request.GetPaginationOptions().GetPageToken() != "" {
+		facets = nil
+	}
+
 	return &packages.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: pkgs,
 		Categories:                categories,
+		Facets:                    facets,
 		NextPageToken:             nextPageToken,
 	}, nil
 }
@@ -109,19 +119,19 @@ func (s packagesServer) GetAvailablePackageDetail(ctx context.Context, request *
 	log.Infof("+core GetAvailablePackageDetail %s", contextMsg)
 
 	if request.GetAvailablePackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("available_package_ref.plugin", "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetAvailablePackageDetail(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to get the available package detail for the package %q using the plugin %q: %v", request.AvailablePackageRef.Identifier, request.AvailablePackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to get the available package detail for the package %q using the plugin %q: %v", request.AvailablePackageRef.Identifier, request.AvailablePackageRef.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -140,61 +150,91 @@ func (s packagesServer) GetInstalledPackageSummaries(ctx context.Context, reques
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
 	log.Infof("+core GetInstalledPackageSummaries %s", contextMsg)
 
-	// TODO (gfichtenholt) what about request.PaginationOptions?
-	// I think logic similar to GetAvailablePackageSummaries is missing here
+	pageSize := request.GetPaginationOptions().GetPageSize()
+
+	summariesWithOffsets, err := fanInInstalledPackageSummaries(ctx, s.pluginsWithServers, request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to request results from registered plugins: %v", err)
+	}
 
-	// Aggregate the response for each plugin
 	pkgs := []*packages.InstalledPackageSummary{}
-	// TODO: We can do these in parallel in separate go routines.
-	for _, p := range s.pluginsWithServers {
-		response, err := p.server.GetInstalledPackageSummaries(ctx, request)
-		if err != nil {
-			return nil, status.Errorf(status.Convert(err).Code(), "Invalid GetInstalledPackageSummaries response from the plugin %v: %v", p.plugin.Name, err)
+	var pkgWithOffsets installedSummaryWithOffsets
+	for pkgWithOffsets = range summariesWithOffsets {
+		if pkgWithOffsets.err != nil {
+			return nil, statuserror.Wrapf(pkgWithOffsets.err, "Invalid GetInstalledPackageSummaries response from the plugins: %v", pkgWithOffsets.err)
 		}
+		pkgs = append(pkgs, pkgWithOffsets.installedPackageSummary)
+		if pageSize > 0 && len(pkgs) >= int(pageSize) {
+			break
+		}
+	}
 
-		// Add the plugin for the pkgs
-		pluginPkgs := response.InstalledPackageSummaries
-		for _, r := range pluginPkgs {
-			if r.InstalledPackageRef == nil {
-				r.InstalledPackageRef = &packages.InstalledPackageReference{}
+	// Only return a next page token of the combined plugin offsets if at least one
+	// plugin is not completely exhausted.
+	nextPageToken := ""
+	for _, v := range pkgWithOffsets.nextItemOffsets {
+		if v != CompleteToken {
+			token, err := json.Marshal(pkgWithOffsets.nextItemOffsets)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Unable to marshal next item offsets %v: %s", pkgWithOffsets.nextItemOffsets, err)
 			}
-			r.InstalledPackageRef.Plugin = p.plugin
+			nextPageToken = string(token)
+			break
 		}
-		pkgs = append(pkgs, pluginPkgs...)
 	}
 
-	From(pkgs).
-		// Order by package name, regardless of the plugin
-		OrderBy(func(pkg interface{}) interface{} {
-			return pkg.(*packages.InstalledPackageSummary).Name + pkg.(*packages.InstalledPackageSummary).InstalledPackageRef.Plugin.Name
-		}).
-		ToSlice(&pkgs)
-
 	// Build the response
 	return &packages.GetInstalledPackageSummariesResponse{
 		InstalledPackageSummaries: pkgs,
+		NextPageToken:             nextPageToken,
 	}, nil
 }
 
+// WatchInstalledPackageSummaries streams the installed package events from
+// all the plugins based on the request.
+func (s packagesServer) WatchInstalledPackageSummaries(request *packages.WatchInstalledPackageSummariesRequest, stream packages.PackagesService_WatchInstalledPackageSummariesServer) error {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+core WatchInstalledPackageSummaries %s", contextMsg)
+
+	events, cancel := fanInInstalledPackageEvents(stream.Context(), stream, s.pluginsWithServers, request)
+	defer cancel()
+
+	for event := range events {
+		if event.err != nil {
+			return statuserror.Wrapf(event.err, "Unable to watch the installed packages using the plugin %q: %v", event.plugin.Name, event.err)
+		}
+		if summary := event.response.GetInstalledPackageSummary(); summary != nil {
+			if summary.InstalledPackageRef == nil {
+				summary.InstalledPackageRef = &packages.InstalledPackageReference{}
+			}
+			summary.InstalledPackageRef.Plugin = event.plugin
+		}
+		if err := stream.Send(event.response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetInstalledPackageDetail returns the package versions based on the request.
 func (s packagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
 	log.Infof("+core GetInstalledPackageDetail %s", contextMsg)
 
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetInstalledPackageDetail(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to get the installed package detail for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to get the installed package detail for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -214,19 +254,19 @@ func (s packagesServer) GetAvailablePackageVersions(ctx context.Context, request
 	log.Infof("+core GetAvailablePackageVersions %s", contextMsg)
 
 	if request.GetAvailablePackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("available_package_ref.plugin", "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetAvailablePackageVersions(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to get the available package versions for the package %q using the plugin %q: %v", request.AvailablePackageRef.Identifier, request.AvailablePackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to get the available package versions for the package %q using the plugin %q: %v", request.AvailablePackageRef.Identifier, request.AvailablePackageRef.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -249,19 +289,101 @@ func (s *packagesServer) GetInstalledPackageResourceRefs(ctx context.Context, re
 	log.Infof("+core GetInstalledPackageResourceRefs %s %s", contextMsg, identifier)
 
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin %v", request.InstalledPackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.InvalidArgument, statuserror.ReasonPluginNotFound, "Unable to retrieve the plugin %v", request.InstalledPackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetInstalledPackageResourceRefs(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to get the resource refs for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to get the resource refs for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// GetInstalledPackageRevisions returns the revisions of an installed package using configured plugins.
+func (s packagesServer) GetInstalledPackageRevisions(ctx context.Context, request *packages.GetInstalledPackageRevisionsRequest) (*packages.GetInstalledPackageRevisionsResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetInstalledPackageRevisions %s %s", contextMsg, request.GetInstalledPackageRef().GetIdentifier())
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageRevisions(ctx, request)
+	if err != nil {
+		return nil, statuserror.Wrapf(err, "Unable to get the revisions for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// RollbackInstalledPackage rolls back an installed package to a previous revision using configured plugins.
+func (s packagesServer) RollbackInstalledPackage(ctx context.Context, request *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core RollbackInstalledPackage %s %s revision=%d", contextMsg, request.GetInstalledPackageRef().GetIdentifier(), request.GetRevision())
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+	if request.GetRevision() <= 0 {
+		return nil, statuserror.FieldErrorf("revision", "Invalid revision %d, the revision to which to rollback must be positive", request.GetRevision())
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.RollbackInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, statuserror.Wrapf(err, "Unable to rollback the installed package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid RollbackInstalledPackage response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// CheckInstalledPackagePermissions returns the actions which the user is not
+// allowed to perform to create or update an installed package, using the
+// plugin of the installed package.
+func (s packagesServer) CheckInstalledPackagePermissions(ctx context.Context, request *packages.CheckInstalledPackagePermissionsRequest) (*packages.CheckInstalledPackagePermissionsResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core CheckInstalledPackagePermissions %s", contextMsg)
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.CheckInstalledPackagePermissions(ctx, request)
+	if err != nil {
+		return nil, statuserror.Wrapf(err, "Unable to check the permissions for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
 	}
 
 	return response, nil
@@ -273,19 +395,19 @@ func (s packagesServer) CreateInstalledPackage(ctx context.Context, request *pac
 	log.Infof("+core CreateInstalledPackage %s", contextMsg)
 
 	if request.GetAvailablePackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("available_package_ref.plugin", "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.CreateInstalledPackage(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to create the installed package for the package %q using the plugin %q: %v", request.AvailablePackageRef.Identifier, request.AvailablePackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to create the installed package for the package %q using the plugin %q: %v", request.AvailablePackageRef.Identifier, request.AvailablePackageRef.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -302,19 +424,19 @@ func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *pac
 	log.Infof("+core UpdateInstalledPackage %s", contextMsg)
 
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.UpdateInstalledPackage(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to update the installed package for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to update the installed package for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -325,25 +447,56 @@ func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *pac
 	return response, nil
 }
 
+// GetInstalledPackageUpdatePreview returns the resource changes for an update using configured plugins.
+func (s packagesServer) GetInstalledPackageUpdatePreview(ctx context.Context, request *packages.GetInstalledPackageUpdatePreviewRequest) (*packages.GetInstalledPackageUpdatePreviewResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
+	log.Infof("+core GetInstalledPackageUpdatePreview %s", contextMsg)
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageUpdatePreview(ctx, request)
+	if err != nil {
+		return nil, statuserror.Wrapf(err, "Unable to preview the update for the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	for _, change := range response.ResourceChanges {
+		if change.ResourceRef == nil {
+			return nil, status.Errorf(codes.Internal, "Invalid GetInstalledPackageUpdatePreview response from the plugin %v: missing resource ref", pluginWithServer.plugin.Name)
+		}
+	}
+
+	return response, nil
+}
+
 // DeleteInstalledPackage deletes an installed package using configured plugins.
 func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
 	log.Infof("+core DeleteInstalledPackage %s", contextMsg)
 
 	if request.GetInstalledPackageRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+		return nil, statuserror.FieldErrorf("installed_package_ref.plugin", "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.DeleteInstalledPackage(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to delete the installed packagefor the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to delete the installed packagefor the package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
 	}
 
 	return response, nil
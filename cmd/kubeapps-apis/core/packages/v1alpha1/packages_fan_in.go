@@ -6,18 +6,51 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/summaries"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
 )
 
 const CompleteToken = -1
 
+var tracer = otel.Tracer("github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/packages/v1alpha1")
+
+// startPluginSpan starts the span of a request sent to a plugin from one of
+// the fan-in go-routines, so that the time spent in each plugin is visible in
+// the trace of the core request.
+func startPluginSpan(ctx context.Context, plugin *v1alpha1.Plugin, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, fmt.Sprintf("%s/%s", plugin.GetName(), method), trace.WithAttributes(
+		attribute.String("kubeapps.plugin.name", plugin.GetName()),
+		attribute.String("kubeapps.plugin.version", plugin.GetVersion()),
+	))
+}
+
+// endPluginSpan records the error, if any, and ends the span.
+func endPluginSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
 // summaryWithOffsets is the channel type for the results of the combined
 // core results after fanning in from the plugins.
 type summaryWithOffsets struct {
 	availablePackageSummary *packages.AvailablePackageSummary
 	categories              []string
+	facets                  *packages.AvailablePackageFacets
 	nextItemOffsets         map[string]int
 	err                     error
 }
@@ -25,6 +58,9 @@ type summaryWithOffsets struct {
 // fanInAvailablePackageSummaries fans in the results from the separate plugins
 // to the return channel.
 //
+// The results are merged in the order requested in the filter options, which
+// each plugin is expected to return its own results in.
+//
 // Each plugin handles the request in a separate go-routine while this function
 // uses the fan-in pattern to merge those results, sending the next result back
 // down the return channel until the request is satisfied. Importantly, each
@@ -79,9 +115,13 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 	// We now have a slice of channels for the fan-in and want a go routine that
 	// will ensure it sends the next (ordered) item from all channels down the
 	// channel.
+	sortBy := request.GetFilterOptions().GetSortBy()
 	go func() {
 		numSent := 0
 		nextItems := make([]*summaryWithOffset, len(fanInput))
+		// The facets are merged as they are received from each plugin, as a
+		// plugin's facets are needed even if none of its items are sent.
+		pluginFacets := []*packages.AvailablePackageFacets{}
 		for {
 			// Populate the empty next items from each channel.
 			for i, ch := range fanInput {
@@ -104,10 +144,13 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 						close(summariesCh)
 						return
 					}
+					if nextItems[i] != nil && nextItems[i].facets != nil {
+						pluginFacets = append(pluginFacets, nextItems[i].facets)
+					}
 				}
 			}
 
-			// Choose the minimum by name and send it down the line.
+			// Choose the minimum in the requested order and send it down the line.
 			// First find the first non-nil value as the min.
 			minIndex := -1
 			for i, s := range nextItems {
@@ -125,7 +168,7 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 
 			// Otherwise, we find the minimum item of the next items from each channel.
 			for i, s := range nextItems {
-				if s != nil && s.availablePackageSummary.Name < nextItems[minIndex].availablePackageSummary.Name {
+				if s != nil && summaries.Less(s.availablePackageSummary, nextItems[minIndex].availablePackageSummary, sortBy) {
 					minIndex = i
 				}
 			}
@@ -134,6 +177,7 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 			summariesCh <- summaryWithOffsets{
 				availablePackageSummary: nextItems[minIndex].availablePackageSummary,
 				categories:              nextItems[minIndex].categories,
+				facets:                  summaries.MergeFacets(pluginFacets...),
 				nextItemOffsets:         pluginPageOffsets,
 			}
 			// Ensure the item will get replaced on the next round.
@@ -155,6 +199,7 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 type summaryWithOffset struct {
 	availablePackageSummary *packages.AvailablePackageSummary
 	categories              []string
+	facets                  *packages.AvailablePackageFacets
 	nextItemOffset          int
 	err                     error
 }
@@ -181,22 +226,27 @@ func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 	// improvement.
 	go func() {
 		for {
-			response, err := pkgPlugin.server.GetAvailablePackageSummaries(ctx, request)
+			spanCtx, span := startPluginSpan(ctx, pkgPlugin.plugin, "GetAvailablePackageSummaries")
+			response, err := pkgPlugin.server.GetAvailablePackageSummaries(spanCtx, request)
+			endPluginSpan(span, err)
 			if err != nil {
 				summaryCh <- &summaryWithOffset{err: err}
 				close(summaryCh)
 				return
 			}
 			categories := response.Categories
+			facets := response.Facets
 			for _, summary := range response.AvailablePackageSummaries {
 				itemOffset = itemOffset + 1
 				summaryCh <- &summaryWithOffset{
 					availablePackageSummary: summary,
 					categories:              categories,
+					facets:                  facets,
 					nextItemOffset:          itemOffset,
 				}
-				// We only need to send the categories once per response.
+				// We only need to send the categories and facets once per response.
 				categories = nil
+				facets = nil
 			}
 			if response.GetNextPageToken() == "" {
 				close(summaryCh)
@@ -215,3 +265,277 @@ func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 
 	return summaryCh, nil
 }
+
+// installedSummaryWithOffsets is the channel type for the results of the
+// combined core installed package summaries after fanning in from the plugins.
+type installedSummaryWithOffsets struct {
+	installedPackageSummary *packages.InstalledPackageSummary
+	nextItemOffsets         map[string]int
+	err                     error
+}
+
+// fanInInstalledPackageSummaries fans in the installed package summaries from
+// the separate plugins to the return channel.
+//
+// It follows the same approach as fanInAvailablePackageSummaries: each plugin
+// is paginated in a separate go-routine and the results are merged by name,
+// with each result accompanied by the next item offsets for each plugin so
+// that the caller can generate a next page token. The merge requires each
+// plugin to return its installed package summaries sorted by name, across
+// namespaces.
+func fanInInstalledPackageSummaries(ctx context.Context, pkgPlugins []pkgPluginWithServer, request *packages.GetInstalledPackageSummariesRequest) (<-chan installedSummaryWithOffsets, error) {
+	summariesCh := make(chan installedSummaryWithOffsets)
+
+	pkgPlugins = pluginsMatchingInstalledPackageFilter(pkgPlugins, request.GetFilterOptions())
+
+	corePageSize := int(request.GetPaginationOptions().GetPageSize())
+	pluginPageSize := corePageSize
+	if len(pkgPlugins) > 1 {
+		pluginPageSize = pluginPageSize / (len(pkgPlugins) - 1)
+	}
+
+	pluginPageOffsets := map[string]int{}
+	if request.GetPaginationOptions().GetPageToken() != "" {
+		err := json.Unmarshal([]byte(request.GetPaginationOptions().GetPageToken()), &pluginPageOffsets)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal %q: %w", request.GetPaginationOptions().GetPageToken(), err)
+		}
+	}
+
+	fanInput := []<-chan *installedSummaryWithOffset{}
+	for _, pluginWithSrv := range pkgPlugins {
+		r := &packages.GetInstalledPackageSummariesRequest{
+			Context: request.Context,
+			PaginationOptions: &packages.PaginationOptions{
+				PageSize:  int32(pluginPageSize),
+				PageToken: fmt.Sprintf("%d", pluginPageOffsets[pluginWithSrv.plugin.Name]),
+			},
+			FilterOptions: request.FilterOptions,
+		}
+
+		ch, err := sendInstalledPackageSummariesForPlugin(ctx, pluginWithSrv, r)
+		if err != nil {
+			return nil, err
+		}
+		fanInput = append(fanInput, ch)
+	}
+
+	go func() {
+		numSent := 0
+		nextItems := make([]*installedSummaryWithOffset, len(fanInput))
+		for {
+			// Populate the empty next items from each channel.
+			for i, ch := range fanInput {
+				if nextItems[i] == nil {
+					ok := true
+					nextItems[i], ok = <-ch
+					if !ok {
+						pluginName := pkgPlugins[i].plugin.Name
+						pluginPageOffsets[pluginName] = CompleteToken
+					}
+
+					if nextItems[i] != nil && nextItems[i].err != nil {
+						summariesCh <- installedSummaryWithOffsets{
+							err: nextItems[i].err,
+						}
+						close(summariesCh)
+						return
+					}
+				}
+			}
+
+			minIndex := -1
+			for i, s := range nextItems {
+				if s != nil {
+					minIndex = i
+					break
+				}
+			}
+
+			if minIndex == -1 {
+				close(summariesCh)
+				return
+			}
+
+			for i, s := range nextItems {
+				if s != nil && s.installedPackageSummary.Name < nextItems[minIndex].installedPackageSummary.Name {
+					minIndex = i
+				}
+			}
+			summary := nextItems[minIndex].installedPackageSummary
+			if summary.InstalledPackageRef == nil {
+				summary.InstalledPackageRef = &packages.InstalledPackageReference{}
+			}
+			summary.InstalledPackageRef.Plugin = pkgPlugins[minIndex].plugin
+			pluginName := pkgPlugins[minIndex].plugin.Name
+			pluginPageOffsets[pluginName] = nextItems[minIndex].nextItemOffset
+			summariesCh <- installedSummaryWithOffsets{
+				installedPackageSummary: nextItems[minIndex].installedPackageSummary,
+				nextItemOffsets:         pluginPageOffsets,
+			}
+			nextItems[minIndex] = nil
+
+			numSent += 1
+			if numSent == corePageSize {
+				close(summariesCh)
+				return
+			}
+		}
+	}()
+
+	return summariesCh, nil
+}
+
+// pluginsMatchingInstalledPackageFilter returns the plugins which are not
+// excluded by the plugins specified in the filter options, if any.
+func pluginsMatchingInstalledPackageFilter(pkgPlugins []pkgPluginWithServer, filter *packages.InstalledPackageFilterOptions) []pkgPluginWithServer {
+	if len(filter.GetPlugins()) == 0 {
+		return pkgPlugins
+	}
+	matching := []pkgPluginWithServer{}
+	for _, pluginWithSrv := range pkgPlugins {
+		for _, name := range filter.GetPlugins() {
+			if pluginWithSrv.plugin.Name == name {
+				matching = append(matching, pluginWithSrv)
+				break
+			}
+		}
+	}
+	return matching
+}
+
+// installedSummaryWithOffset is the channel type for a single installed
+// package summary from a single plugin.
+type installedSummaryWithOffset struct {
+	installedPackageSummary *packages.InstalledPackageSummary
+	nextItemOffset          int
+	err                     error
+}
+
+// sendInstalledPackageSummariesForPlugin returns a channel and sends the
+// installed package summaries returned by the plugin for the given request.
+func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPluginWithServer, request *packages.GetInstalledPackageSummariesRequest) (<-chan *installedSummaryWithOffset, error) {
+	summaryCh := make(chan *installedSummaryWithOffset)
+
+	itemOffset, err := paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if itemOffset == CompleteToken {
+		// This plugin was already exhausted during the last request. Nothing to do here.
+		close(summaryCh)
+		return summaryCh, nil
+	}
+
+	go func() {
+		for {
+			spanCtx, span := startPluginSpan(ctx, pkgPlugin.plugin, "GetInstalledPackageSummaries")
+			response, err := pkgPlugin.server.GetInstalledPackageSummaries(spanCtx, request)
+			endPluginSpan(span, err)
+			if err != nil {
+				summaryCh <- &installedSummaryWithOffset{err: err}
+				close(summaryCh)
+				return
+			}
+			for _, summary := range response.InstalledPackageSummaries {
+				itemOffset = itemOffset + 1
+				summaryCh <- &installedSummaryWithOffset{
+					installedPackageSummary: summary,
+					nextItemOffset:          itemOffset,
+				}
+			}
+			if response.GetNextPageToken() == "" {
+				close(summaryCh)
+				return
+			}
+			if fmt.Sprintf("%d", itemOffset) != response.GetNextPageToken() {
+				summaryCh <- &installedSummaryWithOffset{
+					err: fmt.Errorf("inconsistent item offset: got: %q, expected: %d", response.GetNextPageToken(), itemOffset),
+				}
+				close(summaryCh)
+				return
+			}
+			request.PaginationOptions.PageToken = response.GetNextPageToken()
+		}
+	}()
+
+	return summaryCh, nil
+}
+
+// installedPackageEvent is the channel type for the events fanned in from the
+// watches of the separate plugins.
+type installedPackageEvent struct {
+	response *packages.WatchInstalledPackageSummariesResponse
+	plugin   *v1alpha1.Plugin
+	err      error
+}
+
+// pluginWatchStream implements the server stream passed to a plugin's
+// WatchInstalledPackageSummaries so that each response sent by the plugin is
+// sent down the fan-in channel instead.
+type pluginWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	plugin *v1alpha1.Plugin
+	events chan<- installedPackageEvent
+}
+
+func (w *pluginWatchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *pluginWatchStream) Send(response *packages.WatchInstalledPackageSummariesResponse) error {
+	select {
+	case w.events <- installedPackageEvent{response: response, plugin: w.plugin}:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	}
+}
+
+// fanInInstalledPackageEvents watches the installed packages of each plugin in
+// a separate go-routine and merges the events sent by each onto the returned
+// channel, which is closed once every plugin watch has ended. Plugins which do
+// not implement the watch are skipped.
+//
+// The returned cancel func must be called to end the plugin watches once the
+// caller is no longer reading from the channel.
+func fanInInstalledPackageEvents(ctx context.Context, stream grpc.ServerStream, pkgPlugins []pkgPluginWithServer, request *packages.WatchInstalledPackageSummariesRequest) (<-chan installedPackageEvent, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	eventsCh := make(chan installedPackageEvent)
+
+	var wg sync.WaitGroup
+	wg.Add(len(pkgPlugins))
+	for _, pluginWithSrv := range pkgPlugins {
+		go func(pluginWithSrv pkgPluginWithServer) {
+			defer wg.Done()
+			spanCtx, span := startPluginSpan(ctx, pluginWithSrv.plugin, "WatchInstalledPackageSummaries")
+			defer span.End()
+			err := pluginWithSrv.server.WatchInstalledPackageSummaries(request, &pluginWatchStream{
+				ServerStream: stream,
+				ctx:          spanCtx,
+				plugin:       pluginWithSrv.plugin,
+				events:       eventsCh,
+			})
+			if err == nil || ctx.Err() != nil {
+				return
+			}
+			if status.Code(err) == codes.Unimplemented {
+				log.Infof("Plugin %v does not support watching installed packages: %v", pluginWithSrv.plugin.Name, err)
+				return
+			}
+			select {
+			case eventsCh <- installedPackageEvent{plugin: pluginWithSrv.plugin, err: err}:
+			case <-ctx.Done():
+			}
+		}(pluginWithSrv)
+	}
+
+	go func() {
+		wg.Wait()
+		close(eventsCh)
+	}()
+
+	return eventsCh, cancel
+}
@@ -37,6 +37,13 @@ var ignoreUnexportedOpts = cmpopts.IgnoreUnexported(
 	corev1.GetInstalledPackageResourceRefsResponse{},
 	corev1.GetInstalledPackageDetailResponse{},
 	corev1.GetInstalledPackageSummariesResponse{},
+	corev1.GetInstalledPackageUpdatePreviewResponse{},
+	corev1.GetInstalledPackageRevisionsResponse{},
+	corev1.RollbackInstalledPackageResponse{},
+	corev1.CheckInstalledPackagePermissionsResponse{},
+	corev1.ForbiddenAction{},
+	corev1.InstalledPackageRevision{},
+	corev1.WatchInstalledPackageSummariesResponse{},
 	corev1.CreateInstalledPackageResponse{},
 	corev1.UpdateInstalledPackageResponse{},
 	corev1.InstalledPackageDetail{},
@@ -46,6 +53,7 @@ var ignoreUnexportedOpts = cmpopts.IgnoreUnexported(
 	corev1.Maintainer{},
 	corev1.PackageAppVersion{},
 	corev1.VersionReference{},
+	corev1.ResourceChange{},
 	corev1.ResourceRef{},
 	plugins.Plugin{},
 )
@@ -306,6 +314,112 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 	}
 }
 
+// makeSortTestPackagingPlugin returns a plugin with the given summaries, all
+// in the given repository, and their facets.
+func makeSortTestPackagingPlugin(pluginName, repository string, names ...string) pkgPluginWithServer {
+	pluginDetails := &plugins.Plugin{Name: pluginName, Version: "v1alpha1"}
+	packagingPluginServer := &plugin_test.TestPackagingPluginServer{Plugin: pluginDetails}
+	for _, name := range names {
+		summary := plugin_test.MakeAvailablePackageSummary(name, pluginDetails)
+		summary.RepositoryName = repository
+		packagingPluginServer.AvailablePackageSummaries = append(packagingPluginServer.AvailablePackageSummaries, summary)
+	}
+	packagingPluginServer.Facets = &corev1.AvailablePackageFacets{
+		Categories:   []*corev1.FacetCount{{Value: plugin_test.DefaultCategory, Count: int32(len(names))}},
+		Repositories: []*corev1.FacetCount{{Value: repository, Count: int32(len(names))}},
+		Plugins:      []*corev1.FacetCount{{Value: pluginName, Count: int32(len(names))}},
+	}
+	return pkgPluginWithServer{
+		plugin: pluginDetails,
+		server: packagingPluginServer,
+	}
+}
+
+func TestGetAvailablePackageSummariesSortAndFacets(t *testing.T) {
+	plugin1 := makeSortTestPackagingPlugin("mock1", "repo-b", "pkg-1", "pkg-3")
+	plugin2 := makeSortTestPackagingPlugin("mock2", "repo-a", "pkg-2", "pkg-4")
+	summaryInRepo := func(name string, plugin pkgPluginWithServer, repository string) *corev1.AvailablePackageSummary {
+		summary := plugin_test.MakeAvailablePackageSummary(name, plugin.plugin)
+		summary.RepositoryName = repository
+		return summary
+	}
+	expectedFacets := &corev1.AvailablePackageFacets{
+		Categories:   []*corev1.FacetCount{{Value: plugin_test.DefaultCategory, Count: 4}},
+		Repositories: []*corev1.FacetCount{{Value: "repo-a", Count: 2}, {Value: "repo-b", Count: 2}},
+		Plugins:      []*corev1.FacetCount{{Value: "mock1", Count: 2}, {Value: "mock2", Count: 2}},
+	}
+
+	testCases := []struct {
+		name             string
+		request          *corev1.GetAvailablePackageSummariesRequest
+		expectedResponse *corev1.GetAvailablePackageSummariesResponse
+	}{
+		{
+			name: "it merges the plugin results by name with the merged facets",
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 3},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					summaryInRepo("pkg-1", plugin1, "repo-b"),
+					summaryInRepo("pkg-2", plugin2, "repo-a"),
+					summaryInRepo("pkg-3", plugin1, "repo-b"),
+				},
+				Categories:    []string{},
+				Facets:        expectedFacets,
+				NextPageToken: `{"mock1":2,"mock2":1}`,
+			},
+		},
+		{
+			name: "it merges the plugin results by repository with the merged facets",
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				FilterOptions:     &corev1.FilterOptions{SortBy: corev1.FilterOptions_SORT_BY_REPOSITORY},
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 3},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					summaryInRepo("pkg-2", plugin2, "repo-a"),
+					summaryInRepo("pkg-4", plugin2, "repo-a"),
+					summaryInRepo("pkg-1", plugin1, "repo-b"),
+				},
+				Categories:    []string{},
+				Facets:        expectedFacets,
+				NextPageToken: `{"mock1":1,"mock2":-1}`,
+			},
+		},
+		{
+			name: "it does not return facets for the next pages",
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				FilterOptions:     &corev1.FilterOptions{SortBy: corev1.FilterOptions_SORT_BY_REPOSITORY},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: `{"mock1":1,"mock2":-1}`, PageSize: 3},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					summaryInRepo("pkg-3", plugin1, "repo-b"),
+				},
+				Categories: []string{},
+			},
+		},
+	}
+
+	opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageFacets{}, corev1.FacetCount{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{plugin1, plugin2},
+			}
+			response, err := server.GetAvailablePackageSummaries(context.Background(), tc.request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts, opts))
+			}
+		})
+	}
+}
+
 func TestGetAvailablePackageDetail(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -411,6 +525,139 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 			},
 			statusCode: codes.OK,
 		},
+		{
+			name: "it should only call the plugins specified in the filter options",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Plugins: []string{mockedPackagingPlugin2.plugin.Name},
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should successfully call and paginate one page the core GetInstalledPackageSummaries operation",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageSize: 2},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				},
+				NextPageToken: `{"mock1":1,"mock2":1}`,
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should successfully call and paginate with proper PageSize the core GetInstalledPackageSummaries operation",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: "", PageSize: 3},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+				NextPageToken: `{"mock1":2,"mock2":1}`,
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should successfully call and paginate last page of the core GetInstalledPackageSummaries operation exhausting the results",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: `{"mock1":2,"mock2":1}`, PageSize: 2},
+			},
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+				NextPageToken: "",
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it maintains the offset of a plugin even if that plugin did not contribute to the installed result",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+				mockedPackagingPlugin3,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{
+					PageToken: `{"mock1":1,"mock2":1,"mock3":1}`,
+					PageSize:  2,
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+				NextPageToken: `{"mock1":-1,"mock2":2,"mock3":1}`,
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetInstalledPackageSummaries operation with an invalid page token",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: "not-json", PageSize: 2},
+			},
+			statusCode: codes.Internal,
+		},
 		{
 			name: "it should fail when calling the core GetInstalledPackageSummaries operation when the package is not present in a plugin",
 			configuredPlugins: []pkgPluginWithServer{
@@ -451,6 +698,114 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		statusCode        codes.Code
+		request           *corev1.WatchInstalledPackageSummariesRequest
+		expectedResponses []*corev1.WatchInstalledPackageSummariesResponse
+	}{
+		{
+			name: "it should stream the events from all the plugins",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.WatchInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+			expectedResponses: []*corev1.WatchInstalledPackageSummariesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+			},
+		},
+		{
+			name: "it should skip the plugins which do not implement the watch",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				makeOnlyStatusTestPackagingPlugin("unimplemented-plugin", codes.Unimplemented),
+			},
+			request: &corev1.WatchInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+			expectedResponses: []*corev1.WatchInstalledPackageSummariesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+			},
+		},
+		{
+			name: "it should fail when calling the core WatchInstalledPackageSummaries operation when the package is not present in a plugin",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.WatchInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+			statusCode: codes.NotFound,
+		},
+	}
+
+	// The plugins send their events concurrently, so the order of the
+	// responses across plugins is not deterministic.
+	sortResponses := cmpopts.SortSlices(func(a, b *corev1.WatchInstalledPackageSummariesResponse) bool {
+		aRef, bRef := a.GetInstalledPackageSummary().GetInstalledPackageRef(), b.GetInstalledPackageSummary().GetInstalledPackageRef()
+		if aRef.GetPlugin().GetName() != bRef.GetPlugin().GetName() {
+			return aRef.GetPlugin().GetName() < bRef.GetPlugin().GetName()
+		}
+		return aRef.GetIdentifier() < bRef.GetIdentifier()
+	})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}
+			stream := &plugin_test.TestWatchInstalledPackageSummariesStream{Ctx: context.Background()}
+			err := server.WatchInstalledPackageSummaries(tc.request, stream)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := stream.Responses, tc.expectedResponses; !cmp.Equal(got, want, ignoreUnexportedOpts, sortResponses) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts, sortResponses))
+				}
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageDetail(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -776,17 +1131,124 @@ func TestUpdateInstalledPackage(t *testing.T) {
 	}
 }
 
-func TestDeleteInstalledPackage(t *testing.T) {
+func TestGetInstalledPackageUpdatePreview(t *testing.T) {
+	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
 
 	testCases := []struct {
-		name              string
-		configuredPlugins []*plugins.Plugin
-		statusCode        codes.Code
-		request           *corev1.DeleteInstalledPackageRequest
+		name                  string
+		statusCode            codes.Code
+		pluginResourceChanges []*corev1.ResourceChange
+		request               *corev1.GetInstalledPackageUpdatePreviewRequest
+		expectedResponse      *corev1.GetInstalledPackageUpdatePreviewResponse
 	}{
 		{
-			name: "deletes the package",
-			configuredPlugins: []*plugins.Plugin{
+			name: "returns the resource changes from the correct plugin",
+			pluginResourceChanges: []*corev1.ResourceChange{
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "some-deployment",
+					},
+					ChangeType: corev1.ResourceChange_CHANGE_TYPE_CHANGED,
+				},
+			},
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageUpdatePreviewResponse{
+				ResourceChanges: []*corev1.ResourceChange{
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "some-deployment",
+						},
+						ChangeType: corev1.ResourceChange_CHANGE_TYPE_CHANGED,
+					},
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "returns an internal error if the plugin returns a change without a resource ref",
+			pluginResourceChanges: []*corev1.ResourceChange{
+				{ChangeType: corev1.ResourceChange_CHANGE_TYPE_CREATED},
+			},
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			statusCode: codes.Internal,
+		},
+		{
+			name: "returns invalid argument if plugin not specified in request",
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns internal error if unable to find the plugin",
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "other-plugin.packages", Version: "v1alpha1"},
+				},
+			},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{
+					{
+						plugin: installedPlugin,
+						server: &plugin_test.TestPackagingPluginServer{
+							Plugin:          installedPlugin,
+							ResourceChanges: tc.pluginResourceChanges,
+						},
+					},
+				},
+			}
+
+			response, err := server.GetInstalledPackageUpdatePreview(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteInstalledPackage(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []*plugins.Plugin
+		statusCode        codes.Code
+		request           *corev1.DeleteInstalledPackageRequest
+	}{
+		{
+			name: "deletes the package",
+			configuredPlugins: []*plugins.Plugin{
 				{Name: "plugin-1", Version: "v1alpha1"},
 				{Name: "plugin-1", Version: "v1alpha2"},
 			},
@@ -843,6 +1305,309 @@ func TestDeleteInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	revisions := []*corev1.InstalledPackageRevision{
+		{
+			Revision: 2,
+			Version:  &corev1.PackageAppVersion{PkgVersion: "1.2.4"},
+		},
+		{
+			Revision: 1,
+			Version:  &corev1.PackageAppVersion{PkgVersion: "1.2.3"},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		pluginStatus     codes.Code
+		statusCode       codes.Code
+		request          *corev1.GetInstalledPackageRevisionsRequest
+		expectedResponse *corev1.GetInstalledPackageRevisionsResponse
+	}{
+		{
+			name: "it should successfully call the plugins GetInstalledPackageRevisions endpoint",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: revisions,
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return an invalid argument if the plugin is not specified",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it should return an internal error if the plugin cannot be found",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "other-plugin.packages", Version: "v1alpha1"},
+				},
+			},
+			statusCode: codes.Internal,
+		},
+		{
+			name:         "it should return the status code of the plugin",
+			pluginStatus: codes.Unimplemented,
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			statusCode: codes.Unimplemented,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{
+					{
+						plugin: installedPlugin,
+						server: &plugin_test.TestPackagingPluginServer{
+							Plugin:    installedPlugin,
+							Revisions: revisions,
+							Status:    tc.pluginStatus,
+						},
+					},
+				},
+			}
+
+			response, err := server.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestRollbackInstalledPackage(t *testing.T) {
+	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+
+	testCases := []struct {
+		name             string
+		pluginStatus     codes.Code
+		statusCode       codes.Code
+		request          *corev1.RollbackInstalledPackageRequest
+		expectedResponse *corev1.RollbackInstalledPackageResponse
+	}{
+		{
+			name: "it should successfully call the plugins RollbackInstalledPackage endpoint",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+				Revision: 1,
+			},
+			expectedResponse: &corev1.RollbackInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return an invalid argument if the plugin is not specified",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+				},
+				Revision: 1,
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it should return an invalid argument if the revision is not positive",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it should return an internal error if the plugin cannot be found",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "other-plugin.packages", Version: "v1alpha1"},
+				},
+				Revision: 1,
+			},
+			statusCode: codes.Internal,
+		},
+		{
+			name:         "it should return the status code of the plugin",
+			pluginStatus: codes.NotFound,
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+				Revision: 5,
+			},
+			statusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{
+					{
+						plugin: installedPlugin,
+						server: &plugin_test.TestPackagingPluginServer{
+							Plugin: installedPlugin,
+							Status: tc.pluginStatus,
+						},
+					},
+				},
+			}
+
+			response, err := server.RollbackInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestCheckInstalledPackagePermissions(t *testing.T) {
+	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	forbiddenActions := []*corev1.ForbiddenAction{
+		{ApiVersion: "apps/v1", Resource: "deployments", Namespace: "my-ns", Verbs: []string{"create"}},
+	}
+
+	testCases := []struct {
+		name             string
+		pluginStatus     codes.Code
+		statusCode       codes.Code
+		request          *corev1.CheckInstalledPackagePermissionsRequest
+		expectedResponse *corev1.CheckInstalledPackagePermissionsResponse
+	}{
+		{
+			name: "it should successfully call the plugins CheckInstalledPackagePermissions endpoint",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "available-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: forbiddenActions,
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return an invalid argument if the plugin is not specified",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it should return an internal error if the plugin cannot be found",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "other-plugin.packages", Version: "v1alpha1"},
+				},
+			},
+			statusCode: codes.Internal,
+		},
+		{
+			name:         "it should return the status code of the plugin",
+			pluginStatus: codes.InvalidArgument,
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{
+					{
+						plugin: installedPlugin,
+						server: &plugin_test.TestPackagingPluginServer{
+							Plugin:           installedPlugin,
+							ForbiddenActions: forbiddenActions,
+							Status:           tc.pluginStatus,
+						},
+					},
+				},
+			}
+
+			response, err := server.CheckInstalledPackagePermissions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageResourceRefs(t *testing.T) {
 	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
 
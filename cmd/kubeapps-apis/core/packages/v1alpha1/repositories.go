@@ -12,6 +12,7 @@ import (
 	pluginsv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/plugins/v1alpha1"
 	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -58,19 +59,19 @@ func (s repositoriesServer) AddPackageRepository(ctx context.Context, request *p
 	log.Infof("+core AddPackageRepository %s", contextMsg)
 
 	if request.GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing request.Plugin)")
+		return nil, statuserror.FieldErrorf("plugin", "Unable to retrieve the plugin (missing request.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.AddPackageRepository(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to add package repository %q using the plugin %q: %v", request.Name, request.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to add package repository %q using the plugin %q: %v", request.Name, request.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -86,19 +87,19 @@ func (s repositoriesServer) GetPackageRepositoryDetail(ctx context.Context, requ
 	log.Infof("+core GetPackageRepositoryDetail %s", contextMsg)
 
 	if request.GetPackageRepoRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
+		return nil, statuserror.FieldErrorf("package_repo_ref.plugin", "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.PackageRepoRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.GetPackageRepositoryDetail(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to get the package repository detail for the repository %q using the plugin %q: %v", request.PackageRepoRef.Identifier, request.PackageRepoRef.Plugin.Name, err)
+		return nil, statuserror.Wrapf(err, "Unable to get the package repository detail for the repository %q using the plugin %q: %v", request.PackageRepoRef.Identifier, request.PackageRepoRef.Plugin.Name, err)
 	}
 
 	// Validate the plugin response
@@ -123,7 +124,7 @@ func (s repositoriesServer) GetPackageRepositorySummaries(ctx context.Context, r
 	for _, p := range s.pluginsWithServers {
 		response, err := p.server.GetPackageRepositorySummaries(ctx, request)
 		if err != nil {
-			return nil, status.Errorf(status.Convert(err).Code(), "Invalid GetPackageRepositorySummaries response from the plugin %v: %v", p.plugin.Name, err)
+			return nil, statuserror.Wrapf(err, "Invalid GetPackageRepositorySummaries response from the plugin %v: %v", p.plugin.Name, err)
 		}
 
 		// Add the plugin for the pkgs
@@ -158,19 +159,19 @@ func (s repositoriesServer) UpdatePackageRepository(ctx context.Context, request
 	log.Infof("+core UpdatePackageRepository %s", contextMsg)
 
 	if request.GetPackageRepoRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
+		return nil, statuserror.FieldErrorf("package_repo_ref.plugin", "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.PackageRepoRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.UpdatePackageRepository(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to update the package repository %q using the plugin %q: %v",
+		return nil, statuserror.Wrapf(err, "Unable to update the package repository %q using the plugin %q: %v",
 			request.PackageRepoRef.Identifier, request.PackageRepoRef.Plugin.Name, err)
 	}
 
@@ -191,19 +192,19 @@ func (s repositoriesServer) DeletePackageRepository(ctx context.Context, request
 	log.Infof("+core DeletePackageRepository %s", contextMsg)
 
 	if request.GetPackageRepoRef().GetPlugin() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
+		return nil, statuserror.FieldErrorf("package_repo_ref.plugin", "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
 	}
 
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.PackageRepoRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
+		return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonPluginNotFound, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.DeletePackageRepository(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(status.Convert(err).Code(), "Unable to delete the package repository %q using the plugin %q: %v",
+		return nil, statuserror.Wrapf(err, "Unable to delete the package repository %q using the plugin %q: %v",
 			request.PackageRepoRef.Identifier, request.PackageRepoRef.Plugin.Name, err)
 	}
 
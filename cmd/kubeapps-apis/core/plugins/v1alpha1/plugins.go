@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io/fs"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
@@ -18,12 +19,16 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/audit"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 	"github.com/vmware-tanzu/kubeapps/pkg/kube"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	log "k8s.io/klog/v2"
@@ -49,12 +54,28 @@ type GRPCPluginRegistrationOptions struct {
 	// clients of the K8s API server created by plugins.
 	ClientQPS   float32
 	ClientBurst int
+	// Auditor records the mutations run by plugins outside of the requests,
+	// such as the automatic upgrades of installed packages. It is nil when
+	// the audit log is disabled.
+	Auditor *audit.Auditor
 }
 
 // PluginWithServer keeps a record of a GRPC server and its plugin detail.
 type PluginWithServer struct {
 	Plugin *plugins.Plugin
 	Server interface{}
+	// Capabilities are the features supported by the plugin, discovered from
+	// the interfaces satisfied by the server together with the flags declared
+	// by the plugin itself.
+	Capabilities *plugins.PluginCapabilities
+}
+
+// PluginCapabilitiesProvider can be implemented by a plugin server to declare
+// the optional features which it supports, since these cannot be discovered
+// from the interfaces satisfied by the server: plugins embed the unimplemented
+// servers generated for each service.
+type PluginCapabilitiesProvider interface {
+	GetPluginCapabilities() *plugins.PluginCapabilities
 }
 
 // coreServer implements the API defined in cmd/kubeapps-api-service/core/core.proto
@@ -66,9 +87,15 @@ type PluginsServer struct {
 
 	// The parsed config for clusters in a multi-cluster setup.
 	clustersConfig kube.ClustersConfig
+
+	// The auditor passed to the plugins when registering them.
+	auditor *audit.Auditor
+
+	// The connections to the remote plugins, closed on shutdown.
+	remoteConns []*grpc.ClientConn
 }
 
-func NewPluginsServer(serveOpts core.ServeOptions, registrar grpc.ServiceRegistrar, gwArgs core.GatewayHandlerArgs) (*PluginsServer, error) {
+func NewPluginsServer(serveOpts core.ServeOptions, registrar grpc.ServiceRegistrar, gwArgs core.GatewayHandlerArgs, auditor *audit.Auditor) (*PluginsServer, error) {
 	// Store the serveOptions in the global 'pluginsServeOpts' variable
 
 	// Find all .so plugins in the specified plugins directory.
@@ -77,7 +104,9 @@ func NewPluginsServer(serveOpts core.ServeOptions, registrar grpc.ServiceRegistr
 		log.Fatalf("failed to check for plugins: %v", err)
 	}
 
-	ps := &PluginsServer{}
+	ps := &PluginsServer{
+		auditor: auditor,
+	}
 
 	// get the parsed kube.ClustersConfig from the serveOpts
 	clustersConfig, err := getClustersConfigFromServeOpts(serveOpts)
@@ -94,6 +123,12 @@ func NewPluginsServer(serveOpts core.ServeOptions, registrar grpc.ServiceRegistr
 	return ps, nil
 }
 
+// Close closes the connections to the remote plugins.
+func (s *PluginsServer) Close() {
+	closeConns(s.remoteConns)
+	s.remoteConns = nil
+}
+
 // sortPlugins returns a consistently ordered slice.
 func sortPlugins(p []PluginWithServer) {
 	sort.Slice(p, func(i, j int) bool {
@@ -107,11 +142,17 @@ func (s *PluginsServer) GetConfiguredPlugins(ctx context.Context, in *plugins.Ge
 	// really adds a lot of noise to the logs, so lowering verbosity
 	log.V(4).Infof("+core GetConfiguredPlugins")
 	pluginDetails := make([]*plugins.Plugin, len(s.pluginsWithServers))
+	configuredPlugins := make([]*plugins.ConfiguredPlugin, len(s.pluginsWithServers))
 	for i, p := range s.pluginsWithServers {
 		pluginDetails[i] = p.Plugin
+		configuredPlugins[i] = &plugins.ConfiguredPlugin{
+			Plugin:       p.Plugin,
+			Capabilities: p.Capabilities,
+		}
 	}
 	return &plugins.GetConfiguredPluginsResponse{
-		Plugins: pluginDetails,
+		Plugins:           pluginDetails,
+		ConfiguredPlugins: configuredPlugins,
 	}, nil
 }
 
@@ -139,8 +180,9 @@ func (s *PluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 			return err
 		} else {
 			pluginsWithServers = append(pluginsWithServers, PluginWithServer{
-				Plugin: pluginDetail,
-				Server: grpcServer,
+				Plugin:       pluginDetail,
+				Server:       grpcServer,
+				Capabilities: pluginCapabilities(grpcServer),
 			})
 		}
 
@@ -151,6 +193,23 @@ func (s *PluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 		log.Infof("Successfully registered plugin %q", pluginPath)
 	}
 
+	if serveOpts.RemotePluginsConfigPath != "" {
+		remotePluginsWithServers, remoteConns, err := registerRemotePlugins(serveOpts.RemotePluginsConfigPath)
+		if err != nil {
+			return fmt.Errorf("unable to register remote plugins: %w", err)
+		}
+		for _, remote := range remotePluginsWithServers {
+			for _, p := range pluginsWithServers {
+				if p.Plugin.Name == remote.Plugin.Name {
+					closeConns(remoteConns)
+					return fmt.Errorf("remote plugin %q conflicts with an existing plugin of the same name", remote.Plugin.Name)
+				}
+			}
+			pluginsWithServers = append(pluginsWithServers, remote)
+		}
+		s.remoteConns = remoteConns
+	}
+
 	sortPlugins(pluginsWithServers)
 
 	s.pluginsWithServers = pluginsWithServers
@@ -182,6 +241,7 @@ func (s *PluginsServer) registerGRPC(p *plugin.Plugin, pluginDetail *plugins.Plu
 		PluginConfigPath: serveOpts.PluginConfigPath,
 		ClientQPS:        serveOpts.QPS,
 		ClientBurst:      serveOpts.Burst,
+		Auditor:          s.auditor,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("plug-in %q failed to register due to: %v", pluginDetail, err)
@@ -210,6 +270,33 @@ func (s *PluginsServer) GetPluginsSatisfyingInterface(targetInterface reflect.Ty
 	return satisfiedPlugins
 }
 
+// pluginCapabilities returns the capabilities of a plugin server. Support for
+// the core services is discovered from the interfaces satisfied by the server,
+// while the optional features are those declared by the server, if any.
+func pluginCapabilities(server interface{}) *plugins.PluginCapabilities {
+	capabilities := &plugins.PluginCapabilities{}
+	if provider, ok := server.(PluginCapabilitiesProvider); ok {
+		if declared := provider.GetPluginCapabilities(); declared != nil {
+			capabilities = proto.Clone(declared).(*plugins.PluginCapabilities)
+		}
+	}
+
+	serverType := reflect.TypeOf(server)
+	capabilities.Packages = serverType != nil && serverType.Implements(reflect.TypeOf((*packages.PackagesServiceServer)(nil)).Elem())
+	capabilities.Repositories = serverType != nil && serverType.Implements(reflect.TypeOf((*packages.RepositoriesServiceServer)(nil)).Elem())
+
+	// The optional features of the packages service are meaningless otherwise.
+	if !capabilities.Packages {
+		capabilities.Rollback = false
+		capabilities.ReconciliationOptions = false
+		capabilities.ServiceAccounts = false
+		capabilities.ValuesSchema = false
+		capabilities.UpdatePreview = false
+	}
+
+	return capabilities
+}
+
 // getPluginDetail returns a core.plugins.Plugin as defined by the plugin itself.
 func getPluginDetail(p *plugin.Plugin, pluginPath string) (*plugins.Plugin, error) {
 	pluginDetailFn, err := p.Lookup(pluginDetailFunction)
@@ -280,6 +367,20 @@ func listSOFiles(fsys fs.FS, pluginDirs []string) ([]string, error) {
 // The returned function utilizes the user credential present in the request context.
 // The plugins just have to call this function passing the context in order to retrieve the configured k8s client
 func createConfigGetter(serveOpts core.ServeOptions, clustersConfig kube.ClustersConfig) (core.KubernetesConfigGetter, error) {
+	restConfig, err := InClusterConfig(serveOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// return the closure fuction that takes the context, but preserving the required scope,
+	// 'inClusterConfig' and 'config'
+	return createConfigGetterWithParams(restConfig, serveOpts, clustersConfig)
+}
+
+// InClusterConfig returns the config of the service account of kubeapps-apis
+// for the cluster on which it is installed, or of the local kubeconfig during
+// development.
+func InClusterConfig(serveOpts core.ServeOptions) (*rest.Config, error) {
 	var restConfig *rest.Config
 	var err error
 
@@ -302,10 +403,7 @@ func createConfigGetter(serveOpts core.ServeOptions, clustersConfig kube.Cluster
 			return nil, fmt.Errorf("unable to get inClusterConfig: %w", err)
 		}
 	}
-
-	// return the closure fuction that takes the context, but preserving the required scope,
-	// 'inClusterConfig' and 'config'
-	return createConfigGetterWithParams(restConfig, serveOpts, clustersConfig)
+	return restConfig, nil
 }
 
 // createClientGetter takes the required params and returns the closure fuction.
@@ -318,7 +416,7 @@ func createConfigGetterWithParams(inClusterConfig *rest.Config, serveOpts core.S
 		var err error
 		token, err := extractToken(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid authorization metadata: %v", err)
+			return nil, statuserror.Errorf(codes.Unauthenticated, statuserror.ReasonUnauthenticated, "invalid authorization metadata: %v", err)
 		}
 
 		var config *rest.Config
@@ -343,6 +441,14 @@ func createConfigGetterWithParams(inClusterConfig *rest.Config, serveOpts core.S
 			config.Burst = serveOpts.Burst
 		}
 
+		if serveOpts.TracingEndpoint != "" {
+			// Trace each request to the API server as part of the request
+			// context of the client calls.
+			config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+				return otelhttp.NewTransport(rt)
+			})
+		}
+
 		return config, nil
 	}, nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
 	"github.com/vmware-tanzu/kubeapps/pkg/kube"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -26,13 +27,16 @@ import (
 var ignoreUnexported = cmpopts.IgnoreUnexported(
 	PluginWithServer{},
 	plugins.Plugin{},
+	plugins.ConfiguredPlugin{},
+	plugins.PluginCapabilities{},
 )
 
 func TestPluginsAvailable(t *testing.T) {
 	testCases := []struct {
-		name              string
-		configuredPlugins []PluginWithServer
-		expectedPlugins   []*plugins.Plugin
+		name                      string
+		configuredPlugins         []PluginWithServer
+		expectedPlugins           []*plugins.Plugin
+		expectedConfiguredPlugins []*plugins.ConfiguredPlugin
 	}{
 		{
 			name: "it returns the configured plugins verbatim",
@@ -60,6 +64,53 @@ func TestPluginsAvailable(t *testing.T) {
 					Version: "v1alpha1",
 				},
 			},
+			expectedConfiguredPlugins: []*plugins.ConfiguredPlugin{
+				{
+					Plugin: &plugins.Plugin{
+						Name:    "fluxv2.packages",
+						Version: "v1alpha1",
+					},
+				},
+				{
+					Plugin: &plugins.Plugin{
+						Name:    "kapp_controller.packages",
+						Version: "v1alpha1",
+					},
+				},
+			},
+		},
+		{
+			name: "it returns the capabilities of the configured plugins",
+			configuredPlugins: []PluginWithServer{
+				{
+					Plugin: &plugins.Plugin{
+						Name:    "helm.packages",
+						Version: "v1alpha1",
+					},
+					Capabilities: &plugins.PluginCapabilities{
+						Packages: true,
+						Rollback: true,
+					},
+				},
+			},
+			expectedPlugins: []*plugins.Plugin{
+				{
+					Name:    "helm.packages",
+					Version: "v1alpha1",
+				},
+			},
+			expectedConfiguredPlugins: []*plugins.ConfiguredPlugin{
+				{
+					Plugin: &plugins.Plugin{
+						Name:    "helm.packages",
+						Version: "v1alpha1",
+					},
+					Capabilities: &plugins.PluginCapabilities{
+						Packages: true,
+						Rollback: true,
+					},
+				},
+			},
 		},
 		// We may later allow requesting just plugins for a specific service.
 	}
@@ -78,6 +129,90 @@ func TestPluginsAvailable(t *testing.T) {
 			if got, want := resp.Plugins, tc.expectedPlugins; !cmp.Equal(want, got, ignoreUnexported) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
 			}
+			if got, want := resp.ConfiguredPlugins, tc.expectedConfiguredPlugins; !cmp.Equal(want, got, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
+// packagingPluginWithCapabilities is a packaging plugin server declaring its
+// optional features.
+type packagingPluginWithCapabilities struct {
+	plugin_test.TestPackagingPluginServer
+	capabilities *plugins.PluginCapabilities
+}
+
+func (s packagingPluginWithCapabilities) GetPluginCapabilities() *plugins.PluginCapabilities {
+	return s.capabilities
+}
+
+// repositoriesPluginWithCapabilities is a repositories plugin server declaring
+// optional features of the packages service which it does not implement.
+type repositoriesPluginWithCapabilities struct {
+	plugin_test.TestRepositoriesPluginServer
+}
+
+func (s repositoriesPluginWithCapabilities) GetPluginCapabilities() *plugins.PluginCapabilities {
+	return &plugins.PluginCapabilities{Rollback: true, MultiCluster: true, UpdatePreview: true}
+}
+
+func TestPluginCapabilities(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		server               interface{}
+		expectedCapabilities *plugins.PluginCapabilities
+	}{
+		{
+			name:                 "it discovers the packages service from the interfaces of the server",
+			server:               plugin_test.TestPackagingPluginServer{},
+			expectedCapabilities: &plugins.PluginCapabilities{Packages: true},
+		},
+		{
+			name:                 "it discovers the repositories service from the interfaces of the server",
+			server:               plugin_test.TestRepositoriesPluginServer{},
+			expectedCapabilities: &plugins.PluginCapabilities{Repositories: true},
+		},
+		{
+			name: "it includes the capabilities declared by the server",
+			server: packagingPluginWithCapabilities{
+				capabilities: &plugins.PluginCapabilities{
+					Rollback:              true,
+					ReconciliationOptions: true,
+					ValuesSchema:          true,
+				},
+			},
+			expectedCapabilities: &plugins.PluginCapabilities{
+				Packages:              true,
+				Rollback:              true,
+				ReconciliationOptions: true,
+				ValuesSchema:          true,
+			},
+		},
+		{
+			name: "it does not trust the services declared by the server",
+			server: packagingPluginWithCapabilities{
+				capabilities: &plugins.PluginCapabilities{Repositories: true},
+			},
+			expectedCapabilities: &plugins.PluginCapabilities{Packages: true},
+		},
+		{
+			name:                 "it ignores declared packages features when the packages service is not implemented",
+			server:               repositoriesPluginWithCapabilities{},
+			expectedCapabilities: &plugins.PluginCapabilities{Repositories: true, MultiCluster: true},
+		},
+		{
+			name:                 "it returns no capabilities for a nil server",
+			server:               nil,
+			expectedCapabilities: &plugins.PluginCapabilities{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := pluginCapabilities(tc.server), tc.expectedCapabilities; !cmp.Equal(want, got, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
 		})
 	}
 }
@@ -367,6 +502,7 @@ func TestCreateConfigGetterWithParams(t *testing.T) {
 		contextValue    string
 		expectedAPIHost string
 		expectedErrMsg  error
+		tracingEndpoint string
 	}{
 		{
 			name:            "it creates the config for the default cluster when passing a valid value for the authorization metadata",
@@ -375,6 +511,13 @@ func TestCreateConfigGetterWithParams(t *testing.T) {
 			expectedAPIHost: DefaultK8sAPI,
 			expectedErrMsg:  nil,
 		},
+		{
+			name:            "it creates the config with a traced transport when tracing is enabled",
+			contextKey:      "authorization",
+			contextValue:    "Bearer abc",
+			expectedAPIHost: DefaultK8sAPI,
+			tracingEndpoint: "otel-collector:4317",
+		},
 		{
 			name:           "it doesn't create the config and throws a grpc error when passing an invalid authorization metadata",
 			contextKey:     "authorization",
@@ -407,6 +550,7 @@ func TestCreateConfigGetterWithParams(t *testing.T) {
 			serveOpts := core.ServeOptions{
 				ClustersConfigPath: "/config.yaml",
 				PinnipedProxyURL:   "http://example.com",
+				TracingEndpoint:    tc.tracingEndpoint,
 			}
 			configGetter, err := createConfigGetterWithParams(inClusterConfig, serveOpts, clustersConfig)
 			if err != nil {
@@ -429,6 +573,9 @@ func TestCreateConfigGetterWithParams(t *testing.T) {
 				if got, want := restConfig.Host, tc.expectedAPIHost; got != want {
 					t.Errorf("got: %q, want: %q", got, want)
 				}
+				if got, want := restConfig.WrapTransport != nil, tc.tracingEndpoint != ""; got != want {
+					t.Errorf("got wrapped transport: %t, want: %t", got, want)
+				}
 			}
 		})
 	}
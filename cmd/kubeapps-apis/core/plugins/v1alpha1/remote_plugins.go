@@ -0,0 +1,352 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	log "k8s.io/klog/v2"
+)
+
+// RemotePluginConfig is the configuration of an out-of-process plugin which
+// serves the core packages and/or repositories APIs over gRPC.
+type RemotePluginConfig struct {
+	// Name and Version are the plugin detail used to route requests to the
+	// plugin, such as `acme.packages` and `v1alpha1`.
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Address is the gRPC endpoint of the plugin, such as
+	// `acme-plugin.kubeapps.svc.cluster.local:50051`.
+	Address string `json:"address"`
+	// TLS configures the connection to the plugin. It is required unless
+	// Insecure is set.
+	TLS *RemotePluginTLSConfig `json:"tls,omitempty"`
+	// Insecure explicitly opts in to connecting to the plugin without TLS,
+	// which sends the user's credentials in plain text and should only be
+	// used when the connection is otherwise secured, such as by a service
+	// mesh, or for development.
+	Insecure bool `json:"insecure,omitempty"`
+	// Capabilities are the features supported by the plugin, including
+	// which of the core packages and repositories services it implements,
+	// using the JSON representation of PluginCapabilities.
+	Capabilities json.RawMessage `json:"capabilities,omitempty"`
+}
+
+// RemotePluginTLSConfig configures the TLS connection to a remote plugin.
+type RemotePluginTLSConfig struct {
+	// CAFile is the path to the PEM encoded CA used to verify the plugin
+	// certificate. The system roots are used when it is not set.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile are the paths to the PEM encoded client
+	// certificate and key, for plugins requiring mutual TLS.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// ServerName overrides the name used to verify the plugin certificate.
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// parseRemotePluginsConfig reads the list of remote plugins from the JSON
+// config file at the given path.
+func parseRemotePluginsConfig(configPath string) ([]RemotePluginConfig, error) {
+	configBytes, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open remote plugins config at %q: %w", configPath, err)
+	}
+	var configs []RemotePluginConfig
+	if err = json.Unmarshal(configBytes, &configs); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal remote plugins config %q: %w", configPath, err)
+	}
+	for _, config := range configs {
+		if config.Name == "" || config.Version == "" || config.Address == "" {
+			return nil, fmt.Errorf("invalid remote plugin config %+v: the name, version and address are required", config)
+		}
+		if config.TLS == nil && !config.Insecure {
+			return nil, fmt.Errorf("invalid remote plugin config %+v: the tls config is required unless insecure is set", config)
+		}
+		if config.TLS != nil && config.Insecure {
+			return nil, fmt.Errorf("invalid remote plugin config %+v: only one of tls or insecure can be set", config)
+		}
+	}
+	return configs, nil
+}
+
+// dialOptions returns the options for dialing the remote plugin with the
+// configured transport credentials.
+func (c RemotePluginConfig) dialOptions() ([]grpc.DialOption, error) {
+	if c.TLS == nil {
+		if !c.Insecure {
+			return nil, fmt.Errorf("remote plugin %q requires a tls config, or insecure to be set to connect without TLS", c.Name)
+		}
+		log.Warningf("Connecting to remote plugin %q at %q WITHOUT TLS: the credentials of the users will be sent in plain text. This is not recommended except when the connection is otherwise secured or for development purposes.", c.Name, c.Address)
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         c.TLS.ServerName,
+		InsecureSkipVerify: c.TLS.InsecureSkipVerify, // #nosec G402
+	}
+	if c.TLS.CAFile != "" {
+		caCert, err := ioutil.ReadFile(c.TLS.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CA for remote plugin %q: %w", c.Name, err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("unable to parse the CA for remote plugin %q", c.Name)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	if c.TLS.CertFile != "" || c.TLS.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.TLS.CertFile, c.TLS.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client certificate for remote plugin %q: %w", c.Name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// newRemotePluginWithServer connects to the remote plugin and returns a
+// PluginWithServer whose server proxies the requests of the core services to
+// it, so that it is treated exactly like an in-process plugin.
+func newRemotePluginWithServer(config RemotePluginConfig, extraDialOpts ...grpc.DialOption) (PluginWithServer, *grpc.ClientConn, error) {
+	declared := &plugins.PluginCapabilities{}
+	if len(config.Capabilities) > 0 {
+		if err := protojson.Unmarshal(config.Capabilities, declared); err != nil {
+			return PluginWithServer{}, nil, fmt.Errorf("unable to parse the capabilities for remote plugin %q: %w", config.Name, err)
+		}
+	}
+	if !declared.Packages && !declared.Repositories {
+		return PluginWithServer{}, nil, fmt.Errorf("remote plugin %q must implement at least one of the core packages or repositories services", config.Name)
+	}
+
+	dialOpts, err := config.dialOptions()
+	if err != nil {
+		return PluginWithServer{}, nil, err
+	}
+	// The connection is established lazily, so that plugins can be started
+	// after kubeapps-apis.
+	conn, err := grpc.Dial(config.Address, append(dialOpts, extraDialOpts...)...)
+	if err != nil {
+		return PluginWithServer{}, nil, fmt.Errorf("unable to dial remote plugin %q at %q: %w", config.Name, config.Address, err)
+	}
+
+	remote := remotePlugin{capabilities: declared}
+	var server interface{}
+	switch {
+	case declared.Packages && declared.Repositories:
+		server = &remotePackagesAndRepositoriesServer{
+			remotePackagesServer:     remotePackagesServer{remotePlugin: remote, client: packages.NewPackagesServiceClient(conn)},
+			remoteRepositoriesServer: remoteRepositoriesServer{remotePlugin: remote, client: packages.NewRepositoriesServiceClient(conn)},
+		}
+	case declared.Packages:
+		server = &remotePackagesServer{remotePlugin: remote, client: packages.NewPackagesServiceClient(conn)}
+	default:
+		server = &remoteRepositoriesServer{remotePlugin: remote, client: packages.NewRepositoriesServiceClient(conn)}
+	}
+
+	return PluginWithServer{
+		Plugin: &plugins.Plugin{
+			Name:    config.Name,
+			Version: config.Version,
+		},
+		Server:       server,
+		Capabilities: pluginCapabilities(server),
+	}, conn, nil
+}
+
+// authorizationHeader is the only metadata forwarded to remote plugins.
+const authorizationHeader = "authorization"
+
+// remotePlugin declares the capabilities configured for a remote plugin.
+type remotePlugin struct {
+	capabilities *plugins.PluginCapabilities
+}
+
+func (r remotePlugin) GetPluginCapabilities() *plugins.PluginCapabilities {
+	return r.capabilities
+}
+
+// outgoingContext forwards the user's authorization header of the incoming
+// request to the remote plugin. Other metadata, such as cookies, is not
+// forwarded so that it is not leaked to the plugin.
+func outgoingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	authorization := md.Get(authorizationHeader)
+	if len(authorization) == 0 {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs(authorizationHeader, authorization[0]))
+}
+
+// remotePackagesServer implements the core packages service by proxying each
+// request to the remote plugin.
+type remotePackagesServer struct {
+	remotePlugin
+	client packages.PackagesServiceClient
+}
+
+var _ packages.PackagesServiceServer = (*remotePackagesServer)(nil)
+
+func (s *remotePackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	return s.client.GetAvailablePackageSummaries(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error) {
+	return s.client.GetAvailablePackageDetail(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	return s.client.GetAvailablePackageVersions(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	return s.client.GetInstalledPackageSummaries(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) WatchInstalledPackageSummaries(request *packages.WatchInstalledPackageSummariesRequest, stream packages.PackagesService_WatchInstalledPackageSummariesServer) error {
+	ctx := stream.Context()
+	client, err := s.client.WatchInstalledPackageSummaries(outgoingContext(ctx), request)
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err = stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *remotePackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	return s.client.GetInstalledPackageDetail(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	return s.client.CreateInstalledPackage(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	return s.client.UpdateInstalledPackage(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageUpdatePreview(ctx context.Context, request *packages.GetInstalledPackageUpdatePreviewRequest) (*packages.GetInstalledPackageUpdatePreviewResponse, error) {
+	return s.client.GetInstalledPackageUpdatePreview(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	return s.client.DeleteInstalledPackage(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageResourceRefs(ctx context.Context, request *packages.GetInstalledPackageResourceRefsRequest) (*packages.GetInstalledPackageResourceRefsResponse, error) {
+	return s.client.GetInstalledPackageResourceRefs(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageRevisions(ctx context.Context, request *packages.GetInstalledPackageRevisionsRequest) (*packages.GetInstalledPackageRevisionsResponse, error) {
+	return s.client.GetInstalledPackageRevisions(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) RollbackInstalledPackage(ctx context.Context, request *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error) {
+	return s.client.RollbackInstalledPackage(outgoingContext(ctx), request)
+}
+
+func (s *remotePackagesServer) CheckInstalledPackagePermissions(ctx context.Context, request *packages.CheckInstalledPackagePermissionsRequest) (*packages.CheckInstalledPackagePermissionsResponse, error) {
+	return s.client.CheckInstalledPackagePermissions(outgoingContext(ctx), request)
+}
+
+// remoteRepositoriesServer implements the core repositories service by
+// proxying each request to the remote plugin.
+type remoteRepositoriesServer struct {
+	remotePlugin
+	client packages.RepositoriesServiceClient
+}
+
+var _ packages.RepositoriesServiceServer = (*remoteRepositoriesServer)(nil)
+
+func (s *remoteRepositoriesServer) AddPackageRepository(ctx context.Context, request *packages.AddPackageRepositoryRequest) (*packages.AddPackageRepositoryResponse, error) {
+	return s.client.AddPackageRepository(outgoingContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) GetPackageRepositoryDetail(ctx context.Context, request *packages.GetPackageRepositoryDetailRequest) (*packages.GetPackageRepositoryDetailResponse, error) {
+	return s.client.GetPackageRepositoryDetail(outgoingContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) GetPackageRepositorySummaries(ctx context.Context, request *packages.GetPackageRepositorySummariesRequest) (*packages.GetPackageRepositorySummariesResponse, error) {
+	return s.client.GetPackageRepositorySummaries(outgoingContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) UpdatePackageRepository(ctx context.Context, request *packages.UpdatePackageRepositoryRequest) (*packages.UpdatePackageRepositoryResponse, error) {
+	return s.client.UpdatePackageRepository(outgoingContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) DeletePackageRepository(ctx context.Context, request *packages.DeletePackageRepositoryRequest) (*packages.DeletePackageRepositoryResponse, error) {
+	return s.client.DeletePackageRepository(outgoingContext(ctx), request)
+}
+
+// remotePackagesAndRepositoriesServer implements both core services for a
+// remote plugin. The embedded servers share the same capabilities, which are
+// declared once to avoid an ambiguous selector.
+type remotePackagesAndRepositoriesServer struct {
+	remotePackagesServer
+	remoteRepositoriesServer
+}
+
+func (s *remotePackagesAndRepositoriesServer) GetPluginCapabilities() *plugins.PluginCapabilities {
+	return s.remotePackagesServer.GetPluginCapabilities()
+}
+
+// registerRemotePlugins connects to each configured remote plugin, returning
+// the connections so that they can be closed on shutdown.
+func registerRemotePlugins(configPath string) ([]PluginWithServer, []*grpc.ClientConn, error) {
+	configs, err := parseRemotePluginsConfig(configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	pluginsWithServers := []PluginWithServer{}
+	conns := []*grpc.ClientConn{}
+	for _, config := range configs {
+		pluginWithServer, conn, err := newRemotePluginWithServer(config)
+		if err != nil {
+			closeConns(conns)
+			return nil, nil, err
+		}
+		pluginsWithServers = append(pluginsWithServers, pluginWithServer)
+		conns = append(conns, conn)
+		log.Infof("Successfully registered remote plugin %q at %q", config.Name, config.Address)
+	}
+	return pluginsWithServers, conns, nil
+}
+
+// closeConns closes the connections to the remote plugins.
+func closeConns(conns []*grpc.ClientConn) {
+	for _, conn := range conns {
+		if err := conn.Close(); err != nil {
+			log.Errorf("Unable to close the connection to remote plugin at %q: %v", conn.Target(), err)
+		}
+	}
+}
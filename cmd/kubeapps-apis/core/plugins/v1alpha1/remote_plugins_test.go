@@ -0,0 +1,337 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestParseRemotePluginsConfig(t *testing.T) {
+	testCases := []struct {
+		name            string
+		config          string
+		expectedConfigs []RemotePluginConfig
+		expectedErr     bool
+	}{
+		{
+			name: "it parses the remote plugins",
+			config: `[{
+				"name": "acme.packages",
+				"version": "v1alpha1",
+				"address": "acme-plugin:50051",
+				"tls": {"caFile": "/etc/acme/ca.crt", "serverName": "acme"}
+			}]`,
+			expectedConfigs: []RemotePluginConfig{
+				{
+					Name:    "acme.packages",
+					Version: "v1alpha1",
+					Address: "acme-plugin:50051",
+					TLS: &RemotePluginTLSConfig{
+						CAFile:     "/etc/acme/ca.crt",
+						ServerName: "acme",
+					},
+				},
+			},
+		},
+		{
+			name: "it parses an insecure remote plugin",
+			config: `[{
+				"name": "acme.packages",
+				"version": "v1alpha1",
+				"address": "acme-plugin:50051",
+				"insecure": true
+			}]`,
+			expectedConfigs: []RemotePluginConfig{
+				{
+					Name:     "acme.packages",
+					Version:  "v1alpha1",
+					Address:  "acme-plugin:50051",
+					Insecure: true,
+				},
+			},
+		},
+		{
+			name:        "it returns an error if neither tls nor insecure is set",
+			config:      `[{"name": "acme.packages", "version": "v1alpha1", "address": "acme-plugin:50051"}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "it returns an error if both tls and insecure are set",
+			config:      `[{"name": "acme.packages", "version": "v1alpha1", "address": "acme-plugin:50051", "tls": {}, "insecure": true}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "it returns an error if the address is missing",
+			config:      `[{"name": "acme.packages", "version": "v1alpha1"}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "it returns an error for invalid JSON",
+			config:      `acme.packages`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "remote-plugins.json")
+			if err := os.WriteFile(configPath, []byte(tc.config), 0600); err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			configs, err := parseRemotePluginsConfig(configPath)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+
+			if got, want := configs, tc.expectedConfigs; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+// startRemotePlugin serves the packaging plugin in-memory, returning a dial
+// option to connect to it and the metadata received with the last request.
+func startRemotePlugin(t *testing.T, server packages.PackagesServiceServer) (grpc.DialOption, *metadata.MD) {
+	lis := bufconn.Listen(1024 * 1024)
+	receivedMetadata := &metadata.MD{}
+	grpcSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			*receivedMetadata, _ = metadata.FromIncomingContext(ctx)
+			return handler(ctx, req)
+		}),
+	)
+	packages.RegisterPackagesServiceServer(grpcSrv, server)
+	go func() {
+		if err := grpcSrv.Serve(lis); err != nil {
+			t.Logf("%+v", err)
+		}
+	}()
+	t.Cleanup(grpcSrv.Stop)
+
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), receivedMetadata
+}
+
+func TestRemotePluginProxiesRequests(t *testing.T) {
+	remotePlugin := &plugins.Plugin{Name: "acme.packages", Version: "v1alpha1"}
+	installedPackageSummaries := []*packages.InstalledPackageSummary{
+		{
+			InstalledPackageRef: &packages.InstalledPackageReference{
+				Identifier: "installed-pkg-1",
+				Plugin:     remotePlugin,
+			},
+			Name: "installed-pkg-1",
+		},
+	}
+	dialOpt, receivedMetadata := startRemotePlugin(t, plugin_test.TestPackagingPluginServer{
+		Plugin:                    remotePlugin,
+		InstalledPackageSummaries: installedPackageSummaries,
+	})
+
+	pluginWithServer, conn, err := newRemotePluginWithServer(RemotePluginConfig{
+		Name:         "acme.packages",
+		Version:      "v1alpha1",
+		Address:      "bufnet",
+		Insecure:     true,
+		Capabilities: []byte(`{"packages": true, "rollback": true}`),
+	}, dialOpt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer conn.Close()
+
+	opts := cmpopts.IgnoreUnexported(plugins.Plugin{}, plugins.PluginCapabilities{})
+	if got, want := pluginWithServer.Plugin, remotePlugin; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+	expectedCapabilities := &plugins.PluginCapabilities{Packages: true, Rollback: true}
+	if got, want := pluginWithServer.Capabilities, expectedCapabilities; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	// The remote plugin is only treated as a packaging plugin.
+	ps := PluginsServer{pluginsWithServers: []PluginWithServer{pluginWithServer}}
+	if got, want := len(ps.GetPluginsSatisfyingInterface(reflect.TypeOf((*packages.PackagesServiceServer)(nil)).Elem())), 1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+	if got, want := len(ps.GetPluginsSatisfyingInterface(reflect.TypeOf((*packages.RepositoriesServiceServer)(nil)).Elem())), 0; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"authorization": "Bearer abc",
+		"cookie":        "kubeapps_auth=secret",
+	}))
+	response, err := pluginWithServer.Server.(packages.PackagesServiceServer).GetInstalledPackageSummaries(ctx, &packages.GetInstalledPackageSummariesRequest{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	opts = cmpopts.IgnoreUnexported(packages.InstalledPackageSummary{}, packages.InstalledPackageReference{}, plugins.Plugin{})
+	if got, want := response.InstalledPackageSummaries, installedPackageSummaries; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+	if got, want := receivedMetadata.Get("authorization"), []string{"Bearer abc"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	// Only the authorization header is forwarded to the remote plugin.
+	if got := receivedMetadata.Get("cookie"); len(got) != 0 {
+		t.Errorf("got: %+v, want: no cookie forwarded", got)
+	}
+}
+
+func TestRemotePluginKeepsErrorCodes(t *testing.T) {
+	dialOpt, _ := startRemotePlugin(t, plugin_test.TestPackagingPluginServer{
+		Status: codes.NotFound,
+	})
+
+	pluginWithServer, conn, err := newRemotePluginWithServer(RemotePluginConfig{
+		Name:         "acme.packages",
+		Version:      "v1alpha1",
+		Address:      "bufnet",
+		Insecure:     true,
+		Capabilities: []byte(`{"packages": true}`),
+	}, dialOpt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer conn.Close()
+
+	_, err = pluginWithServer.Server.(packages.PackagesServiceServer).GetInstalledPackageDetail(context.Background(), &packages.GetInstalledPackageDetailRequest{})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
+func TestRemotePluginProxiesWatch(t *testing.T) {
+	remotePlugin := &plugins.Plugin{Name: "acme.packages", Version: "v1alpha1"}
+	dialOpt, _ := startRemotePlugin(t, plugin_test.TestPackagingPluginServer{
+		Plugin: remotePlugin,
+		InstalledPackageSummaries: []*packages.InstalledPackageSummary{
+			{Name: "installed-pkg-1"},
+			{Name: "installed-pkg-2"},
+		},
+	})
+
+	pluginWithServer, conn, err := newRemotePluginWithServer(RemotePluginConfig{
+		Name:         "acme.packages",
+		Version:      "v1alpha1",
+		Address:      "bufnet",
+		Insecure:     true,
+		Capabilities: []byte(`{"packages": true}`),
+	}, dialOpt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer conn.Close()
+
+	stream := &plugin_test.TestWatchInstalledPackageSummariesStream{Ctx: context.Background()}
+	err = pluginWithServer.Server.(packages.PackagesServiceServer).WatchInstalledPackageSummaries(&packages.WatchInstalledPackageSummariesRequest{}, stream)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	names := []string{}
+	for _, r := range stream.Responses {
+		names = append(names, r.GetInstalledPackageSummary().GetName())
+	}
+	if got, want := names, []string{"installed-pkg-1", "installed-pkg-2"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestNewRemotePluginWithServerErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config RemotePluginConfig
+	}{
+		{
+			name: "it requires one of the core services",
+			config: RemotePluginConfig{
+				Name:         "acme.packages",
+				Version:      "v1alpha1",
+				Address:      "acme-plugin:50051",
+				Capabilities: []byte(`{"rollback": true}`),
+			},
+		},
+		{
+			name: "it returns an error for unknown capabilities",
+			config: RemotePluginConfig{
+				Name:         "acme.packages",
+				Version:      "v1alpha1",
+				Address:      "acme-plugin:50051",
+				Capabilities: []byte(`{"packages": true, "teleport": true}`),
+			},
+		},
+		{
+			name: "it requires tls unless insecure is set",
+			config: RemotePluginConfig{
+				Name:         "acme.packages",
+				Version:      "v1alpha1",
+				Address:      "acme-plugin:50051",
+				Capabilities: []byte(`{"packages": true}`),
+			},
+		},
+		{
+			name: "it returns an error if the CA cannot be read",
+			config: RemotePluginConfig{
+				Name:         "acme.packages",
+				Version:      "v1alpha1",
+				Address:      "acme-plugin:50051",
+				TLS:          &RemotePluginTLSConfig{CAFile: "/does/not/exist"},
+				Capabilities: []byte(`{"packages": true}`),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := newRemotePluginWithServer(tc.config); err == nil {
+				t.Errorf("got: nil, want: error")
+			}
+		})
+	}
+}
+
+func TestPluginsServerClosesRemoteConns(t *testing.T) {
+	dialOpt, _ := startRemotePlugin(t, plugin_test.TestPackagingPluginServer{})
+
+	_, conn, err := newRemotePluginWithServer(RemotePluginConfig{
+		Name:         "acme.packages",
+		Version:      "v1alpha1",
+		Address:      "bufnet",
+		Insecure:     true,
+		Capabilities: []byte(`{"packages": true}`),
+	}, dialOpt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	ps := PluginsServer{remoteConns: []*grpc.ClientConn{conn}}
+	ps.Close()
+
+	if got, want := conn.GetState(), connectivity.Shutdown; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
@@ -17,11 +17,29 @@ type ServeOptions struct {
 	PluginDirs               []string
 	ClustersConfigPath       string
 	PluginConfigPath         string
+	RemotePluginsConfigPath  string
 	PinnipedProxyURL         string
 	GlobalReposNamespace     string
 	UnsafeLocalDevKubeconfig bool
 	QPS                      float32
 	Burst                    int
+	// TracingEndpoint is the OTLP gRPC endpoint to which traces are
+	// exported. Tracing is disabled when empty.
+	TracingEndpoint    string
+	TracingInsecure    bool
+	TracingSampleRatio float64
+	// AuditLogPath is the file to which the audit events are appended, or
+	// "-" for stdout. The audit log is disabled when both it and the
+	// AuditWebhookURL are empty.
+	AuditLogPath    string
+	AuditWebhookURL string
+	// NotificationsConfigPath is the configuration of the notifications of
+	// the new versions of the installed packages, which are disabled when
+	// it is empty.
+	NotificationsConfigPath string
+	// MetricsPort is the port on which the Prometheus metrics are served,
+	// which are disabled when it is 0.
+	MetricsPort int
 }
 
 // GatewayHandlerArgs is a helper struct just encapsulating all the args
@@ -0,0 +1,988 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: kubeappsapis/core/packages/v1alpha1/bundles.proto
+
+package v1alpha1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExportFormat
+//
+// The format in which the installed packages are exported.
+type ExportInstalledPackagesRequest_ExportFormat int32
+
+const (
+	// No format, which is invalid.
+	ExportInstalledPackagesRequest_EXPORT_FORMAT_UNSPECIFIED ExportInstalledPackagesRequest_ExportFormat = 0
+	// A Flux HelmRelease for each package, together with the HelmRepository
+	// of its chart. Only available for packages which are Helm charts.
+	ExportInstalledPackagesRequest_EXPORT_FORMAT_FLUX ExportInstalledPackagesRequest_ExportFormat = 1
+	// A Carvel PackageInstall for each package, together with a Secret with
+	// its values. Only available for packages which are Carvel packages.
+	ExportInstalledPackagesRequest_EXPORT_FORMAT_CARVEL ExportInstalledPackagesRequest_ExportFormat = 2
+	// A helmfile with a release for each package and the repositories of
+	// their charts. Only available for packages which are Helm charts.
+	ExportInstalledPackagesRequest_EXPORT_FORMAT_HELMFILE ExportInstalledPackagesRequest_ExportFormat = 3
+)
+
+// Enum value maps for ExportInstalledPackagesRequest_ExportFormat.
+var (
+	ExportInstalledPackagesRequest_ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_FLUX",
+		2: "EXPORT_FORMAT_CARVEL",
+		3: "EXPORT_FORMAT_HELMFILE",
+	}
+	ExportInstalledPackagesRequest_ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_FLUX":        1,
+		"EXPORT_FORMAT_CARVEL":      2,
+		"EXPORT_FORMAT_HELMFILE":    3,
+	}
+)
+
+func (x ExportInstalledPackagesRequest_ExportFormat) Enum() *ExportInstalledPackagesRequest_ExportFormat {
+	p := new(ExportInstalledPackagesRequest_ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportInstalledPackagesRequest_ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportInstalledPackagesRequest_ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportInstalledPackagesRequest_ExportFormat) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_enumTypes[0]
+}
+
+func (x ExportInstalledPackagesRequest_ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportInstalledPackagesRequest_ExportFormat.Descriptor instead.
+func (ExportInstalledPackagesRequest_ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// ImportStatus
+//
+// The outcome of the import of a package.
+type ImportedPackageResult_ImportStatus int32
+
+const (
+	// No status, which is invalid.
+	ImportedPackageResult_IMPORT_STATUS_UNSPECIFIED ImportedPackageResult_ImportStatus = 0
+	// The installed package was created.
+	ImportedPackageResult_IMPORT_STATUS_CREATED ImportedPackageResult_ImportStatus = 1
+	// An installed package with the same name already existed in the target
+	// context, so it was left untouched.
+	ImportedPackageResult_IMPORT_STATUS_ALREADY_EXISTS ImportedPackageResult_ImportStatus = 2
+	// The installed package could not be created. See the message.
+	ImportedPackageResult_IMPORT_STATUS_FAILED ImportedPackageResult_ImportStatus = 3
+)
+
+// Enum value maps for ImportedPackageResult_ImportStatus.
+var (
+	ImportedPackageResult_ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNSPECIFIED",
+		1: "IMPORT_STATUS_CREATED",
+		2: "IMPORT_STATUS_ALREADY_EXISTS",
+		3: "IMPORT_STATUS_FAILED",
+	}
+	ImportedPackageResult_ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNSPECIFIED":    0,
+		"IMPORT_STATUS_CREATED":        1,
+		"IMPORT_STATUS_ALREADY_EXISTS": 2,
+		"IMPORT_STATUS_FAILED":         3,
+	}
+)
+
+func (x ImportedPackageResult_ImportStatus) Enum() *ImportedPackageResult_ImportStatus {
+	p := new(ImportedPackageResult_ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportedPackageResult_ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportedPackageResult_ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportedPackageResult_ImportStatus) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_enumTypes[1]
+}
+
+func (x ImportedPackageResult_ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportedPackageResult_ImportStatus.Descriptor instead.
+func (ImportedPackageResult_ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{6, 0}
+}
+
+// ExportInstalledPackagesRequest
+//
+// Request for ExportInstalledPackages
+type ExportInstalledPackagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The references of the installed packages to be exported. Required
+	InstalledPackageRefs []*InstalledPackageReference `protobuf:"bytes,1,rep,name=installed_package_refs,json=installedPackageRefs,proto3" json:"installed_package_refs,omitempty"`
+	// The format of the export. Required
+	Format ExportInstalledPackagesRequest_ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest_ExportFormat" json:"format,omitempty"`
+}
+
+func (x *ExportInstalledPackagesRequest) Reset() {
+	*x = ExportInstalledPackagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInstalledPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInstalledPackagesRequest) ProtoMessage() {}
+
+func (x *ExportInstalledPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInstalledPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ExportInstalledPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportInstalledPackagesRequest) GetInstalledPackageRefs() []*InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRefs
+	}
+	return nil
+}
+
+func (x *ExportInstalledPackagesRequest) GetFormat() ExportInstalledPackagesRequest_ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportInstalledPackagesRequest_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// ExportInstalledPackagesResponse
+//
+// Response for ExportInstalledPackages
+type ExportInstalledPackagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exported packages
+	//
+	// The definition of each installed package, in the order of the request.
+	ExportedPackages []*ExportedPackage `protobuf:"bytes,1,rep,name=exported_packages,json=exportedPackages,proto3" json:"exported_packages,omitempty"`
+	// Bundle
+	//
+	// The definitions of all the installed packages as a single file: a
+	// multi-document YAML file with each resource only once for the Flux and
+	// Carvel formats, or a single helmfile.
+	Bundle string `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// Packages
+	//
+	// The portable definition of the installed packages, which can be
+	// imported in another context with ImportInstalledPackages.
+	Packages []*BundledPackage `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
+}
+
+func (x *ExportInstalledPackagesResponse) Reset() {
+	*x = ExportInstalledPackagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInstalledPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInstalledPackagesResponse) ProtoMessage() {}
+
+func (x *ExportInstalledPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInstalledPackagesResponse.ProtoReflect.Descriptor instead.
+func (*ExportInstalledPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportInstalledPackagesResponse) GetExportedPackages() []*ExportedPackage {
+	if x != nil {
+		return x.ExportedPackages
+	}
+	return nil
+}
+
+func (x *ExportInstalledPackagesResponse) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+func (x *ExportInstalledPackagesResponse) GetPackages() []*BundledPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+// ExportedPackage
+//
+// The declarative definition of an installed package.
+type ExportedPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference of the exported installed package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// The YAML definition of the installed package, including the resources
+	// it depends on, such as the repository of a Helm chart.
+	Manifest string `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *ExportedPackage) Reset() {
+	*x = ExportedPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportedPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedPackage) ProtoMessage() {}
+
+func (x *ExportedPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedPackage.ProtoReflect.Descriptor instead.
+func (*ExportedPackage) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportedPackage) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *ExportedPackage) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+// ImportInstalledPackagesRequest
+//
+// Request for ImportInstalledPackages
+type ImportInstalledPackagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The context (cluster/namespace) in which the packages are installed. Required
+	TargetContext *Context `protobuf:"bytes,1,opt,name=target_context,json=targetContext,proto3" json:"target_context,omitempty"`
+	// The packages to be installed. Required
+	Packages []*BundledPackage `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
+}
+
+func (x *ImportInstalledPackagesRequest) Reset() {
+	*x = ImportInstalledPackagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportInstalledPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportInstalledPackagesRequest) ProtoMessage() {}
+
+func (x *ImportInstalledPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportInstalledPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ImportInstalledPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportInstalledPackagesRequest) GetTargetContext() *Context {
+	if x != nil {
+		return x.TargetContext
+	}
+	return nil
+}
+
+func (x *ImportInstalledPackagesRequest) GetPackages() []*BundledPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+// ImportInstalledPackagesResponse
+//
+// Response for ImportInstalledPackages
+type ImportInstalledPackagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results
+	//
+	// The result of the import of each package, in the order of the request.
+	Results []*ImportedPackageResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ImportInstalledPackagesResponse) Reset() {
+	*x = ImportInstalledPackagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportInstalledPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportInstalledPackagesResponse) ProtoMessage() {}
+
+func (x *ImportInstalledPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportInstalledPackagesResponse.ProtoReflect.Descriptor instead.
+func (*ImportInstalledPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportInstalledPackagesResponse) GetResults() []*ImportedPackageResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BundledPackage
+//
+// The portable definition of an installed package, independent of the
+// context in which it is installed.
+type BundledPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the installed package.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The reference of the available package it is installed from. Its
+	// context is only a hint: when importing, the package is looked up first
+	// in the target context and then in this one.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,2,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// The version of the available package, as a version constraint where
+	// supported.
+	PkgVersionReference *VersionReference `protobuf:"bytes,3,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// The values of the installed package, as a YAML or JSON string.
+	Values string `protobuf:"bytes,4,opt,name=values,proto3" json:"values,omitempty"`
+	// The reconciliation options of the installed package, if any.
+	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,5,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+}
+
+func (x *BundledPackage) Reset() {
+	*x = BundledPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundledPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundledPackage) ProtoMessage() {}
+
+func (x *BundledPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundledPackage.ProtoReflect.Descriptor instead.
+func (*BundledPackage) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BundledPackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundledPackage) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *BundledPackage) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *BundledPackage) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *BundledPackage) GetReconciliationOptions() *ReconciliationOptions {
+	if x != nil {
+		return x.ReconciliationOptions
+	}
+	return nil
+}
+
+// ImportedPackageResult
+//
+// The result of the import of a package.
+type ImportedPackageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the package in the bundle.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The status of the import.
+	Status ImportedPackageResult_ImportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=kubeappsapis.core.packages.v1alpha1.ImportedPackageResult_ImportStatus" json:"status,omitempty"`
+	// The reference of the installed package in the target context, unless
+	// the import failed.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,3,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// The reference of the available package resolved in the target context,
+	// if it was found.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,4,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// A human-readable description of the result, such as the reason of a
+	// failure.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportedPackageResult) Reset() {
+	*x = ImportedPackageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportedPackageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedPackageResult) ProtoMessage() {}
+
+func (x *ImportedPackageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedPackageResult.ProtoReflect.Descriptor instead.
+func (*ImportedPackageResult) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImportedPackageResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedPackageResult) GetStatus() ImportedPackageResult_ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportedPackageResult_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportedPackageResult) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *ImportedPackageResult) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *ImportedPackageResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_kubeappsapis_core_packages_v1alpha1_bundles_proto protoreflect.FileDescriptor
+
+var file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x23, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x32, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd, 0x02, 0x0a, 0x1e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x74, 0x0a,
+	0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x73, 0x12, 0x68, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x50, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x7b, 0x0a,
+	0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a,
+	0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x4c,
+	0x55, 0x58, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x41, 0x52, 0x56, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x48, 0x45, 0x4c, 0x4d, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x22, 0xed, 0x01, 0x0a, 0x1f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x11, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x08, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x72,
+	0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0xc6,
+	0x01, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x53, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4f, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x1f, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x8e, 0x03, 0x0a, 0x0e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x69, 0x0a, 0x15, 0x70,
+	0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71,
+	0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x95, 0x04, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x5f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x47, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a,
+	0x1c, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0xd8, 0x03, 0x0a, 0x0e, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xe1, 0x01, 0x0a,
+	0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x44, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x30, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0xe1, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x43, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x44, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22,
+	0x30, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x3a, 0x01, 0x2a, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x2d, 0x74, 0x61, 0x6e, 0x7a, 0x75, 0x2f,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescOnce sync.Once
+	file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescData = file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDesc
+)
+
+func file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescGZIP() []byte {
+	file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescOnce.Do(func() {
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescData = protoimpl.X.CompressGZIP(file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescData)
+	})
+	return file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDescData
+}
+
+var file_kubeappsapis_core_packages_v1alpha1_bundles_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_kubeappsapis_core_packages_v1alpha1_bundles_proto_goTypes = []interface{}{
+	(ExportInstalledPackagesRequest_ExportFormat)(0), // 0: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.ExportFormat
+	(ImportedPackageResult_ImportStatus)(0),          // 1: kubeappsapis.core.packages.v1alpha1.ImportedPackageResult.ImportStatus
+	(*ExportInstalledPackagesRequest)(nil),           // 2: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest
+	(*ExportInstalledPackagesResponse)(nil),          // 3: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesResponse
+	(*ExportedPackage)(nil),                          // 4: kubeappsapis.core.packages.v1alpha1.ExportedPackage
+	(*ImportInstalledPackagesRequest)(nil),           // 5: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest
+	(*ImportInstalledPackagesResponse)(nil),          // 6: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesResponse
+	(*BundledPackage)(nil),                           // 7: kubeappsapis.core.packages.v1alpha1.BundledPackage
+	(*ImportedPackageResult)(nil),                    // 8: kubeappsapis.core.packages.v1alpha1.ImportedPackageResult
+	(*InstalledPackageReference)(nil),                // 9: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	(*Context)(nil),                                  // 10: kubeappsapis.core.packages.v1alpha1.Context
+	(*AvailablePackageReference)(nil),                // 11: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	(*VersionReference)(nil),                         // 12: kubeappsapis.core.packages.v1alpha1.VersionReference
+	(*ReconciliationOptions)(nil),                    // 13: kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+}
+var file_kubeappsapis_core_packages_v1alpha1_bundles_proto_depIdxs = []int32{
+	9,  // 0: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.installed_package_refs:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	0,  // 1: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.format:type_name -> kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.ExportFormat
+	4,  // 2: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesResponse.exported_packages:type_name -> kubeappsapis.core.packages.v1alpha1.ExportedPackage
+	7,  // 3: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesResponse.packages:type_name -> kubeappsapis.core.packages.v1alpha1.BundledPackage
+	9,  // 4: kubeappsapis.core.packages.v1alpha1.ExportedPackage.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	10, // 5: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	7,  // 6: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest.packages:type_name -> kubeappsapis.core.packages.v1alpha1.BundledPackage
+	8,  // 7: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesResponse.results:type_name -> kubeappsapis.core.packages.v1alpha1.ImportedPackageResult
+	11, // 8: kubeappsapis.core.packages.v1alpha1.BundledPackage.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	12, // 9: kubeappsapis.core.packages.v1alpha1.BundledPackage.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	13, // 10: kubeappsapis.core.packages.v1alpha1.BundledPackage.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	1,  // 11: kubeappsapis.core.packages.v1alpha1.ImportedPackageResult.status:type_name -> kubeappsapis.core.packages.v1alpha1.ImportedPackageResult.ImportStatus
+	9,  // 12: kubeappsapis.core.packages.v1alpha1.ImportedPackageResult.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	11, // 13: kubeappsapis.core.packages.v1alpha1.ImportedPackageResult.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	2,  // 14: kubeappsapis.core.packages.v1alpha1.BundlesService.ExportInstalledPackages:input_type -> kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest
+	5,  // 15: kubeappsapis.core.packages.v1alpha1.BundlesService.ImportInstalledPackages:input_type -> kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest
+	3,  // 16: kubeappsapis.core.packages.v1alpha1.BundlesService.ExportInstalledPackages:output_type -> kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesResponse
+	6,  // 17: kubeappsapis.core.packages.v1alpha1.BundlesService.ImportInstalledPackages:output_type -> kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesResponse
+	16, // [16:18] is the sub-list for method output_type
+	14, // [14:16] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_kubeappsapis_core_packages_v1alpha1_bundles_proto_init() }
+func file_kubeappsapis_core_packages_v1alpha1_bundles_proto_init() {
+	if File_kubeappsapis_core_packages_v1alpha1_bundles_proto != nil {
+		return
+	}
+	file_kubeappsapis_core_packages_v1alpha1_packages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInstalledPackagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInstalledPackagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportedPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportInstalledPackagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportInstalledPackagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundledPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportedPackageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kubeappsapis_core_packages_v1alpha1_bundles_proto_goTypes,
+		DependencyIndexes: file_kubeappsapis_core_packages_v1alpha1_bundles_proto_depIdxs,
+		EnumInfos:         file_kubeappsapis_core_packages_v1alpha1_bundles_proto_enumTypes,
+		MessageInfos:      file_kubeappsapis_core_packages_v1alpha1_bundles_proto_msgTypes,
+	}.Build()
+	File_kubeappsapis_core_packages_v1alpha1_bundles_proto = out.File
+	file_kubeappsapis_core_packages_v1alpha1_bundles_proto_rawDesc = nil
+	file_kubeappsapis_core_packages_v1alpha1_bundles_proto_goTypes = nil
+	file_kubeappsapis_core_packages_v1alpha1_bundles_proto_depIdxs = nil
+}
@@ -0,0 +1,153 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: kubeappsapis/core/packages/v1alpha1/bundles.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BundlesServiceClient is the client API for BundlesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BundlesServiceClient interface {
+	// ExportInstalledPackages returns the declarative definition of the
+	// installed packages, in the requested format, so that they can be stored
+	// in Git and reconciled by a GitOps tool.
+	ExportInstalledPackages(ctx context.Context, in *ExportInstalledPackagesRequest, opts ...grpc.CallOption) (*ExportInstalledPackagesResponse, error)
+	// ImportInstalledPackages creates the installed packages of a bundle, such
+	// as the one returned by ExportInstalledPackages, in the target context.
+	// Installed packages which already exist there are left untouched, so
+	// that the same bundle can be imported again.
+	ImportInstalledPackages(ctx context.Context, in *ImportInstalledPackagesRequest, opts ...grpc.CallOption) (*ImportInstalledPackagesResponse, error)
+}
+
+type bundlesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBundlesServiceClient(cc grpc.ClientConnInterface) BundlesServiceClient {
+	return &bundlesServiceClient{cc}
+}
+
+func (c *bundlesServiceClient) ExportInstalledPackages(ctx context.Context, in *ExportInstalledPackagesRequest, opts ...grpc.CallOption) (*ExportInstalledPackagesResponse, error) {
+	out := new(ExportInstalledPackagesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.BundlesService/ExportInstalledPackages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundlesServiceClient) ImportInstalledPackages(ctx context.Context, in *ImportInstalledPackagesRequest, opts ...grpc.CallOption) (*ImportInstalledPackagesResponse, error) {
+	out := new(ImportInstalledPackagesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.BundlesService/ImportInstalledPackages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BundlesServiceServer is the server API for BundlesService service.
+// All implementations should embed UnimplementedBundlesServiceServer
+// for forward compatibility
+type BundlesServiceServer interface {
+	// ExportInstalledPackages returns the declarative definition of the
+	// installed packages, in the requested format, so that they can be stored
+	// in Git and reconciled by a GitOps tool.
+	ExportInstalledPackages(context.Context, *ExportInstalledPackagesRequest) (*ExportInstalledPackagesResponse, error)
+	// ImportInstalledPackages creates the installed packages of a bundle, such
+	// as the one returned by ExportInstalledPackages, in the target context.
+	// Installed packages which already exist there are left untouched, so
+	// that the same bundle can be imported again.
+	ImportInstalledPackages(context.Context, *ImportInstalledPackagesRequest) (*ImportInstalledPackagesResponse, error)
+}
+
+// UnimplementedBundlesServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedBundlesServiceServer struct {
+}
+
+func (UnimplementedBundlesServiceServer) ExportInstalledPackages(context.Context, *ExportInstalledPackagesRequest) (*ExportInstalledPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInstalledPackages not implemented")
+}
+func (UnimplementedBundlesServiceServer) ImportInstalledPackages(context.Context, *ImportInstalledPackagesRequest) (*ImportInstalledPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInstalledPackages not implemented")
+}
+
+// UnsafeBundlesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BundlesServiceServer will
+// result in compilation errors.
+type UnsafeBundlesServiceServer interface {
+	mustEmbedUnimplementedBundlesServiceServer()
+}
+
+func RegisterBundlesServiceServer(s grpc.ServiceRegistrar, srv BundlesServiceServer) {
+	s.RegisterService(&BundlesService_ServiceDesc, srv)
+}
+
+func _BundlesService_ExportInstalledPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportInstalledPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlesServiceServer).ExportInstalledPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.BundlesService/ExportInstalledPackages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlesServiceServer).ExportInstalledPackages(ctx, req.(*ExportInstalledPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundlesService_ImportInstalledPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInstalledPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlesServiceServer).ImportInstalledPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.BundlesService/ImportInstalledPackages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlesServiceServer).ImportInstalledPackages(ctx, req.(*ImportInstalledPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BundlesService_ServiceDesc is the grpc.ServiceDesc for BundlesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BundlesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kubeappsapis.core.packages.v1alpha1.BundlesService",
+	HandlerType: (*BundlesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportInstalledPackages",
+			Handler:    _BundlesService_ExportInstalledPackages_Handler,
+		},
+		{
+			MethodName: "ImportInstalledPackages",
+			Handler:    _BundlesService_ImportInstalledPackages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kubeappsapis/core/packages/v1alpha1/bundles.proto",
+}
@@ -26,11 +26,31 @@ type PackagesServiceClient interface {
 	GetAvailablePackageDetail(ctx context.Context, in *GetAvailablePackageDetailRequest, opts ...grpc.CallOption) (*GetAvailablePackageDetailResponse, error)
 	GetAvailablePackageVersions(ctx context.Context, in *GetAvailablePackageVersionsRequest, opts ...grpc.CallOption) (*GetAvailablePackageVersionsResponse, error)
 	GetInstalledPackageSummaries(ctx context.Context, in *GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*GetInstalledPackageSummariesResponse, error)
+	// WatchInstalledPackageSummaries streams the additions, updates and
+	// deletions of installed packages in the requested context, aggregated
+	// across the registered plugins. Existing installed packages are sent as
+	// additions when the watch starts.
+	WatchInstalledPackageSummaries(ctx context.Context, in *WatchInstalledPackageSummariesRequest, opts ...grpc.CallOption) (PackagesService_WatchInstalledPackageSummariesClient, error)
 	GetInstalledPackageDetail(ctx context.Context, in *GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailResponse, error)
 	CreateInstalledPackage(ctx context.Context, in *CreateInstalledPackageRequest, opts ...grpc.CallOption) (*CreateInstalledPackageResponse, error)
 	UpdateInstalledPackage(ctx context.Context, in *UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*UpdateInstalledPackageResponse, error)
+	GetInstalledPackageUpdatePreview(ctx context.Context, in *GetInstalledPackageUpdatePreviewRequest, opts ...grpc.CallOption) (*GetInstalledPackageUpdatePreviewResponse, error)
 	DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error)
+	// GetInstalledPackageResourceRefs returns the references of the resources
+	// of an installed package and, when requested with include_manifest, their
+	// rendered manifest. The kapp-controller plugin never returns the manifest.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an
+	// installed package, with the most recent revision first.
+	GetInstalledPackageRevisions(ctx context.Context, in *GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous
+	// revision.
+	RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is
+	// not allowed to perform when creating or updating an installed package
+	// with the given version and values, so that a client can warn the user
+	// before starting an installation which would fail part way.
+	CheckInstalledPackagePermissions(ctx context.Context, in *CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*CheckInstalledPackagePermissionsResponse, error)
 }
 
 type packagesServiceClient struct {
@@ -77,6 +97,38 @@ func (c *packagesServiceClient) GetInstalledPackageSummaries(ctx context.Context
 	return out, nil
 }
 
+func (c *packagesServiceClient) WatchInstalledPackageSummaries(ctx context.Context, in *WatchInstalledPackageSummariesRequest, opts ...grpc.CallOption) (PackagesService_WatchInstalledPackageSummariesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PackagesService_ServiceDesc.Streams[0], "/kubeappsapis.core.packages.v1alpha1.PackagesService/WatchInstalledPackageSummaries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &packagesServiceWatchInstalledPackageSummariesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PackagesService_WatchInstalledPackageSummariesClient interface {
+	Recv() (*WatchInstalledPackageSummariesResponse, error)
+	grpc.ClientStream
+}
+
+type packagesServiceWatchInstalledPackageSummariesClient struct {
+	grpc.ClientStream
+}
+
+func (x *packagesServiceWatchInstalledPackageSummariesClient) Recv() (*WatchInstalledPackageSummariesResponse, error) {
+	m := new(WatchInstalledPackageSummariesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *packagesServiceClient) GetInstalledPackageDetail(ctx context.Context, in *GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailResponse, error) {
 	out := new(GetInstalledPackageDetailResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail", in, out, opts...)
@@ -104,6 +156,15 @@ func (c *packagesServiceClient) UpdateInstalledPackage(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *packagesServiceClient) GetInstalledPackageUpdatePreview(ctx context.Context, in *GetInstalledPackageUpdatePreviewRequest, opts ...grpc.CallOption) (*GetInstalledPackageUpdatePreviewResponse, error) {
+	out := new(GetInstalledPackageUpdatePreviewResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageUpdatePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error) {
 	out := new(DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -122,6 +183,33 @@ func (c *packagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Cont
 	return out, nil
 }
 
+func (c *packagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsResponse, error) {
+	out := new(GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error) {
+	out := new(RollbackInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/RollbackInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) CheckInstalledPackagePermissions(ctx context.Context, in *CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*CheckInstalledPackagePermissionsResponse, error) {
+	out := new(CheckInstalledPackagePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/CheckInstalledPackagePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PackagesServiceServer is the server API for PackagesService service.
 // All implementations should embed UnimplementedPackagesServiceServer
 // for forward compatibility
@@ -130,11 +218,31 @@ type PackagesServiceServer interface {
 	GetAvailablePackageDetail(context.Context, *GetAvailablePackageDetailRequest) (*GetAvailablePackageDetailResponse, error)
 	GetAvailablePackageVersions(context.Context, *GetAvailablePackageVersionsRequest) (*GetAvailablePackageVersionsResponse, error)
 	GetInstalledPackageSummaries(context.Context, *GetInstalledPackageSummariesRequest) (*GetInstalledPackageSummariesResponse, error)
+	// WatchInstalledPackageSummaries streams the additions, updates and
+	// deletions of installed packages in the requested context, aggregated
+	// across the registered plugins. Existing installed packages are sent as
+	// additions when the watch starts.
+	WatchInstalledPackageSummaries(*WatchInstalledPackageSummariesRequest, PackagesService_WatchInstalledPackageSummariesServer) error
 	GetInstalledPackageDetail(context.Context, *GetInstalledPackageDetailRequest) (*GetInstalledPackageDetailResponse, error)
 	CreateInstalledPackage(context.Context, *CreateInstalledPackageRequest) (*CreateInstalledPackageResponse, error)
 	UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error)
+	GetInstalledPackageUpdatePreview(context.Context, *GetInstalledPackageUpdatePreviewRequest) (*GetInstalledPackageUpdatePreviewResponse, error)
 	DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error)
+	// GetInstalledPackageResourceRefs returns the references of the resources
+	// of an installed package and, when requested with include_manifest, their
+	// rendered manifest. The kapp-controller plugin never returns the manifest.
 	GetInstalledPackageResourceRefs(context.Context, *GetInstalledPackageResourceRefsRequest) (*GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an
+	// installed package, with the most recent revision first.
+	GetInstalledPackageRevisions(context.Context, *GetInstalledPackageRevisionsRequest) (*GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous
+	// revision.
+	RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is
+	// not allowed to perform when creating or updating an installed package
+	// with the given version and values, so that a client can warn the user
+	// before starting an installation which would fail part way.
+	CheckInstalledPackagePermissions(context.Context, *CheckInstalledPackagePermissionsRequest) (*CheckInstalledPackagePermissionsResponse, error)
 }
 
 // UnimplementedPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -153,6 +261,9 @@ func (UnimplementedPackagesServiceServer) GetAvailablePackageVersions(context.Co
 func (UnimplementedPackagesServiceServer) GetInstalledPackageSummaries(context.Context, *GetInstalledPackageSummariesRequest) (*GetInstalledPackageSummariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageSummaries not implemented")
 }
+func (UnimplementedPackagesServiceServer) WatchInstalledPackageSummaries(*WatchInstalledPackageSummariesRequest, PackagesService_WatchInstalledPackageSummariesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInstalledPackageSummaries not implemented")
+}
 func (UnimplementedPackagesServiceServer) GetInstalledPackageDetail(context.Context, *GetInstalledPackageDetailRequest) (*GetInstalledPackageDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDetail not implemented")
 }
@@ -162,12 +273,24 @@ func (UnimplementedPackagesServiceServer) CreateInstalledPackage(context.Context
 func (UnimplementedPackagesServiceServer) UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedPackagesServiceServer) GetInstalledPackageUpdatePreview(context.Context, *GetInstalledPackageUpdatePreviewRequest) (*GetInstalledPackageUpdatePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpdatePreview not implemented")
+}
 func (UnimplementedPackagesServiceServer) DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
 func (UnimplementedPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *GetInstalledPackageResourceRefsRequest) (*GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedPackagesServiceServer) GetInstalledPackageRevisions(context.Context, *GetInstalledPackageRevisionsRequest) (*GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedPackagesServiceServer) RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
+}
+func (UnimplementedPackagesServiceServer) CheckInstalledPackagePermissions(context.Context, *CheckInstalledPackagePermissionsRequest) (*CheckInstalledPackagePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInstalledPackagePermissions not implemented")
+}
 
 // UnsafePackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PackagesServiceServer will
@@ -252,6 +375,27 @@ func _PackagesService_GetInstalledPackageSummaries_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_WatchInstalledPackageSummaries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInstalledPackageSummariesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PackagesServiceServer).WatchInstalledPackageSummaries(m, &packagesServiceWatchInstalledPackageSummariesServer{stream})
+}
+
+type PackagesService_WatchInstalledPackageSummariesServer interface {
+	Send(*WatchInstalledPackageSummariesResponse) error
+	grpc.ServerStream
+}
+
+type packagesServiceWatchInstalledPackageSummariesServer struct {
+	grpc.ServerStream
+}
+
+func (x *packagesServiceWatchInstalledPackageSummariesServer) Send(m *WatchInstalledPackageSummariesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PackagesService_GetInstalledPackageDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInstalledPackageDetailRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +450,24 @@ func _PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_GetInstalledPackageUpdatePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageUpdatePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageUpdatePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageUpdatePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageUpdatePreview(ctx, req.(*GetInstalledPackageUpdatePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +504,60 @@ func _PackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).RollbackInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/RollbackInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).RollbackInstalledPackage(ctx, req.(*RollbackInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_CheckInstalledPackagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInstalledPackagePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).CheckInstalledPackagePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/CheckInstalledPackagePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).CheckInstalledPackagePermissions(ctx, req.(*CheckInstalledPackagePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PackagesService_ServiceDesc is the grpc.ServiceDesc for PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -377,6 +593,10 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _PackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpdatePreview",
+			Handler:    _PackagesService_GetInstalledPackageUpdatePreview_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _PackagesService_DeleteInstalledPackage_Handler,
@@ -385,7 +605,25 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _PackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _PackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackInstalledPackage",
+			Handler:    _PackagesService_RollbackInstalledPackage_Handler,
+		},
+		{
+			MethodName: "CheckInstalledPackagePermissions",
+			Handler:    _PackagesService_CheckInstalledPackagePermissions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInstalledPackageSummaries",
+			Handler:       _PackagesService_WatchInstalledPackageSummaries_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/core/packages/v1alpha1/packages.proto",
 }
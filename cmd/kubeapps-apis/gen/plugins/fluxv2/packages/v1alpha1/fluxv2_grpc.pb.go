@@ -37,11 +37,20 @@ type FluxV2PackagesServiceClient interface {
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpdatePreview returns the changes to resources which would result from an update.
+	GetInstalledPackageUpdatePreview(ctx context.Context, in *v1alpha1.GetInstalledPackageUpdatePreviewRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an installed package.
+	GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+	// when creating or updating an installed package.
+	CheckInstalledPackagePermissions(ctx context.Context, in *v1alpha1.CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error)
 }
 
 type fluxV2PackagesServiceClient struct {
@@ -115,6 +124,15 @@ func (c *fluxV2PackagesServiceClient) UpdateInstalledPackage(ctx context.Context
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageUpdatePreview(ctx context.Context, in *v1alpha1.GetInstalledPackageUpdatePreviewRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageUpdatePreviewResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageUpdatePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fluxV2PackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	out := new(v1alpha1.DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -133,6 +151,33 @@ func (c *fluxV2PackagesServiceClient) GetInstalledPackageResourceRefs(ctx contex
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	out := new(v1alpha1.RollbackInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/RollbackInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) CheckInstalledPackagePermissions(ctx context.Context, in *v1alpha1.CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error) {
+	out := new(v1alpha1.CheckInstalledPackagePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CheckInstalledPackagePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FluxV2PackagesServiceServer is the server API for FluxV2PackagesService service.
 // All implementations should embed UnimplementedFluxV2PackagesServiceServer
 // for forward compatibility
@@ -151,11 +196,20 @@ type FluxV2PackagesServiceServer interface {
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpdatePreview returns the changes to resources which would result from an update.
+	GetInstalledPackageUpdatePreview(context.Context, *v1alpha1.GetInstalledPackageUpdatePreviewRequest) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an installed package.
+	GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+	// when creating or updating an installed package.
+	CheckInstalledPackagePermissions(context.Context, *v1alpha1.CheckInstalledPackagePermissionsRequest) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error)
 }
 
 // UnimplementedFluxV2PackagesServiceServer should be embedded to have forward compatible implementations.
@@ -183,12 +237,24 @@ func (UnimplementedFluxV2PackagesServiceServer) CreateInstalledPackage(context.C
 func (UnimplementedFluxV2PackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageUpdatePreview(context.Context, *v1alpha1.GetInstalledPackageUpdatePreviewRequest) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpdatePreview not implemented")
+}
 func (UnimplementedFluxV2PackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
 func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) CheckInstalledPackagePermissions(context.Context, *v1alpha1.CheckInstalledPackagePermissionsRequest) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInstalledPackagePermissions not implemented")
+}
 
 // UnsafeFluxV2PackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FluxV2PackagesServiceServer will
@@ -327,6 +393,24 @@ func _FluxV2PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetInstalledPackageUpdatePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageUpdatePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageUpdatePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageUpdatePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageUpdatePreview(ctx, req.(*v1alpha1.GetInstalledPackageUpdatePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FluxV2PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -363,6 +447,60 @@ func _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*v1alpha1.GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RollbackInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).RollbackInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/RollbackInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).RollbackInstalledPackage(ctx, req.(*v1alpha1.RollbackInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_CheckInstalledPackagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.CheckInstalledPackagePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).CheckInstalledPackagePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CheckInstalledPackagePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).CheckInstalledPackagePermissions(ctx, req.(*v1alpha1.CheckInstalledPackagePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FluxV2PackagesService_ServiceDesc is the grpc.ServiceDesc for FluxV2PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -398,6 +536,10 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _FluxV2PackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpdatePreview",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageUpdatePreview_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _FluxV2PackagesService_DeleteInstalledPackage_Handler,
@@ -406,6 +548,18 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackInstalledPackage",
+			Handler:    _FluxV2PackagesService_RollbackInstalledPackage_Handler,
+		},
+		{
+			MethodName: "CheckInstalledPackagePermissions",
+			Handler:    _FluxV2PackagesService_CheckInstalledPackagePermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/fluxv2/packages/v1alpha1/fluxv2.proto",
@@ -37,13 +37,25 @@ type HelmPackagesServiceClient interface {
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpdatePreview returns the changes to resources which would result from an update.
+	GetInstalledPackageUpdatePreview(ctx context.Context, in *v1alpha1.GetInstalledPackageUpdatePreviewRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
-	// RollbackInstalledPackage updates an installed package based on the request.
+	// Deprecated: Do not use.
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	//
+	// Deprecated: use the RollbackInstalledPackage RPC of the core packages
+	// service, which takes a revision rather than a release_revision. This
+	// RPC will be removed in a future release.
 	RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+	// when creating or updating an installed package.
+	CheckInstalledPackagePermissions(ctx context.Context, in *v1alpha1.CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an installed package.
+	GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
 }
 
 type helmPackagesServiceClient struct {
@@ -117,6 +129,15 @@ func (c *helmPackagesServiceClient) UpdateInstalledPackage(ctx context.Context,
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) GetInstalledPackageUpdatePreview(ctx context.Context, in *v1alpha1.GetInstalledPackageUpdatePreviewRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageUpdatePreviewResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageUpdatePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	out := new(v1alpha1.DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DeleteInstalledPackage", in, out, opts...)
@@ -126,6 +147,7 @@ func (c *helmPackagesServiceClient) DeleteInstalledPackage(ctx context.Context,
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *helmPackagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error) {
 	out := new(RollbackInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage", in, out, opts...)
@@ -135,6 +157,15 @@ func (c *helmPackagesServiceClient) RollbackInstalledPackage(ctx context.Context
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) CheckInstalledPackagePermissions(ctx context.Context, in *v1alpha1.CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error) {
+	out := new(v1alpha1.CheckInstalledPackagePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CheckInstalledPackagePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	out := new(v1alpha1.GetInstalledPackageResourceRefsResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceRefs", in, out, opts...)
@@ -144,6 +175,15 @@ func (c *helmPackagesServiceClient) GetInstalledPackageResourceRefs(ctx context.
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HelmPackagesServiceServer is the server API for HelmPackagesService service.
 // All implementations should embed UnimplementedHelmPackagesServiceServer
 // for forward compatibility
@@ -162,13 +202,25 @@ type HelmPackagesServiceServer interface {
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
 	// UpdateInstalledPackage updates an installed package based on the request.
 	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// GetInstalledPackageUpdatePreview returns the changes to resources which would result from an update.
+	GetInstalledPackageUpdatePreview(context.Context, *v1alpha1.GetInstalledPackageUpdatePreviewRequest) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error)
 	// DeleteInstalledPackage deletes an installed package based on the request.
 	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
-	// RollbackInstalledPackage updates an installed package based on the request.
+	// Deprecated: Do not use.
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	//
+	// Deprecated: use the RollbackInstalledPackage RPC of the core packages
+	// service, which takes a revision rather than a release_revision. This
+	// RPC will be removed in a future release.
 	RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+	// when creating or updating an installed package.
+	CheckInstalledPackagePermissions(context.Context, *v1alpha1.CheckInstalledPackagePermissionsRequest) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an installed package.
+	GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
 }
 
 // UnimplementedHelmPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -196,15 +248,24 @@ func (UnimplementedHelmPackagesServiceServer) CreateInstalledPackage(context.Con
 func (UnimplementedHelmPackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageUpdatePreview(context.Context, *v1alpha1.GetInstalledPackageUpdatePreviewRequest) (*v1alpha1.GetInstalledPackageUpdatePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageUpdatePreview not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
 func (UnimplementedHelmPackagesServiceServer) RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) CheckInstalledPackagePermissions(context.Context, *v1alpha1.CheckInstalledPackagePermissionsRequest) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInstalledPackagePermissions not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
 
 // UnsafeHelmPackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HelmPackagesServiceServer will
@@ -343,6 +404,24 @@ func _HelmPackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_GetInstalledPackageUpdatePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageUpdatePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageUpdatePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageUpdatePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageUpdatePreview(ctx, req.(*v1alpha1.GetInstalledPackageUpdatePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -379,6 +458,24 @@ func _HelmPackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_CheckInstalledPackagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.CheckInstalledPackagePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).CheckInstalledPackagePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CheckInstalledPackagePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).CheckInstalledPackagePermissions(ctx, req.(*v1alpha1.CheckInstalledPackagePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.GetInstalledPackageResourceRefsRequest)
 	if err := dec(in); err != nil {
@@ -397,6 +494,24 @@ func _HelmPackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*v1alpha1.GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HelmPackagesService_ServiceDesc is the grpc.ServiceDesc for HelmPackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -432,6 +547,10 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _HelmPackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageUpdatePreview",
+			Handler:    _HelmPackagesService_GetInstalledPackageUpdatePreview_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _HelmPackagesService_DeleteInstalledPackage_Handler,
@@ -440,10 +559,18 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RollbackInstalledPackage",
 			Handler:    _HelmPackagesService_RollbackInstalledPackage_Handler,
 		},
+		{
+			MethodName: "CheckInstalledPackagePermissions",
+			Handler:    _HelmPackagesService_CheckInstalledPackagePermissions_Handler,
+		},
 		{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _HelmPackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _HelmPackagesService_GetInstalledPackageRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/helm/packages/v1alpha1/helm.proto",
@@ -44,6 +44,13 @@ type KappControllerPackagesServiceClient interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an installed package.
+	GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+	// when creating or updating an installed package.
+	CheckInstalledPackagePermissions(ctx context.Context, in *v1alpha1.CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error)
 }
 
 type kappControllerPackagesServiceClient struct {
@@ -144,6 +151,33 @@ func (c *kappControllerPackagesServiceClient) GetInstalledPackageResourceRefs(ct
 	return out, nil
 }
 
+func (c *kappControllerPackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	out := new(v1alpha1.RollbackInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/RollbackInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kappControllerPackagesServiceClient) CheckInstalledPackagePermissions(ctx context.Context, in *v1alpha1.CheckInstalledPackagePermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error) {
+	out := new(v1alpha1.CheckInstalledPackagePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/CheckInstalledPackagePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KappControllerPackagesServiceServer is the server API for KappControllerPackagesService service.
 // All implementations should embed UnimplementedKappControllerPackagesServiceServer
 // for forward compatibility
@@ -169,6 +203,13 @@ type KappControllerPackagesServiceServer interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the history of revisions of an installed package.
+	GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+	// when creating or updating an installed package.
+	CheckInstalledPackagePermissions(context.Context, *v1alpha1.CheckInstalledPackagePermissionsRequest) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error)
 }
 
 // UnimplementedKappControllerPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -205,6 +246,15 @@ func (UnimplementedKappControllerPackagesServiceServer) DeleteInstalledPackage(c
 func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
+}
+func (UnimplementedKappControllerPackagesServiceServer) CheckInstalledPackagePermissions(context.Context, *v1alpha1.CheckInstalledPackagePermissionsRequest) (*v1alpha1.CheckInstalledPackagePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInstalledPackagePermissions not implemented")
+}
 
 // UnsafeKappControllerPackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to KappControllerPackagesServiceServer will
@@ -397,6 +447,60 @@ func _KappControllerPackagesService_GetInstalledPackageResourceRefs_Handler(srv
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KappControllerPackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*v1alpha1.GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RollbackInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).RollbackInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/RollbackInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).RollbackInstalledPackage(ctx, req.(*v1alpha1.RollbackInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KappControllerPackagesService_CheckInstalledPackagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.CheckInstalledPackagePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).CheckInstalledPackagePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/CheckInstalledPackagePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).CheckInstalledPackagePermissions(ctx, req.(*v1alpha1.CheckInstalledPackagePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KappControllerPackagesService_ServiceDesc is the grpc.ServiceDesc for KappControllerPackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -444,6 +548,18 @@ var KappControllerPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _KappControllerPackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _KappControllerPackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackInstalledPackage",
+			Handler:    _KappControllerPackagesService_RollbackInstalledPackage_Handler,
+		},
+		{
+			MethodName: "CheckInstalledPackagePermissions",
+			Handler:    _KappControllerPackagesService_CheckInstalledPackagePermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/kapp_controller/packages/v1alpha1/kapp_controller.proto",
@@ -23,6 +23,29 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ResourcesServiceClient interface {
 	GetResources(ctx context.Context, in *GetResourcesRequest, opts ...grpc.CallOption) (ResourcesService_GetResourcesClient, error)
+	// GetResourcesDrift compares the resources of an installed package as
+	// rendered with their live state, to find the resources which were edited
+	// or deleted on the cluster since they were deployed.
+	GetResourcesDrift(ctx context.Context, in *GetResourcesDriftRequest, opts ...grpc.CallOption) (*GetResourcesDriftResponse, error)
+	// GetResourcesHealth rolls the health of the resources of an installed
+	// package up into a single summary.
+	GetResourcesHealth(ctx context.Context, in *GetResourcesHealthRequest, opts ...grpc.CallOption) (*GetResourcesHealthResponse, error)
+	// GetPodLogs streams the logs of the containers of the pods owned by the
+	// resources of an installed package.
+	GetPodLogs(ctx context.Context, in *GetPodLogsRequest, opts ...grpc.CallOption) (ResourcesService_GetPodLogsClient, error)
+	// GetResourcesEvents returns, and optionally watches, the Kubernetes
+	// events of the resources of an installed package and of their pods and
+	// ReplicaSets.
+	GetResourcesEvents(ctx context.Context, in *GetResourcesEventsRequest, opts ...grpc.CallOption) (ResourcesService_GetResourcesEventsClient, error)
+	// GetResourcesTopology returns the resources of an installed package as
+	// trees of their dependents, found through their owner references.
+	GetResourcesTopology(ctx context.Context, in *GetResourcesTopologyRequest, opts ...grpc.CallOption) (*GetResourcesTopologyResponse, error)
+	// ScaleResource sets the replicas of a Deployment or StatefulSet of an
+	// installed package.
+	ScaleResource(ctx context.Context, in *ScaleResourceRequest, opts ...grpc.CallOption) (*ScaleResourceResponse, error)
+	// RestartResource triggers a rollout restart of a Deployment, StatefulSet
+	// or DaemonSet of an installed package, as "kubectl rollout restart" does.
+	RestartResource(ctx context.Context, in *RestartResourceRequest, opts ...grpc.CallOption) (*RestartResourceResponse, error)
 	GetServiceAccountNames(ctx context.Context, in *GetServiceAccountNamesRequest, opts ...grpc.CallOption) (*GetServiceAccountNamesResponse, error)
 	GetNamespaceNames(ctx context.Context, in *GetNamespaceNamesRequest, opts ...grpc.CallOption) (*GetNamespaceNamesResponse, error)
 	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
@@ -71,6 +94,115 @@ func (x *resourcesServiceGetResourcesClient) Recv() (*GetResourcesResponse, erro
 	return m, nil
 }
 
+func (c *resourcesServiceClient) GetResourcesDrift(ctx context.Context, in *GetResourcesDriftRequest, opts ...grpc.CallOption) (*GetResourcesDriftResponse, error) {
+	out := new(GetResourcesDriftResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetResourcesHealth(ctx context.Context, in *GetResourcesHealthRequest, opts ...grpc.CallOption) (*GetResourcesHealthResponse, error) {
+	out := new(GetResourcesHealthResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetPodLogs(ctx context.Context, in *GetPodLogsRequest, opts ...grpc.CallOption) (ResourcesService_GetPodLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourcesService_ServiceDesc.Streams[1], "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetPodLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourcesServiceGetPodLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourcesService_GetPodLogsClient interface {
+	Recv() (*GetPodLogsResponse, error)
+	grpc.ClientStream
+}
+
+type resourcesServiceGetPodLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourcesServiceGetPodLogsClient) Recv() (*GetPodLogsResponse, error) {
+	m := new(GetPodLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourcesServiceClient) GetResourcesEvents(ctx context.Context, in *GetResourcesEventsRequest, opts ...grpc.CallOption) (ResourcesService_GetResourcesEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourcesService_ServiceDesc.Streams[2], "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourcesServiceGetResourcesEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourcesService_GetResourcesEventsClient interface {
+	Recv() (*GetResourcesEventsResponse, error)
+	grpc.ClientStream
+}
+
+type resourcesServiceGetResourcesEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourcesServiceGetResourcesEventsClient) Recv() (*GetResourcesEventsResponse, error) {
+	m := new(GetResourcesEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourcesServiceClient) GetResourcesTopology(ctx context.Context, in *GetResourcesTopologyRequest, opts ...grpc.CallOption) (*GetResourcesTopologyResponse, error) {
+	out := new(GetResourcesTopologyResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesTopology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) ScaleResource(ctx context.Context, in *ScaleResourceRequest, opts ...grpc.CallOption) (*ScaleResourceResponse, error) {
+	out := new(ScaleResourceResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/ScaleResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) RestartResource(ctx context.Context, in *RestartResourceRequest, opts ...grpc.CallOption) (*RestartResourceResponse, error) {
+	out := new(RestartResourceResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/RestartResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourcesServiceClient) GetServiceAccountNames(ctx context.Context, in *GetServiceAccountNamesRequest, opts ...grpc.CallOption) (*GetServiceAccountNamesResponse, error) {
 	out := new(GetServiceAccountNamesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetServiceAccountNames", in, out, opts...)
@@ -130,6 +262,29 @@ func (c *resourcesServiceClient) CreateSecret(ctx context.Context, in *CreateSec
 // for forward compatibility
 type ResourcesServiceServer interface {
 	GetResources(*GetResourcesRequest, ResourcesService_GetResourcesServer) error
+	// GetResourcesDrift compares the resources of an installed package as
+	// rendered with their live state, to find the resources which were edited
+	// or deleted on the cluster since they were deployed.
+	GetResourcesDrift(context.Context, *GetResourcesDriftRequest) (*GetResourcesDriftResponse, error)
+	// GetResourcesHealth rolls the health of the resources of an installed
+	// package up into a single summary.
+	GetResourcesHealth(context.Context, *GetResourcesHealthRequest) (*GetResourcesHealthResponse, error)
+	// GetPodLogs streams the logs of the containers of the pods owned by the
+	// resources of an installed package.
+	GetPodLogs(*GetPodLogsRequest, ResourcesService_GetPodLogsServer) error
+	// GetResourcesEvents returns, and optionally watches, the Kubernetes
+	// events of the resources of an installed package and of their pods and
+	// ReplicaSets.
+	GetResourcesEvents(*GetResourcesEventsRequest, ResourcesService_GetResourcesEventsServer) error
+	// GetResourcesTopology returns the resources of an installed package as
+	// trees of their dependents, found through their owner references.
+	GetResourcesTopology(context.Context, *GetResourcesTopologyRequest) (*GetResourcesTopologyResponse, error)
+	// ScaleResource sets the replicas of a Deployment or StatefulSet of an
+	// installed package.
+	ScaleResource(context.Context, *ScaleResourceRequest) (*ScaleResourceResponse, error)
+	// RestartResource triggers a rollout restart of a Deployment, StatefulSet
+	// or DaemonSet of an installed package, as "kubectl rollout restart" does.
+	RestartResource(context.Context, *RestartResourceRequest) (*RestartResourceResponse, error)
 	GetServiceAccountNames(context.Context, *GetServiceAccountNamesRequest) (*GetServiceAccountNamesResponse, error)
 	GetNamespaceNames(context.Context, *GetNamespaceNamesRequest) (*GetNamespaceNamesResponse, error)
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
@@ -145,6 +300,27 @@ type UnimplementedResourcesServiceServer struct {
 func (UnimplementedResourcesServiceServer) GetResources(*GetResourcesRequest, ResourcesService_GetResourcesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetResources not implemented")
 }
+func (UnimplementedResourcesServiceServer) GetResourcesDrift(context.Context, *GetResourcesDriftRequest) (*GetResourcesDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourcesDrift not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetResourcesHealth(context.Context, *GetResourcesHealthRequest) (*GetResourcesHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourcesHealth not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetPodLogs(*GetPodLogsRequest, ResourcesService_GetPodLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPodLogs not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetResourcesEvents(*GetResourcesEventsRequest, ResourcesService_GetResourcesEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetResourcesEvents not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetResourcesTopology(context.Context, *GetResourcesTopologyRequest) (*GetResourcesTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourcesTopology not implemented")
+}
+func (UnimplementedResourcesServiceServer) ScaleResource(context.Context, *ScaleResourceRequest) (*ScaleResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleResource not implemented")
+}
+func (UnimplementedResourcesServiceServer) RestartResource(context.Context, *RestartResourceRequest) (*RestartResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartResource not implemented")
+}
 func (UnimplementedResourcesServiceServer) GetServiceAccountNames(context.Context, *GetServiceAccountNamesRequest) (*GetServiceAccountNamesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountNames not implemented")
 }
@@ -196,6 +372,138 @@ func (x *resourcesServiceGetResourcesServer) Send(m *GetResourcesResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourcesService_GetResourcesDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourcesDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourcesDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourcesDrift(ctx, req.(*GetResourcesDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetResourcesHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourcesHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourcesHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourcesHealth(ctx, req.(*GetResourcesHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetPodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPodLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourcesServiceServer).GetPodLogs(m, &resourcesServiceGetPodLogsServer{stream})
+}
+
+type ResourcesService_GetPodLogsServer interface {
+	Send(*GetPodLogsResponse) error
+	grpc.ServerStream
+}
+
+type resourcesServiceGetPodLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourcesServiceGetPodLogsServer) Send(m *GetPodLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourcesService_GetResourcesEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetResourcesEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourcesServiceServer).GetResourcesEvents(m, &resourcesServiceGetResourcesEventsServer{stream})
+}
+
+type ResourcesService_GetResourcesEventsServer interface {
+	Send(*GetResourcesEventsResponse) error
+	grpc.ServerStream
+}
+
+type resourcesServiceGetResourcesEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourcesServiceGetResourcesEventsServer) Send(m *GetResourcesEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourcesService_GetResourcesTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourcesTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourcesTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetResourcesTopology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourcesTopology(ctx, req.(*GetResourcesTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_ScaleResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).ScaleResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/ScaleResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).ScaleResource(ctx, req.(*ScaleResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_RestartResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).RestartResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/RestartResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).RestartResource(ctx, req.(*RestartResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourcesService_GetServiceAccountNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetServiceAccountNamesRequest)
 	if err := dec(in); err != nil {
@@ -311,6 +619,26 @@ var ResourcesService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "kubeappsapis.plugins.resources.v1alpha1.ResourcesService",
 	HandlerType: (*ResourcesServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetResourcesDrift",
+			Handler:    _ResourcesService_GetResourcesDrift_Handler,
+		},
+		{
+			MethodName: "GetResourcesHealth",
+			Handler:    _ResourcesService_GetResourcesHealth_Handler,
+		},
+		{
+			MethodName: "GetResourcesTopology",
+			Handler:    _ResourcesService_GetResourcesTopology_Handler,
+		},
+		{
+			MethodName: "ScaleResource",
+			Handler:    _ResourcesService_ScaleResource_Handler,
+		},
+		{
+			MethodName: "RestartResource",
+			Handler:    _ResourcesService_RestartResource_Handler,
+		},
 		{
 			MethodName: "GetServiceAccountNames",
 			Handler:    _ResourcesService_GetServiceAccountNames_Handler,
@@ -342,6 +670,16 @@ var ResourcesService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ResourcesService_GetResources_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetPodLogs",
+			Handler:       _ResourcesService_GetPodLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetResourcesEvents",
+			Handler:       _ResourcesService_GetResourcesEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "kubeappsapis/plugins/resources/v1alpha1/resources.proto",
 }
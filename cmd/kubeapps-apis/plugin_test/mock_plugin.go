@@ -10,6 +10,7 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,7 +24,12 @@ type TestPackagingPluginServer struct {
 	InstalledPackageDetail    *corev1.InstalledPackageDetail
 	PackageAppVersions        []*corev1.PackageAppVersion
 	ResourceRefs              []*corev1.ResourceRef
+	Manifest                  string
+	ResourceChanges           []*corev1.ResourceChange
+	Revisions                 []*corev1.InstalledPackageRevision
+	ForbiddenActions          []*corev1.ForbiddenAction
 	Categories                []string
+	Facets                    *corev1.AvailablePackageFacets
 	NextPageToken             string
 	Status                    codes.Code
 }
@@ -50,9 +56,14 @@ func (s TestPackagingPluginServer) GetAvailablePackageSummaries(ctx context.Cont
 		summaries = summaries[:pageSize]
 		nextPageToken = fmt.Sprintf("%d", itemOffset+pageSize)
 	}
+	var facets *corev1.AvailablePackageFacets
+	if itemOffset == 0 {
+		facets = s.Facets
+	}
 	return &corev1.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: summaries,
 		Categories:                s.Categories,
+		Facets:                    facets,
 		NextPageToken:             nextPageToken,
 	}, nil
 }
@@ -72,12 +83,40 @@ func (s TestPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Cont
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
 	}
+	itemOffset, err := paginate.ItemOffsetFromPageToken(request.PaginationOptions.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	summaries := s.InstalledPackageSummaries[itemOffset:]
+	pageSize := int(request.PaginationOptions.GetPageSize())
+	nextPageToken := ""
+	if pageSize > 0 && pageSize < len(summaries) {
+		summaries = summaries[:pageSize]
+		nextPageToken = fmt.Sprintf("%d", itemOffset+pageSize)
+	}
 	return &corev1.GetInstalledPackageSummariesResponse{
-		InstalledPackageSummaries: s.InstalledPackageSummaries,
-		NextPageToken:             s.NextPageToken,
+		InstalledPackageSummaries: summaries,
+		NextPageToken:             nextPageToken,
 	}, nil
 }
 
+// WatchInstalledPackageSummaries sends an addition for each installed package summary.
+func (s TestPackagingPluginServer) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	if s.Status != codes.OK {
+		return status.Errorf(s.Status, "Non-OK response")
+	}
+	for _, summary := range s.InstalledPackageSummaries {
+		err := stream.Send(&corev1.WatchInstalledPackageSummariesResponse{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: summary,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetInstalledPackageDetail returns the package versions based on the request.
 func (s TestPackagingPluginServer) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
 	if s.Status != codes.OK {
@@ -103,9 +142,14 @@ func (s TestPackagingPluginServer) GetInstalledPackageResourceRefs(ctx context.C
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
 	}
+	manifest := ""
+	if request.GetIncludeManifest() {
+		manifest = s.Manifest
+	}
 	return &corev1.GetInstalledPackageResourceRefsResponse{
 		Context:      request.GetInstalledPackageRef().GetContext(),
 		ResourceRefs: s.ResourceRefs,
+		Manifest:     manifest,
 	}, nil
 }
 
@@ -135,6 +179,15 @@ func (s TestPackagingPluginServer) UpdateInstalledPackage(ctx context.Context, r
 	}, nil
 }
 
+func (s TestPackagingPluginServer) GetInstalledPackageUpdatePreview(ctx context.Context, request *corev1.GetInstalledPackageUpdatePreviewRequest) (*corev1.GetInstalledPackageUpdatePreviewResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.GetInstalledPackageUpdatePreviewResponse{
+		ResourceChanges: s.ResourceChanges,
+	}, nil
+}
+
 func (s TestPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
@@ -142,6 +195,37 @@ func (s TestPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, r
 	return &corev1.DeleteInstalledPackageResponse{}, nil
 }
 
+func (s TestPackagingPluginServer) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.GetInstalledPackageRevisionsResponse{
+		Revisions: s.Revisions,
+	}, nil
+}
+
+func (s TestPackagingPluginServer) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.RollbackInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    request.GetInstalledPackageRef().GetContext(),
+			Identifier: request.GetInstalledPackageRef().GetIdentifier(),
+			Plugin:     s.Plugin,
+		},
+	}, nil
+}
+
+func (s TestPackagingPluginServer) CheckInstalledPackagePermissions(ctx context.Context, request *corev1.CheckInstalledPackagePermissionsRequest) (*corev1.CheckInstalledPackagePermissionsResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.CheckInstalledPackagePermissionsResponse{
+		ForbiddenActions: s.ForbiddenActions,
+	}, nil
+}
+
 type TestRepositoriesPluginServer struct {
 	corev1.UnimplementedRepositoriesServiceServer
 	Plugin                     *plugins.Plugin
@@ -207,3 +291,25 @@ func (s TestRepositoriesPluginServer) DeletePackageRepository(ctx context.Contex
 	}
 	return &corev1.DeletePackageRepositoryResponse{}, nil
 }
+
+// TestWatchInstalledPackageSummariesStream is a server stream for
+// WatchInstalledPackageSummaries which records the sent responses, calling
+// OnSend (if set) for each.
+type TestWatchInstalledPackageSummariesStream struct {
+	grpc.ServerStream
+	Ctx       context.Context
+	Responses []*corev1.WatchInstalledPackageSummariesResponse
+	OnSend    func(*corev1.WatchInstalledPackageSummariesResponse)
+}
+
+func (s *TestWatchInstalledPackageSummariesStream) Context() context.Context {
+	return s.Ctx
+}
+
+func (s *TestWatchInstalledPackageSummariesStream) Send(r *corev1.WatchInstalledPackageSummariesResponse) error {
+	s.Responses = append(s.Responses, r)
+	if s.OnSend != nil {
+		s.OnSend(r)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// queueDepth is the number of items waiting to be processed in each of the
+// work queues of the chart and watcher caches. The plugin is loaded into the
+// kubeapps-apis process, so it is exposed on the same /metrics endpoint.
+var queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "kubeapps_apis",
+	Subsystem: "fluxv2",
+	Name:      "cache_queue_depth",
+	Help:      "Number of items waiting to be processed in the fluxv2 plugin cache queues.",
+}, []string{"queue"})
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -119,6 +120,7 @@ func newQueue(name string, verbose bool) *Type {
 		dirty:      sets.String{},
 		processing: sets.String{},
 		cond:       sync.NewCond(&sync.Mutex{}),
+		depth:      queueDepth.WithLabelValues(name),
 	}
 }
 
@@ -152,6 +154,9 @@ type Type struct {
 	cond *sync.Cond
 
 	shuttingDown bool
+
+	// depth is the gauge exporting the length of queue
+	depth prometheus.Gauge
 }
 
 // Add marks item as needing processing.
@@ -178,6 +183,7 @@ func (q *Type) Add(item interface{}) {
 		}
 
 		q.queue = append(q.queue, itemstr)
+		q.depth.Set(float64(len(q.queue)))
 		if q.verbose {
 			log.Infof("[%s]: Add(%s)%s", q.name, item, q.prettyPrintAll())
 		}
@@ -211,6 +217,7 @@ func (q *Type) Get() (item interface{}, shutdown bool) {
 		} else if len(q.queue) > 0 {
 			var itemstr string
 			itemstr, q.queue = q.queue[0], q.queue[1:]
+			q.depth.Set(float64(len(q.queue)))
 			q.processing.Insert(itemstr)
 			q.dirty.Delete(itemstr)
 			if q.verbose {
@@ -240,6 +247,7 @@ func (q *Type) Done(item interface{}) {
 		q.processing.Delete(itemstr)
 		if q.dirty.Has(itemstr) {
 			q.queue = append(q.queue, itemstr)
+			q.depth.Set(float64(len(q.queue)))
 		}
 		if q.verbose {
 			log.Infof("[%s]: Done(%s) %s", q.name, item, q.prettyPrintAll())
@@ -328,6 +336,7 @@ func (q *Type) reset() {
 	defer q.cond.L.Unlock()
 
 	q.queue = []string{}
+	q.depth.Set(0)
 	q.dirty = sets.String{}
 	q.processing = sets.String{}
 	// we are intentionally not resetting q.expected as we don't want to lose
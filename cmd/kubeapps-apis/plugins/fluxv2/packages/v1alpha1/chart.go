@@ -10,13 +10,18 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	pkgsummaries "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/summaries"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/valuesschema"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"github.com/vmware-tanzu/kubeapps/pkg/versionfilter"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/chart"
@@ -53,9 +58,41 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 	if err != nil {
 		return nil, err
 	} else if !isRepoReady(*repo) {
-		return nil, status.Errorf(codes.Internal, "repository [%s] is not in Ready state", repoName)
+		return nil, statuserror.ResourceErrorf(codes.Internal, statuserror.ReasonRepositoryNotReady, "HelmRepository", repoName.String(), "repository [%s] is not in Ready state", repoName)
 	}
 
+	chartID := fmt.Sprintf("%s/%s", repoName.Name, chartName)
+	byteArray, err := s.getChartTarball(ctx, repoName, chartName, chartVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	chartDetail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(byteArray), chartID)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgDetail, err := availablePackageDetailFromChartDetail(chartID, chartDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	// fix up a couple of fields that don't come from the chart tarball
+	repoUrl := repo.Spec.URL
+	if repoUrl == "" {
+		return nil, status.Errorf(codes.NotFound, "Missing required field spec.url on repository %q", repoName)
+	}
+
+	pkgDetail.RepoUrl = repoUrl
+	pkgDetail.AvailablePackageRef.Context.Namespace = packageRef.Context.Namespace
+	// per https://github.com/vmware-tanzu/kubeapps/pull/3686#issue-1038093832
+	pkgDetail.AvailablePackageRef.Context.Cluster = s.kubeappsCluster
+	return pkgDetail, nil
+}
+
+// getChartTarball returns the tarball of the requested version of a chart,
+// or of the latest version if no version is specified.
+func (s *Server) getChartTarball(ctx context.Context, repoName types.NamespacedName, chartName, chartVersion string) ([]byte, error) {
 	chartID := fmt.Sprintf("%s/%s", repoName.Name, chartName)
 	// first, try the happy path - we have the chart version and the corresponding entry
 	// happens to be in the cache
@@ -74,7 +111,7 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 		if err != nil {
 			return nil, err
 		} else if chartModel == nil {
-			return nil, status.Errorf(codes.NotFound, "chart [%s] not found", chartName)
+			return nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "HelmChart", chartName, "chart [%s] not found", chartName)
 		}
 
 		if chartVersion == "" {
@@ -91,28 +128,7 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 			return nil, status.Errorf(codes.Internal, "failed to load details for chart [%s]", chartModel.ID)
 		}
 	}
-
-	chartDetail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(byteArray), chartID)
-	if err != nil {
-		return nil, err
-	}
-
-	pkgDetail, err := availablePackageDetailFromChartDetail(chartID, chartDetail)
-	if err != nil {
-		return nil, err
-	}
-
-	// fix up a couple of fields that don't come from the chart tarball
-	repoUrl := repo.Spec.URL
-	if repoUrl == "" {
-		return nil, status.Errorf(codes.NotFound, "Missing required field spec.url on repository %q", repoName)
-	}
-
-	pkgDetail.RepoUrl = repoUrl
-	pkgDetail.AvailablePackageRef.Context.Namespace = packageRef.Context.Namespace
-	// per https://github.com/vmware-tanzu/kubeapps/pull/3686#issue-1038093832
-	pkgDetail.AvailablePackageRef.Context.Cluster = s.kubeappsCluster
-	return pkgDetail, nil
+	return byteArray, nil
 }
 
 func (s *Server) getChart(ctx context.Context, repo types.NamespacedName, chartName string) (*models.Chart, error) {
@@ -143,6 +159,86 @@ func (s *Server) getChart(ctx context.Context, repo types.NamespacedName, chartN
 	return nil, nil
 }
 
+// chartVersionForConstraint returns the latest version of the chart matching
+// the version reference, with the default upgrade policy applied, which is
+// the version flux installs. The latest version is returned if no version is
+// specified.
+func (s *Server) chartVersionForConstraint(chartModel *models.Chart, versionRef *corev1.VersionReference) (string, error) {
+	if len(chartModel.ChartVersions) == 0 {
+		return "", statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "HelmChart", chartModel.Name, "chart [%s] not found", chartModel.Name)
+	}
+	versionExpr := versionRef.GetVersion()
+	if versionExpr == "" {
+		return chartModel.ChartVersions[0].Version, nil
+	}
+	versionExpr, err := pkgutils.VersionConstraintWithUpgradePolicy(versionExpr, s.pluginConfig.DefaultUpgradePolicy)
+	if err != nil {
+		return "", err
+	}
+	constraint, err := semver.NewConstraint(versionExpr)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid version constraint [%s]: %v", versionExpr, err)
+	}
+	for _, cv := range chartModel.ChartVersions {
+		if v, err := semver.NewVersion(cv.Version); err == nil && constraint.Check(v) {
+			return cv.Version, nil
+		}
+	}
+	return "", statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "HelmChart", chartModel.Name, "no version of chart [%s] matches [%s]", chartModel.Name, versionExpr)
+}
+
+// validateValues validates the values against the values schema of the
+// version of the chart which flux would install, if the chart has one.
+func (s *Server) validateValues(ctx context.Context, repo types.NamespacedName, chartModel *models.Chart, versionRef *corev1.VersionReference, valuesString string) error {
+	if s.chartCache == nil {
+		return nil
+	}
+	chartVersion, err := s.chartVersionForConstraint(chartModel, versionRef)
+	if err != nil {
+		return err
+	}
+	byteArray, err := s.getChartTarball(ctx, repo, chartModel.Name, chartVersion)
+	if err != nil {
+		return err
+	}
+	chartDetail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(byteArray), chartModel.ID)
+	if err != nil {
+		return err
+	}
+	if chartDetail[models.SchemaKey] == "" {
+		return nil
+	}
+	defaults := map[string]interface{}{}
+	if err = yaml.Unmarshal([]byte(chartDetail[models.ValuesKey]), &defaults); err != nil {
+		return status.Errorf(codes.Internal, "unable to parse the default values of chart [%s]: %v", chartModel.ID, err)
+	}
+	return valuesschema.Validate(valuesString, defaults, []byte(chartDetail[models.SchemaKey]))
+}
+
+// validateUpdatedValues validates the values against the values schema of
+// the chart of the HelmRelease being updated.
+func (s *Server) validateUpdatedValues(ctx context.Context, rel *helmv2.HelmRelease, versionRef *corev1.VersionReference, valuesString string) error {
+	if s.chartCache == nil {
+		return nil
+	}
+	packageRef, err := installedPackageAvailablePackageRef(rel)
+	if err != nil {
+		return err
+	}
+	repoName, chartName, err := pkgutils.SplitChartIdentifier(packageRef.Identifier)
+	if err != nil {
+		return err
+	}
+	repo := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: repoName}
+	chartModel, err := s.getChart(ctx, repo, chartName)
+	if err != nil {
+		return err
+	} else if chartModel == nil {
+		return statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "HelmChart", chartName, "chart [%s] not found", chartName)
+	}
+	return s.validateValues(ctx, repo, chartModel, versionRef, valuesString)
+}
+
 func passesFilter(chart models.Chart, filters *corev1.FilterOptions) bool {
 	if filters == nil {
 		return true
@@ -157,16 +253,6 @@ func passesFilter(chart models.Chart, filters *corev1.FilterOptions) bool {
 			}
 		}
 	}
-	if ok {
-		if appVersion := filters.GetAppVersion(); len(appVersion) > 0 {
-			ok = appVersion == chart.ChartVersions[0].AppVersion
-		}
-	}
-	if ok {
-		if pkgVersion := filters.GetPkgVersion(); len(pkgVersion) > 0 {
-			ok = pkgVersion == chart.ChartVersions[0].Version
-		}
-	}
 	if ok {
 		if query := filters.GetQuery(); len(query) > 0 {
 			if strings.Contains(chart.Name, query) {
@@ -197,40 +283,57 @@ func passesFilter(chart models.Chart, filters *corev1.FilterOptions) bool {
 	return ok
 }
 
-func filterAndPaginateCharts(filters *corev1.FilterOptions, pageSize int32, itemOffset int, charts map[string][]models.Chart) ([]*corev1.AvailablePackageSummary, error) {
-	// this loop is here for 3 reasons:
+// filterAndPaginateCharts returns the page of summaries of the charts passing
+// the filters, in the requested order, together with their facets when the
+// first page is requested.
+func filterAndPaginateCharts(filters *corev1.FilterOptions, pageSize int32, itemOffset int, charts map[string][]models.Chart) ([]*corev1.AvailablePackageSummary, *corev1.AvailablePackageFacets, error) {
+	// this loop is here for 2 reasons:
 	// 1) to convert from []interface{} which is what the generic cache implementation
 	// returns for cache hits to a typed array object.
 	// 2) perform any filtering of the results as needed, pending redis support for
 	// querying values stored in cache (see discussion in https://github.com/vmware-tanzu/kubeapps/issues/3032)
-	// 3) if pagination was requested, only return up to one page size of results
+	// All the matching charts are needed to sort them and to count the facets.
 	summaries := make([]*corev1.AvailablePackageSummary, 0)
-	i := 0
-	startAt := -1
-	if pageSize > 0 {
-		startAt = itemOffset
-	}
+	pkgVersion, appVersion := versionfilter.New(filters.GetPkgVersion()), versionfilter.New(filters.GetAppVersion())
 	for _, packages := range charts {
 		for _, chart := range packages {
+			// only the versions matching the version filters are returned
+			if pkgVersion != nil || appVersion != nil {
+				chart.ChartVersions = versionfilter.ChartVersions(chart.ChartVersions, pkgVersion, appVersion)
+				if len(chart.ChartVersions) == 0 {
+					continue
+				}
+			}
 			if passesFilter(chart, filters) {
-				i++
-				if startAt < i {
-					pkg, err := pkgutils.AvailablePackageSummaryFromChart(&chart, GetPluginDetail())
-					if err != nil {
-						return nil, status.Errorf(
-							codes.Internal,
-							"Unable to parse chart to an AvailablePackageSummary: %v",
-							err)
-					}
-					summaries = append(summaries, pkg)
-					if pageSize > 0 && len(summaries) == int(pageSize) {
-						return summaries, nil
-					}
+				pkg, err := pkgutils.AvailablePackageSummaryFromChart(&chart, GetPluginDetail())
+				if err != nil {
+					return nil, nil, status.Errorf(
+						codes.Internal,
+						"Unable to parse chart to an AvailablePackageSummary: %v",
+						err)
 				}
+				summaries = append(summaries, pkg)
 			}
 		}
 	}
-	return summaries, nil
+	pkgsummaries.Sort(summaries, filters.GetSortBy())
+
+	var facets *corev1.AvailablePackageFacets
+	if itemOffset == 0 {
+		facets = pkgsummaries.Facets(summaries, GetPluginDetail().GetName())
+	}
+
+	// if pagination was requested, only return up to one page size of results
+	if pageSize > 0 {
+		if itemOffset >= len(summaries) {
+			return []*corev1.AvailablePackageSummary{}, facets, nil
+		}
+		summaries = summaries[itemOffset:]
+		if len(summaries) > int(pageSize) {
+			summaries = summaries[:pageSize]
+		}
+	}
+	return summaries, facets, nil
 }
 
 func availablePackageDetailFromChartDetail(chartID string, chartDetail map[string]string) (*corev1.AvailablePackageDetail, error) {
@@ -0,0 +1,82 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1"
+
+// redisTracingHook is a redis hook which traces the commands run as part of a
+// traced request. Commands run from the background cache workers, which are
+// not part of any request, are not traced to avoid flooding the traces.
+//
+// NewRedisClientFromEnv creates it with the global tracer provider, which is
+// configured by the kubeapps-apis server and shared with the plugins.
+type redisTracingHook struct {
+	tracer trace.Tracer
+}
+
+var _ redis.Hook = (*redisTracingHook)(nil)
+
+func newRedisTracingHook(tracerProvider trace.TracerProvider) *redisTracingHook {
+	return &redisTracingHook{tracer: tracerProvider.Tracer(tracerName)}
+}
+
+func (h *redisTracingHook) start(ctx context.Context, name string, attrs ...attribute.KeyValue) context.Context {
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return ctx
+	}
+	ctx, _ = h.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(append(attrs, semconv.DBSystemRedis)...),
+	)
+	return ctx
+}
+
+func (h *redisTracingHook) end(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	// redis.Nil is returned for missing keys, which is not a failure.
+	if err != nil && !errors.Is(err, redis.Nil) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (h *redisTracingHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return h.start(ctx, "redis "+cmd.Name(), semconv.DBOperationKey.String(cmd.Name())), nil
+}
+
+func (h *redisTracingHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.end(ctx, cmd.Err())
+	return nil
+}
+
+func (h *redisTracingHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	return h.start(ctx, "redis pipeline", attribute.Int("db.redis.num_cmd", len(cmds))), nil
+}
+
+func (h *redisTracingHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if cmdErr := cmd.Err(); cmdErr != nil && !errors.Is(cmdErr, redis.Nil) {
+			err = cmdErr
+			break
+		}
+	}
+	h.end(ctx, err)
+	return nil
+}
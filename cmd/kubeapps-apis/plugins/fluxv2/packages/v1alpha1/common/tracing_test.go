@@ -0,0 +1,52 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRedisTracingHook(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	redisCli, mock := redismock.NewClientMock()
+	redisCli.AddHook(newRedisTracingHook(tracerProvider))
+	mock.ExpectGet("helmcharts:default:bitnami/apache:8.6.1").RedisNil()
+	mock.ExpectSet("helmcharts:default:bitnami/apache:8.6.1", "chart", 0).SetVal("OK")
+
+	// Commands run outside of a traced request are not traced.
+	redisCli.Get(context.Background(), "helmcharts:default:bitnami/apache:8.6.1")
+
+	ctx, requestSpan := tracerProvider.Tracer("test").Start(context.Background(), "request")
+	redisCli.Set(ctx, "helmcharts:default:bitnami/apache:8.6.1", "chart", 0)
+	requestSpan.End()
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	names := []string{}
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+		if span.Name() == "redis set" {
+			if got, want := span.Parent().SpanID(), requestSpan.SpanContext().SpanID(); got != want {
+				t.Errorf("got parent: %s, want: %s", got, want)
+			}
+			if got, want := span.Status().Code, codes.Unset; got != want {
+				t.Errorf("got: %v, want: %v", got, want)
+			}
+		}
+	}
+	if got, want := names, []string{"redis set", "request"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
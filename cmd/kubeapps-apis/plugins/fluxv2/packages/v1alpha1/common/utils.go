@@ -23,6 +23,7 @@ import (
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	httpclient "github.com/vmware-tanzu/kubeapps/pkg/http-client"
+	"go.opentelemetry.io/otel"
 	"golang.org/x/net/http/httpproxy"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -199,6 +200,8 @@ func NewRedisClientFromEnv(stopCh <-chan struct{}) (*redis.Client, error) {
 		log.Infof("Redis [CONFIG GET maxmemory]: %v", maxmemory[1])
 	}
 
+	redisCli.AddHook(newRedisTracingHook(otel.GetTracerProvider()))
+
 	return redisCli, nil
 }
 
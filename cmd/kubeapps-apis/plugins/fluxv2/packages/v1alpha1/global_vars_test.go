@@ -585,6 +585,7 @@ var (
 					DisplayName:         "podinfo",
 					ShortDescription:    "Podinfo Helm chart for Kubernetes",
 					Categories:          []string{""},
+					RepositoryName:      name,
 				},
 			},
 		}
@@ -640,7 +641,8 @@ var (
 				Context:    &corev1.Context{Namespace: "default", Cluster: KubeappsCluster},
 				Plugin:     fluxPlugin,
 			},
-			Categories: []string{""},
+			Categories:     []string{""},
+			RepositoryName: "bitnami-1",
 		},
 		{
 			Name:        "wordpress",
@@ -656,7 +658,8 @@ var (
 				Context:    &corev1.Context{Namespace: "default", Cluster: KubeappsCluster},
 				Plugin:     fluxPlugin,
 			},
-			Categories: []string{""},
+			Categories:     []string{""},
+			RepositoryName: "bitnami-1",
 		},
 	}
 
@@ -671,7 +674,8 @@ var (
 			Context:    &corev1.Context{Namespace: "ns1", Cluster: KubeappsCluster},
 			Plugin:     fluxPlugin,
 		},
-		Categories: []string{""},
+		Categories:     []string{""},
+		RepositoryName: "jetstack-1",
 	}
 
 	elasticsearch_summary = &corev1.AvailablePackageSummary{
@@ -688,7 +692,8 @@ var (
 			Context:    &corev1.Context{Namespace: "default", Cluster: KubeappsCluster},
 			Plugin:     fluxPlugin,
 		},
-		Categories: []string{"Analytics"},
+		Categories:     []string{"Analytics"},
+		RepositoryName: "index-with-categories-1",
 	}
 
 	ghost_summary = &corev1.AvailablePackageSummary{
@@ -705,7 +710,8 @@ var (
 			Context:    &corev1.Context{Namespace: "default", Cluster: KubeappsCluster},
 			Plugin:     fluxPlugin,
 		},
-		Categories: []string{"CMS"},
+		Categories:     []string{"CMS"},
+		RepositoryName: "index-with-categories-1",
 	}
 
 	index_with_categories_summaries = []*corev1.AvailablePackageSummary{
@@ -727,7 +733,8 @@ var (
 				Context:    &corev1.Context{Namespace: "ns2", Cluster: KubeappsCluster},
 				Plugin:     fluxPlugin,
 			},
-			Categories: []string{""},
+			Categories:     []string{""},
+			RepositoryName: "testrepo",
 		},
 		{
 			Name:        "nginx",
@@ -742,7 +749,8 @@ var (
 				Context:    &corev1.Context{Namespace: "ns2", Cluster: KubeappsCluster},
 				Plugin:     fluxPlugin,
 			},
-			Categories: []string{""},
+			Categories:     []string{""},
+			RepositoryName: "testrepo",
 		},
 	}
 
@@ -760,7 +768,8 @@ var (
 				Context:    &corev1.Context{Namespace: "ns2", Cluster: KubeappsCluster},
 				Plugin:     fluxPlugin,
 			},
-			Categories: []string{""},
+			Categories:     []string{""},
+			RepositoryName: "testrepo",
 		},
 		{
 			Name:        "nginx",
@@ -775,7 +784,8 @@ var (
 				Context:    &corev1.Context{Namespace: "ns2", Cluster: KubeappsCluster},
 				Plugin:     fluxPlugin,
 			},
-			Categories: []string{""},
+			Categories:     []string{""},
+			RepositoryName: "testrepo",
 		}}
 
 	add_repo_1 = sourcev1.HelmRepository{
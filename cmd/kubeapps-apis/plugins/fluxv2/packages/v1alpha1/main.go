@@ -14,6 +14,8 @@ import (
 	log "k8s.io/klog/v2"
 )
 
+var _ pluginsv1alpha1.PluginCapabilitiesProvider = (*Server)(nil)
+
 // RegisterWithGRPCServer enables a plugin to register with a gRPC server
 // returning the server implementation.
 func RegisterWithGRPCServer(opts pluginsv1alpha1.GRPCPluginRegistrationOptions) (interface{}, error) {
@@ -4,9 +4,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,7 +17,10 @@ import (
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/manifestdiff"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/permissions"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/releasehistory"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	httpclient "github.com/vmware-tanzu/kubeapps/pkg/http-client"
@@ -23,6 +28,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -30,7 +36,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 )
 
@@ -79,36 +87,165 @@ func (s *Server) getReleaseInCluster(ctx context.Context, key types.NamespacedNa
 	return &rel, nil
 }
 
-func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, namespace string, pageSize int32, itemOffset int) ([]*corev1.InstalledPackageSummary, error) {
+func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, namespace string, pageSize int32, itemOffset int, filter *corev1.InstalledPackageFilterOptions) ([]*corev1.InstalledPackageSummary, error) {
+	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
+	if !pkgutils.PluginMatchesInstalledPackageFilter(GetPluginDetail(), filter) {
+		return installedPkgSummaries, nil
+	}
+
 	releasesFromCluster, err := s.listReleasesInCluster(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
+	// The releases are listed by namespace, but are sorted by name, as the
+	// core server merges the summaries of the plugins by name.
+	sort.SliceStable(releasesFromCluster, func(i, j int) bool {
+		if releasesFromCluster[i].Name != releasesFromCluster[j].Name {
+			return releasesFromCluster[i].Name < releasesFromCluster[j].Name
+		}
+		return releasesFromCluster[i].Namespace < releasesFromCluster[j].Namespace
+	})
+
+	if pkgutils.IsInstalledPackageFilterEmpty(filter) {
+		if len(releasesFromCluster) > 0 {
+			startAt := -1
+			if pageSize > 0 {
+				startAt = itemOffset
+			}
 
-	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
-	if len(releasesFromCluster) > 0 {
-		startAt := -1
-		if pageSize > 0 {
-			startAt = itemOffset
+			for i, r := range releasesFromCluster {
+				if startAt <= i {
+					summary, err := s.installedPkgSummaryFromRelease(ctx, r)
+					if err != nil {
+						return nil, err
+					} else if summary == nil {
+						// not ready yet
+						continue
+					}
+					installedPkgSummaries = append(installedPkgSummaries, summary)
+					if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
+						break
+					}
+				}
+			}
+		}
+		return installedPkgSummaries, nil
+	}
+
+	// When filtering, the item offset is the number of matching releases to
+	// skip, rather than the number of releases.
+	numMatching := 0
+	for _, r := range releasesFromCluster {
+		repoName := r.Spec.Chart.Spec.SourceRef.Name
+		if !pkgutils.RepositoryMatchesInstalledPackageFilter(repoName, filter) {
+			// no need to build the summary of a release from another repository
+			continue
+		}
+		summary, err := s.installedPkgSummaryFromRelease(ctx, r)
+		if err != nil {
+			return nil, err
+		} else if summary == nil {
+			// not ready yet
+			continue
+		}
+		if !pkgutils.InstalledPackageSummaryMatchesFilter(summary, GetPluginDetail(), repoName, filter) {
+			continue
+		}
+		numMatching++
+		if pageSize > 0 && numMatching <= itemOffset {
+			continue
+		}
+		installedPkgSummaries = append(installedPkgSummaries, summary)
+		if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
+			break
 		}
+	}
+	return installedPkgSummaries, nil
+}
+
+// watchInstalledPkgSummaries watches the HelmReleases in the namespace (or
+// across namespaces if namespace is "") and sends an event with the installed
+// package summary for each change until the context is done.
+func (s *Server) watchInstalledPkgSummaries(ctx context.Context, namespace string, send func(*corev1.WatchInstalledPackageSummariesResponse) error) error {
+	client, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+	if err != nil {
+		return err
+	}
 
-		for i, r := range releasesFromCluster {
-			if startAt <= i {
-				summary, err := s.installedPkgSummaryFromRelease(ctx, r)
+	var relList helmv2.HelmReleaseList
+	watcher, err := client.Watch(ctx, &relList, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return statuserror.FromK8sError("watch", "HelmRelease", namespace+"/*", err)
+	}
+	defer watcher.Stop()
+
+	// releases that are not ready yet are not sent, the same as for
+	// GetInstalledPackageSummaries, so we keep track of those that were sent
+	// to send the right event type.
+	sentReleases := map[types.NamespacedName]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return statuserror.FromK8sError("watch", "HelmRelease", namespace+"/*", errors.FromObject(event.Object))
+			}
+			rel, ok := event.Object.(*helmv2.HelmRelease)
+			if !ok {
+				continue
+			}
+			name, err := common.NamespacedName(rel)
+			if err != nil {
+				return err
+			}
+
+			var response *corev1.WatchInstalledPackageSummariesResponse
+			if event.Type == watch.Deleted {
+				if !sentReleases[*name] {
+					continue
+				}
+				delete(sentReleases, *name)
+				response = &corev1.WatchInstalledPackageSummariesResponse{
+					EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+					InstalledPackageSummary: &corev1.InstalledPackageSummary{
+						InstalledPackageRef: &corev1.InstalledPackageReference{
+							Context: &corev1.Context{
+								Namespace: name.Namespace,
+								Cluster:   s.kubeappsCluster,
+							},
+							Identifier: name.Name,
+							Plugin:     GetPluginDetail(),
+						},
+						Name: name.Name,
+					},
+				}
+			} else {
+				summary, err := s.installedPkgSummaryFromRelease(ctx, *rel)
 				if err != nil {
-					return nil, err
+					return err
 				} else if summary == nil {
 					// not ready yet
 					continue
 				}
-				installedPkgSummaries = append(installedPkgSummaries, summary)
-				if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
-					break
+				eventType := corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED
+				if sentReleases[*name] {
+					eventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED
+				}
+				sentReleases[*name] = true
+				response = &corev1.WatchInstalledPackageSummariesResponse{
+					EventType:               eventType,
+					InstalledPackageSummary: summary,
 				}
 			}
+			if err = send(response); err != nil {
+				return err
+			}
 		}
 	}
-	return installedPkgSummaries, nil
 }
 
 func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.HelmRelease) (*corev1.InstalledPackageSummary, error) {
@@ -313,7 +450,7 @@ func (s *Server) getReleaseViaHelmApi(ctx context.Context, key types.NamespacedN
 	release, err := cmd.Run(helmRel.Name)
 	if err != nil {
 		if err == driver.ErrReleaseNotFound {
-			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release [%s] in namespace [%s]", helmRel, key.Namespace)
+			return nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonResourceNotFound, "HelmRelease", helmRel.String(), "Unable to find Helm release [%s] in namespace [%s]", helmRel, key.Namespace)
 		}
 		return nil, status.Errorf(codes.NotFound, "Unable to run Helm Get action for release [%s] in namespace [%s]: %v", helmRel, key.Namespace, err)
 	}
@@ -330,6 +467,10 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 	chart, err := s.getChart(ctx, repo, chartName)
 	if err != nil {
 		return nil, err
+	} else if chart != nil {
+		if err = s.validateValues(ctx, repo, chart, versionRef, valuesString); err != nil {
+			return nil, err
+		}
 	}
 
 	var values map[string]interface{}
@@ -407,7 +548,11 @@ func (s *Server) updateRelease(ctx context.Context, packageRef *corev1.Installed
 	// non-pending releases  (i.e. success or failed status) are allowed
 	_, reason, _ := isHelmReleaseReady(*rel)
 	if reason == corev1.InstalledPackageStatus_STATUS_REASON_PENDING {
-		return nil, status.Errorf(codes.Internal, "updates to helm releases pending reconciliation are not supported")
+		return nil, statuserror.ResourceErrorf(codes.FailedPrecondition, statuserror.ReasonPendingReconciliation, "HelmRelease", key.String(), "updates to helm releases pending reconciliation are not supported")
+	}
+
+	if err = s.validateUpdatedValues(ctx, rel, versionRef, valuesString); err != nil {
+		return nil, err
 	}
 
 	versionExpr := versionRef.GetVersion()
@@ -487,6 +632,130 @@ func (s *Server) updateRelease(ctx context.Context, packageRef *corev1.Installed
 	}, nil
 }
 
+// releaseRevisions returns the revisions of the helm release managed by the
+// flux HelmRelease, most recent first.
+func (s *Server) releaseRevisions(ctx context.Context, packageRef *corev1.InstalledPackageReference) ([]*corev1.InstalledPackageRevision, error) {
+	key := types.NamespacedName{Name: packageRef.Identifier, Namespace: packageRef.Context.Namespace}
+
+	rel, err := s.getReleaseInCluster(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return releasehistory.GetInstalledPackageRevisions(ctx, helmReleaseName(key, rel), s.actionConfigGetter)
+}
+
+// previewRelease renders the chart which would be installed by creating or
+// updating the HelmRelease with the given version and values, and returns the
+// resulting changes when compared with the manifest of the existing helm
+// release, if any.
+func (s *Server) previewRelease(ctx context.Context, installedRef *corev1.InstalledPackageReference, packageRef *corev1.AvailablePackageReference, versionRef *corev1.VersionReference, valuesString string) ([]*corev1.ResourceChange, error) {
+	key := types.NamespacedName{Name: installedRef.Identifier, Namespace: installedRef.GetContext().GetNamespace()}
+	helmRelName := key
+
+	currentManifest := ""
+	rel, err := s.getReleaseInCluster(ctx, key)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if packageRef == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided to preview the creation of [%s]", key)
+		}
+	} else {
+		helmRelName = helmReleaseName(key, rel)
+		if packageRef == nil {
+			if packageRef, err = installedPackageAvailablePackageRef(rel); err != nil {
+				return nil, err
+			}
+		}
+		// the helm release may not exist yet if flux has not yet reconciled
+		// the HelmRelease, in which case everything is to be created
+		if helmRel, err := s.getReleaseViaHelmApi(ctx, key, rel); err == nil {
+			currentManifest = helmRel.Manifest
+		} else if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+	}
+
+	repoName, chartName, err := pkgutils.SplitChartIdentifier(packageRef.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	repo := types.NamespacedName{Namespace: packageRef.GetContext().GetNamespace(), Name: repoName}
+	chartModel, err := s.getChart(ctx, repo, chartName)
+	if err != nil {
+		return nil, err
+	} else if chartModel == nil {
+		return nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "HelmChart", chartName, "chart [%s] not found", chartName)
+	}
+
+	// flux installs the latest version matching the version constraint,
+	// so we do the same here.
+	chartVersion, err := s.chartVersionForConstraint(chartModel, versionRef)
+	if err != nil {
+		return nil, err
+	}
+
+	byteArray, err := s.getChartTarball(ctx, repo, chartName, chartVersion)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := loader.LoadArchive(bytes.NewReader(byteArray))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to load chart [%s]: %v", chartName, err)
+	}
+
+	values := map[string]interface{}{}
+	if valuesString != "" {
+		// could be JSON or YAML
+		if err = yaml.Unmarshal([]byte(valuesString), &values); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to parse values: %v", err)
+		}
+	}
+
+	// render the chart client-side only, as flux itself would, without
+	// touching the cluster
+	cmd := action.NewInstall(&action.Configuration{})
+	cmd.ReleaseName = helmRelName.Name
+	cmd.Namespace = helmRelName.Namespace
+	cmd.DryRun = true
+	cmd.ClientOnly = true
+	cmd.Replace = true
+	targetRelease, err := cmd.Run(ch, values)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to render chart [%s] version [%s]: %v", chartName, chartVersion, err)
+	}
+
+	return manifestdiff.DiffManifests(currentManifest, targetRelease.Manifest, helmRelName.Namespace)
+}
+
+// releaseForbiddenActions returns the actions which the user is not allowed
+// to perform to create or update the HelmRelease. The resources of the chart
+// itself are applied by flux using its own service account, so only the
+// permissions on the HelmRelease are checked.
+func (s *Server) releaseForbiddenActions(ctx context.Context, installedRef *corev1.InstalledPackageReference, packageRef *corev1.AvailablePackageReference) ([]*corev1.ForbiddenAction, error) {
+	key := types.NamespacedName{Name: installedRef.Identifier, Namespace: installedRef.GetContext().GetNamespace()}
+
+	verb := permissions.ActionUpdate
+	if _, err := s.getReleaseInCluster(ctx, key); err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if packageRef == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided to check the creation of [%s]", key)
+		}
+		verb = permissions.ActionCreate
+	}
+
+	typedClient, err := s.clientGetter.Typed(ctx, s.kubeappsCluster)
+	if err != nil {
+		return nil, err
+	}
+	manifest := permissions.ResourceManifest(helmv2.GroupVersion.String(), helmv2.HelmReleaseKind, key.Name, key.Namespace)
+	return permissions.ForbiddenActions(typedClient, key.Namespace, verb, manifest)
+}
+
 func (s *Server) deleteRelease(ctx context.Context, packageRef *corev1.InstalledPackageReference) error {
 	client, err := s.getClient(ctx, packageRef.Context.Namespace)
 	if err != nil {
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -19,15 +20,26 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs/resourcerefstest"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"helm.sh/helm/v3/pkg/release"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type testSpecGetInstalledPackages struct {
@@ -240,6 +252,67 @@ func TestGetInstalledPackageSummariesWithoutPagination(t *testing.T) {
 	}
 }
 
+// The core server merges the installed packages of the plugins by name, so
+// the releases are returned sorted by name rather than by namespace.
+func TestGetInstalledPackageSummariesSortedByName(t *testing.T) {
+	redisInDefault := redis_existing_spec_completed
+	redisInDefault.releaseNamespace = "default"
+	redisInDefaultSummary := proto.Clone(redis_summary_installed).(*corev1.InstalledPackageSummary)
+	redisInDefaultSummary.InstalledPackageRef.Context.Namespace = "default"
+	existingObjs := []testSpecGetInstalledPackages{
+		redisInDefault,
+		airflow_existing_spec_completed,
+	}
+
+	charts, releases, cleanup := newChartsAndReleases(t, existingObjs)
+	s, mock, err := newServerWithChartsAndReleases(t, nil, charts, releases)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer cleanup()
+
+	for _, existing := range existingObjs {
+		ts2, repo, err := newRepoWithIndex(
+			existing.repoIndex, existing.repoName, existing.repoNamespace, nil, "")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer ts2.Close()
+
+		redisKey, bytes, err := s.redisKeyValueForRepo(*repo)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		mock.ExpectGet(redisKey).SetVal(string(bytes))
+	}
+
+	response, err := s.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: ""},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	expectedResponse := &corev1.GetInstalledPackageSummariesResponse{
+		InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+			airflow_summary_installed,
+			redisInDefaultSummary,
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		corev1.GetInstalledPackageSummariesResponse{},
+		corev1.InstalledPackageSummary{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.VersionReference{},
+		corev1.InstalledPackageStatus{},
+		corev1.PackageAppVersion{},
+		plugins.Plugin{})
+	if got, want := response, expectedResponse; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+}
+
 func TestGetInstalledPackageSummariesWithPagination(t *testing.T) {
 	// one big test case that can't really be broken down to smaller cases because
 	// the tests aren't independent/idempotent: there is state that needs to be
@@ -378,6 +451,266 @@ type helmReleaseStub struct {
 	notes        string
 	manifest     string
 	status       release.Status
+	version      int
+}
+
+// fakeWatcherCtrlClient is a controller-runtime client that returns the given
+// watcher for any watch request, since the fake client does not send events
+// for existing objects.
+type fakeWatcherCtrlClient struct {
+	ctrlclient.WithWatch
+	watcher watch.Interface
+}
+
+func (c *fakeWatcherCtrlClient) Watch(ctx context.Context, obj ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+	return c.watcher, nil
+}
+
+func TestGetInstalledPackageSummariesWithFilters(t *testing.T) {
+	testCases := []struct {
+		name         string
+		request      *corev1.GetInstalledPackageSummariesRequest
+		existingObjs []testSpecGetInstalledPackages
+		// the objects for which a summary is expected to be built, as opposed
+		// to those excluded before looking up the chart in the cache
+		summarizedObjs   []testSpecGetInstalledPackages
+		expectedResponse *corev1.GetInstalledPackageSummariesResponse
+	}{
+		{
+			name: "returns the installed packages matching a query",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context:       &corev1.Context{Namespace: ""},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{Query: "AIRFLOW"},
+			},
+			existingObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_completed,
+				airflow_existing_spec_completed,
+			},
+			summarizedObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_completed,
+				airflow_existing_spec_completed,
+			},
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					airflow_summary_installed,
+				},
+			},
+		},
+		{
+			name: "returns the installed packages from a repository",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: ""},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Repositories: []string{airflow_existing_spec_completed.repoName},
+				},
+			},
+			existingObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_completed,
+				airflow_existing_spec_completed,
+			},
+			summarizedObjs: []testSpecGetInstalledPackages{
+				airflow_existing_spec_completed,
+			},
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					airflow_summary_installed,
+				},
+			},
+		},
+		{
+			name: "returns the installed packages with a status reason",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "test"},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+						corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+					},
+				},
+			},
+			existingObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_failed,
+			},
+			summarizedObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_failed,
+			},
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
+			},
+		},
+		{
+			name: "returns no installed packages when the plugin is filtered out",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: ""},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Plugins: []string{"helm.packages"},
+				},
+			},
+			existingObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_completed,
+			},
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			charts, releases, cleanup := newChartsAndReleases(t, tc.existingObjs)
+			s, mock, err := newServerWithChartsAndReleases(t, nil, charts, releases)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			defer cleanup()
+
+			for _, existing := range tc.summarizedObjs {
+				ts2, repo, err := newRepoWithIndex(
+					existing.repoIndex, existing.repoName, existing.repoNamespace, nil, "")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				defer ts2.Close()
+
+				redisKey, bytes, err := s.redisKeyValueForRepo(*repo)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+
+				mock.ExpectGet(redisKey).SetVal(string(bytes))
+			}
+
+			response, err := s.GetInstalledPackageSummaries(context.Background(), tc.request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			opts := cmpopts.IgnoreUnexported(
+				corev1.GetInstalledPackageSummariesResponse{},
+				corev1.InstalledPackageSummary{},
+				corev1.InstalledPackageReference{},
+				corev1.Context{},
+				corev1.VersionReference{},
+				corev1.InstalledPackageStatus{},
+				corev1.PackageAppVersion{},
+				plugins.Plugin{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	existingObjs := []testSpecGetInstalledPackages{redis_existing_spec_completed}
+	charts, releases, cleanup := newChartsAndReleases(t, existingObjs)
+	defer cleanup()
+	s, mock, err := newServerWithChartsAndReleases(t, nil, charts, releases)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	for _, existing := range existingObjs {
+		ts2, repo, err := newRepoWithIndex(
+			existing.repoIndex, existing.repoName, existing.repoNamespace, nil, "")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer ts2.Close()
+
+		redisKey, bytes, err := s.redisKeyValueForRepo(*repo)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		// once for the addition and once for the modification
+		mock.ExpectGet(redisKey).SetVal(string(bytes))
+		mock.ExpectGet(redisKey).SetVal(string(bytes))
+	}
+
+	fakeWatcher := watch.NewFakeWithChanSize(3, false)
+	fakeWatcher.Add(&releases[0])
+	fakeWatcher.Modify(&releases[0])
+	fakeWatcher.Delete(&releases[0])
+
+	clientGetter := s.clientGetter
+	s.clientGetter = func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+		clients, err := clientGetter(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		typedClient, err := clients.Typed()
+		if err != nil {
+			return nil, err
+		}
+		apiExt, err := clients.ApiExt()
+		if err != nil {
+			return nil, err
+		}
+		ctrlClient, err := clients.ControllerRuntime()
+		if err != nil {
+			return nil, err
+		}
+		return clientgetter.NewBuilder().
+			WithTyped(typedClient).
+			WithApiExt(apiExt).
+			WithControllerRuntime(&fakeWatcherCtrlClient{WithWatch: ctrlClient, watcher: fakeWatcher}).
+			Build(), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &plugin_test.TestWatchInstalledPackageSummariesStream{
+		Ctx: ctx,
+		OnSend: func(r *corev1.WatchInstalledPackageSummariesResponse) {
+			if r.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				cancel()
+			}
+		},
+	}
+
+	err = s.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "test"},
+	}, stream)
+	if got, want := status.Code(err), codes.OK; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	expectedResponses := []*corev1.WatchInstalledPackageSummariesResponse{
+		{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: redis_summary_installed,
+		},
+		{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED,
+			InstalledPackageSummary: redis_summary_installed,
+		},
+		{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: redis_summary_installed.InstalledPackageRef,
+				Name:                redis_summary_installed.Name,
+			},
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		corev1.WatchInstalledPackageSummariesResponse{},
+		corev1.InstalledPackageSummary{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.VersionReference{},
+		corev1.InstalledPackageStatus{},
+		corev1.PackageAppVersion{},
+		plugins.Plugin{})
+	if got, want := stream.Responses, expectedResponses; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
 }
 
 func TestGetInstalledPackageDetail(t *testing.T) {
@@ -701,6 +1034,77 @@ func TestCreateInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestCreateInstalledPackageValidatesValues(t *testing.T) {
+	repoName := "bitnami-1"
+	repoNamespace := "default"
+	replaceUrls := make(map[string]string)
+	charts := []testSpecChartWithUrl{}
+	requestChartUrl := ""
+	for _, spec := range redis_charts_spec {
+		tarGzBytes, err := ioutil.ReadFile(spec.tgzFile)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(200)
+			w.Write(tarGzBytes)
+		}))
+		defer ts.Close()
+		replaceUrls[fmt.Sprintf("{{%s}}", spec.tgzFile)] = ts.URL
+		charts = append(charts, testSpecChartWithUrl{
+			chartID:       fmt.Sprintf("%s/%s", repoName, spec.name),
+			chartRevision: spec.revision,
+			chartUrl:      ts.URL,
+			repoNamespace: repoNamespace,
+		})
+		if spec.revision == "14.4.0" {
+			requestChartUrl = ts.URL
+		}
+	}
+
+	ts2, repo, err := newRepoWithIndex(
+		testYaml("redis-two-versions.yaml"), repoName, repoNamespace, replaceUrls, "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer ts2.Close()
+
+	s, mock, err := newServerWithRepos(t, []sourcev1.HelmRepository{*repo}, charts, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	s.redisMockExpectGetFromRepoCache(mock, nil, *repo)
+	chartCacheKey, err := s.chartCache.KeyFor(repoNamespace, "bitnami-1/redis", "14.4.0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = redisMockExpectGetFromChartCache(mock, chartCacheKey, requestChartUrl, &common.ClientOptions{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	_, err = s.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
+		TargetContext:       &corev1.Context{Namespace: "test"},
+		Name:                "my-redis",
+		PkgVersionReference: &corev1.VersionReference{
+			Version: "14.4.0",
+		},
+		Values: "auth:\n  enabled: \"yes\"\n",
+	})
+
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+	if got, want := status.Convert(err).Message(), "values.auth.enabled: Invalid type. Expected: boolean, given: string"; !strings.Contains(got, want) {
+		t.Errorf("got: %q, want it to contain: %q", got, want)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestUpdateInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name                    string
@@ -834,7 +1238,7 @@ func TestUpdateInstalledPackage(t *testing.T) {
 				},
 			},
 			existingK8sObjs:    &redis_existing_spec_pending,
-			expectedStatusCode: codes.Internal,
+			expectedStatusCode: codes.FailedPrecondition,
 		},
 
 		// test case update installed package that has failed reconciliation will be done
@@ -913,6 +1317,197 @@ func TestUpdateInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageUpdatePreview(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.GetInstalledPackageUpdatePreviewRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "previews the creation of a package",
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: installedRef("my-redis", "test"),
+				AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "14.4.0",
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns invalid argument when previewing a creation without an available package ref",
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: installedRef("my-redis", "test"),
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repoName := "bitnami-1"
+			repoNamespace := "default"
+			replaceUrls := make(map[string]string)
+			charts := []testSpecChartWithUrl{}
+			requestChartUrl := ""
+			for _, spec := range redis_charts_spec {
+				tarGzBytes, err := ioutil.ReadFile(spec.tgzFile)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(200)
+					w.Write(tarGzBytes)
+				}))
+				defer ts.Close()
+				replaceUrls[fmt.Sprintf("{{%s}}", spec.tgzFile)] = ts.URL
+				charts = append(charts, testSpecChartWithUrl{
+					chartID:       fmt.Sprintf("%s/%s", repoName, spec.name),
+					chartRevision: spec.revision,
+					chartUrl:      ts.URL,
+					repoNamespace: repoNamespace,
+				})
+				if spec.revision == tc.request.GetPkgVersionReference().GetVersion() {
+					requestChartUrl = ts.URL
+				}
+			}
+
+			ts2, repo, err := newRepoWithIndex(
+				testYaml("redis-two-versions.yaml"), repoName, repoNamespace, replaceUrls, "")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			defer ts2.Close()
+
+			s, mock, err := newServerWithRepos(t, []sourcev1.HelmRepository{*repo}, charts, nil)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if tc.expectedStatusCode == codes.OK {
+				s.redisMockExpectGetFromRepoCache(mock, nil, *repo)
+				chartCacheKey, err := s.chartCache.KeyFor(
+					repoNamespace, tc.request.AvailablePackageRef.Identifier, tc.request.PkgVersionReference.Version)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if err = redisMockExpectGetFromChartCache(mock, chartCacheKey, requestChartUrl, &common.ClientOptions{}); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+
+			response, err := s.GetInstalledPackageUpdatePreview(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			// As nothing is yet installed, every rendered resource is to be created.
+			if len(response.ResourceChanges) == 0 {
+				t.Fatalf("expected resource changes, got none")
+			}
+			for _, change := range response.ResourceChanges {
+				if got, want := change.ChangeType, corev1.ResourceChange_CHANGE_TYPE_CREATED; got != want {
+					t.Errorf("got: %v, want: %v for %v", got, want, change.ResourceRef)
+				}
+				if got, want := change.ResourceRef.Namespace, "test"; got != want {
+					t.Errorf("got: %q, want: %q for %v", got, want, change.ResourceRef)
+				}
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestCheckInstalledPackagePermissions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.CheckInstalledPackagePermissionsRequest
+		existingK8sObjs    []testSpecGetInstalledPackages
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.CheckInstalledPackagePermissionsResponse
+	}{
+		{
+			name: "returns the actions forbidden to create the HelmRelease",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: installedRef("my-redis", "test"),
+				AvailablePackageRef: availableRef("bitnami-1/redis", "default"),
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: []*corev1.ForbiddenAction{
+					{ApiVersion: "helm.toolkit.fluxcd.io/v2beta1", Resource: "helmreleases", Namespace: "test", Verbs: []string{"create"}},
+				},
+			},
+		},
+		{
+			name: "returns no actions when the user can update the existing HelmRelease",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: my_redis_ref,
+			},
+			existingK8sObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_completed,
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: []*corev1.ForbiddenAction{},
+			},
+		},
+		{
+			name: "returns invalid argument when checking a creation without an available package ref",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: installedRef("my-redis", "test"),
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			charts, repos, cleanup := newChartsAndReleases(t, tc.existingK8sObjs)
+			defer cleanup()
+			s, _, err := newServerWithChartsAndReleases(t, nil, charts, repos)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			// The user can update but not create HelmReleases.
+			typedClient, err := s.clientGetter.Typed(context.Background(), s.kubeappsCluster)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			clientSet := typedClient.(*typfake.Clientset)
+			clientSet.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+				{GroupVersion: "helm.toolkit.fluxcd.io/v2beta1", APIResources: []metav1.APIResource{{Name: "helmreleases", Kind: "HelmRelease", Namespaced: true}}},
+			}
+			clientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb != "create"
+				return true, review, nil
+			})
+
+			response, err := s.CheckInstalledPackagePermissions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.CheckInstalledPackagePermissionsResponse{}, corev1.ForbiddenAction{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
 func TestDeleteInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -1029,6 +1624,7 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 					},
 					Identifier: flux_obj_name,
 				},
+				IncludeManifest: true,
 			},
 		}
 		if response {
@@ -1038,6 +1634,7 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 					Namespace: flux_obj_namespace,
 				},
 				ResourceRefs: resourcerefstest.TestCases2[tc].ExpectedResourceRefs,
+				Manifest:     resourcerefstest.TestCases2[tc].ExistingReleases[0].Manifest,
 			}
 		}
 		newCase.expectedStatusCode = code
@@ -1065,6 +1662,13 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 		newTestCase(11, true, codes.OK, true),
 	}
 
+	// The manifest is only returned when requested.
+	withoutManifest := newTestCase(0, true, codes.OK, false)
+	withoutManifest.baseTestCase.Name += " without the manifest"
+	withoutManifest.request.IncludeManifest = false
+	withoutManifest.expectedResponse.Manifest = ""
+	testCases = append(testCases, withoutManifest)
+
 	ignoredFields := cmpopts.IgnoreUnexported(
 		corev1.GetInstalledPackageResourceRefsResponse{},
 		corev1.ResourceRef{},
@@ -1259,3 +1863,104 @@ func newRelease(name string, namespace string, spec *helmv2.HelmReleaseSpec, sta
 	}
 	return helmRelease
 }
+
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.GetInstalledPackageRevisionsRequest
+		existingHelmStubs  []helmReleaseStub
+		expectedStatusCode codes.Code
+		expectedRevisions  []int32
+	}{
+		{
+			name: "returns the revisions of the helm release, most recent first",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: my_redis_ref,
+			},
+			existingHelmStubs: []helmReleaseStub{
+				{
+					name:         "my-redis",
+					namespace:    "test",
+					chartVersion: "14.3.4",
+					status:       release.StatusSuperseded,
+					version:      1,
+				},
+				{
+					name:         "my-redis",
+					namespace:    "test",
+					chartVersion: "14.4.0",
+					status:       release.StatusDeployed,
+					version:      2,
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedRevisions:  []int32{2, 1},
+		},
+		{
+			name: "returns not found if the helm release doesn't exist",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: my_redis_ref,
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: installedRef("not-a-valid-identifier", "default"),
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			charts, releases, cleanup := newChartsAndReleases(t, []testSpecGetInstalledPackages{redis_existing_spec_completed})
+			defer cleanup()
+			actionConfig := newHelmActionConfig(t, redis_existing_spec_completed.releaseNamespace, tc.existingHelmStubs)
+			s, mock, err := newServerWithChartsAndReleases(t, actionConfig, charts, releases)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			response, err := s.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			revisions := []int32{}
+			for _, r := range response.Revisions {
+				revisions = append(revisions, r.Revision)
+			}
+			if got, want := revisions, tc.expectedRevisions; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRollbackInstalledPackage(t *testing.T) {
+	charts, releases, cleanup := newChartsAndReleases(t, []testSpecGetInstalledPackages{redis_existing_spec_completed})
+	defer cleanup()
+	s, _, err := newServerWithChartsAndReleases(t, nil, charts, releases)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	_, err = s.RollbackInstalledPackage(context.Background(), &corev1.RollbackInstalledPackageRequest{
+		InstalledPackageRef: my_redis_ref,
+		Revision:            1,
+	})
+
+	if got, want := status.Code(err), codes.Unimplemented; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
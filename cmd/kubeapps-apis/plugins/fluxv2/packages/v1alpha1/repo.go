@@ -203,9 +203,9 @@ func (s *Server) clientOptionsForRepo(ctx context.Context, repoName types.Namesp
 func (s *Server) newRepo(ctx context.Context, targetName types.NamespacedName, url string, interval uint32,
 	tlsConfig *corev1.PackageRepositoryTlsConfig, auth *corev1.PackageRepositoryAuth) (*corev1.PackageRepositoryReference, error) {
 	if url == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "repository url may not be empty")
+		return nil, statuserror.FieldErrorf("url", "repository url may not be empty")
 	} else if tlsConfig != nil && tlsConfig.InsecureSkipVerify {
-		return nil, status.Errorf(codes.InvalidArgument, "TLS flag insecureSkipVerify is not supported")
+		return nil, statuserror.FieldErrorf("tls_config.insecure_skip_verify", "TLS flag insecureSkipVerify is not supported")
 	}
 
 	var secret *apiv1.Secret
@@ -365,7 +365,7 @@ func (s *Server) validateUserManagedRepoSecret(
 	var secretRefTls, secretRefAuth string
 	if tlsConfig != nil {
 		if tlsConfig.GetCertAuthority() != "" {
-			return nil, status.Errorf(codes.InvalidArgument, "Secret Ref must be used with user managed secrets")
+			return nil, statuserror.FieldErrorf("tls_config.secret_ref", "Secret Ref must be used with user managed secrets")
 		} else if tlsConfig.GetSecretRef().GetName() != "" {
 			secretRefTls = tlsConfig.GetSecretRef().GetName()
 		}
@@ -376,7 +376,7 @@ func (s *Server) validateUserManagedRepoSecret(
 			auth.GetHeader() != "" ||
 			auth.GetTlsCertKey() != nil ||
 			auth.GetUsernamePassword() != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "Secret Ref must be used with user managed secrets")
+			return nil, statuserror.FieldErrorf("auth.secret_ref", "Secret Ref must be used with user managed secrets")
 		} else if auth.GetSecretRef().GetName() != "" {
 			secretRefAuth = auth.GetSecretRef().GetName()
 		}
@@ -385,8 +385,7 @@ func (s *Server) validateUserManagedRepoSecret(
 	var secretRef string
 	if secretRefTls != "" && secretRefAuth != "" && secretRefTls != secretRefAuth {
 		// flux repo spec only allows one secret per HelmRepository CRD
-		return nil, status.Errorf(
-			codes.InvalidArgument, "TLS config secret and Auth secret must be the same")
+		return nil, statuserror.FieldErrorf("auth.secret_ref", "TLS config secret and Auth secret must be the same")
 	} else if secretRefTls != "" {
 		secretRef = secretRefTls
 	} else if secretRefAuth != "" {
@@ -421,7 +420,7 @@ func (s *Server) validateUserManagedRepoSecret(
 						return nil, status.Errorf(codes.Internal, "Specified secret [%s] missing fields 'keyFile' and/or 'certFile'", secretRef)
 					}
 				default:
-					return nil, status.Errorf(codes.Internal, "Package repository authentication type %q is not supported", auth.Type)
+					return nil, statuserror.Errorf(codes.Internal, statuserror.ReasonNotSupported, "Package repository authentication type %q is not supported", auth.Type)
 				}
 			}
 		}
@@ -555,11 +554,11 @@ func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageReposito
 	// Updates to non-pending repos (i.e. success or failed status) are allowed
 	complete, _, _ := isHelmRepositoryReady(*repo)
 	if !complete {
-		return nil, status.Errorf(codes.Internal, "updates to repositories pending reconciliation are not supported")
+		return nil, statuserror.ResourceErrorf(codes.FailedPrecondition, statuserror.ReasonPendingReconciliation, "HelmRepository", key.String(), "updates to repositories pending reconciliation are not supported")
 	}
 
 	if url == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "repository url may not be empty")
+		return nil, statuserror.FieldErrorf("url", "repository url may not be empty")
 	}
 	repo.Spec.URL = url
 
@@ -573,7 +572,7 @@ func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageReposito
 	}
 
 	if tlsConfig != nil && tlsConfig.InsecureSkipVerify {
-		return nil, status.Errorf(codes.InvalidArgument, "TLS flag insecureSkipVerify is not supported")
+		return nil, statuserror.FieldErrorf("tls_config.insecure_skip_verify", "TLS flag insecureSkipVerify is not supported")
 	}
 
 	var secret *apiv1.Secret
@@ -1029,7 +1028,7 @@ func newSecretFromTlsConfigAndAuth(repoName types.NamespacedName,
 	auth *corev1.PackageRepositoryAuth) (secret *apiv1.Secret, isSameSecret bool, err error) {
 	if tlsConfig != nil {
 		if tlsConfig.GetSecretRef() != nil {
-			return nil, false, status.Errorf(codes.InvalidArgument, "SecretRef may not be used with kubeapps managed secrets")
+			return nil, false, statuserror.FieldErrorf("tls_config.secret_ref", "SecretRef may not be used with kubeapps managed secrets")
 		}
 		caCert := tlsConfig.GetCertAuthority()
 		if caCert == redactedString {
@@ -1041,7 +1040,7 @@ func newSecretFromTlsConfigAndAuth(repoName types.NamespacedName,
 	}
 	if auth != nil {
 		if auth.GetSecretRef() != nil {
-			return nil, false, status.Errorf(codes.InvalidArgument, "SecretRef may not be used with kubeapps managed secrets")
+			return nil, false, statuserror.FieldErrorf("auth.secret_ref", "SecretRef may not be used with kubeapps managed secrets")
 		}
 		if secret == nil {
 			secret = common.NewLocalOpaqueSecret(repoName)
@@ -1056,7 +1055,7 @@ func newSecretFromTlsConfigAndAuth(repoName types.NamespacedName,
 					secret.Data["password"] = []byte(unp.Password)
 				}
 			} else {
-				return nil, false, status.Errorf(codes.Internal, "Username/Password configuration is missing")
+				return nil, false, statuserror.FieldErrorf("auth.username_password", "Username/Password configuration is missing")
 			}
 		case corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_TLS:
 			if ck := auth.GetTlsCertKey(); ck != nil {
@@ -1067,12 +1066,12 @@ func newSecretFromTlsConfigAndAuth(repoName types.NamespacedName,
 					secret.Data["keyFile"] = []byte(ck.Key)
 				}
 			} else {
-				return nil, false, status.Errorf(codes.Internal, "TLS Cert/Key configuration is missing")
+				return nil, false, statuserror.FieldErrorf("auth.tls_cert_key", "TLS Cert/Key configuration is missing")
 			}
 		case corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BEARER,
 			corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_CUSTOM,
 			corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_DOCKER_CONFIG_JSON:
-			return nil, false, status.Errorf(codes.Unimplemented, "Package repository authentication type %q is not supported", auth.Type)
+			return nil, false, statuserror.Errorf(codes.Unimplemented, statuserror.ReasonNotSupported, "Package repository authentication type %q is not supported", auth.Type)
 		case corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_UNSPECIFIED:
 			return nil, true, nil
 		default:
@@ -114,7 +114,7 @@ func TestKindClusterRepoWithBasicAuth(t *testing.T) {
 				corev1.Context{},
 				plugins.Plugin{},
 				corev1.PackageAppVersion{})
-			opt2 := cmpopts.SortSlices(lessAvailablePackageFunc)
+			opt2 := cmp.Options{cmpopts.SortSlices(lessAvailablePackageFunc), ignoreSummaryDatesAndFacets}
 			if got, want := resp, available_package_summaries_podinfo_basic_auth(repoName); !cmp.Equal(got, want, opt1, opt2) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 			}
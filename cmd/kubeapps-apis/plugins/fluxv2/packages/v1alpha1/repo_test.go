@@ -315,6 +315,66 @@ func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
 				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{},
 			},
 		},
+		{
+			name: "uses a filter based on an appVersion semver constraint",
+			repos: []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "index-with-categories-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     testYaml("index-with-categories.yaml"),
+				},
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "blah"},
+				FilterOptions: &corev1.FilterOptions{
+					AppVersion: ">=4 <5",
+				},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					ghost_summary,
+				},
+			},
+		},
+		{
+			name: "uses a filter based on a pkgVersion semver constraint, returning the latest matching version",
+			repos: []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "bitnami-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     testYaml("valid-index.yaml"),
+				},
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "blah"},
+				FilterOptions: &corev1.FilterOptions{
+					PkgVersion: "~0.7.0, <0.7.5",
+				},
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					{
+						Name:        "wordpress",
+						DisplayName: "wordpress",
+						LatestVersion: &corev1.PackageAppVersion{
+							PkgVersion: "0.7.4",
+							AppVersion: "4.9.0",
+						},
+						IconUrl:          "https://bitnami.com/assets/stacks/wordpress/img/wordpress-stack-220x234.png",
+						ShortDescription: "new description!",
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Identifier: "bitnami-1/wordpress",
+							Context:    &corev1.Context{Namespace: "default", Cluster: KubeappsCluster},
+							Plugin:     fluxPlugin,
+						},
+						Categories:     []string{""},
+						RepositoryName: "bitnami-1",
+					},
+				},
+			},
+		},
 		{
 			name: "uses a filter based on existing query text (chart name)",
 			repos: []testSpecGetAvailablePackageSummaries{
@@ -427,7 +487,7 @@ func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
 			}
 
 			opt1 := cmpopts.IgnoreUnexported(corev1.GetAvailablePackageSummariesResponse{}, corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.PackageAppVersion{})
-			opt2 := cmpopts.SortSlices(lessAvailablePackageFunc)
+			opt2 := cmp.Options{cmpopts.SortSlices(lessAvailablePackageFunc), ignoreSummaryDatesAndFacets}
 			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, opt1, opt2) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 			}
@@ -490,7 +550,7 @@ func TestGetAvailablePackageSummariesWithPagination(t *testing.T) {
 			corev1.Context{},
 			plugins.Plugin{},
 			corev1.PackageAppVersion{})
-		opts2 := cmpopts.SortSlices(lessAvailablePackageFunc)
+		opts2 := cmp.Options{cmpopts.SortSlices(lessAvailablePackageFunc), ignoreSummaryDatesAndFacets}
 
 		expectedResp1 := &corev1.GetAvailablePackageSummariesResponse{
 			AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
@@ -567,6 +627,116 @@ func TestGetAvailablePackageSummariesWithPagination(t *testing.T) {
 	})
 }
 
+func TestGetAvailablePackageSummariesSortAndFacets(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sortBy        corev1.FilterOptions_SortBy
+		pageToken     string
+		expectedNames []string
+		expectFacets  bool
+	}{
+		{
+			name:          "it returns the packages sorted by name with the facets",
+			sortBy:        corev1.FilterOptions_SORT_BY_UNSPECIFIED,
+			expectedNames: []string{"acs-engine-autoscaler", "elasticsearch", "ghost", "wordpress"},
+			expectFacets:  true,
+		},
+		{
+			name:          "it returns the most recently updated packages first",
+			sortBy:        corev1.FilterOptions_SORT_BY_LAST_UPDATED,
+			expectedNames: []string{"elasticsearch", "ghost", "wordpress", "acs-engine-autoscaler"},
+			expectFacets:  true,
+		},
+		{
+			name:          "it returns the packages sorted by repository without facets after the first page",
+			sortBy:        corev1.FilterOptions_SORT_BY_REPOSITORY,
+			pageToken:     "1",
+			expectedNames: []string{"wordpress", "elasticsearch", "ghost"},
+		},
+	}
+
+	expectedFacets := &corev1.AvailablePackageFacets{
+		Categories: []*corev1.FacetCount{
+			{Value: "Analytics", Count: 1},
+			{Value: "CMS", Count: 1},
+		},
+		Repositories: []*corev1.FacetCount{
+			{Value: "bitnami-1", Count: 2},
+			{Value: "index-with-categories-1", Count: 2},
+		},
+		Plugins: []*corev1.FacetCount{
+			{Value: "fluxv2.packages", Count: 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repos := []sourcev1.HelmRepository{}
+			for _, rs := range []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "bitnami-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     testYaml("valid-index.yaml"),
+				},
+				{
+					name:      "index-with-categories-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     testYaml("index-with-categories.yaml"),
+				},
+			} {
+				ts2, repo, err := newRepoWithIndex(rs.index, rs.name, rs.namespace, nil, "")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				defer ts2.Close()
+				repos = append(repos, *repo)
+			}
+
+			s, mock, err := newSimpleServerWithRepos(t, repos)
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+
+			request := &corev1.GetAvailablePackageSummariesRequest{
+				Context:           &corev1.Context{Namespace: "blah"},
+				FilterOptions:     &corev1.FilterOptions{SortBy: tc.sortBy},
+				PaginationOptions: &corev1.PaginationOptions{PageToken: tc.pageToken, PageSize: 10},
+			}
+			if err = s.redisMockExpectGetFromRepoCache(mock, request.FilterOptions, repos...); err != nil {
+				t.Fatalf("%v", err)
+			}
+
+			response, err := s.GetAvailablePackageSummaries(context.Background(), request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			names := []string{}
+			for _, summary := range response.AvailablePackageSummaries {
+				names = append(names, summary.Name)
+			}
+			if got, want := names, tc.expectedNames; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			var expected *corev1.AvailablePackageFacets
+			if tc.expectFacets {
+				expected = expectedFacets
+			}
+			opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageFacets{}, corev1.FacetCount{})
+			if got, want := response.Facets, expected; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("%v", err)
+			}
+		})
+	}
+}
+
 func TestGetAvailablePackageSummaryAfterRepoIndexUpdate(t *testing.T) {
 	t.Run("test get available package summaries after repo index is updated", func(t *testing.T) {
 		indexYamlBeforeUpdateBytes, err := ioutil.ReadFile(testYaml("index-before-update.yaml"))
@@ -643,7 +813,7 @@ func TestGetAvailablePackageSummaryAfterRepoIndexUpdate(t *testing.T) {
 			plugins.Plugin{},
 			corev1.Maintainer{},
 			corev1.PackageAppVersion{})
-		opt2 := cmpopts.SortSlices(lessAvailablePackageFunc)
+		opt2 := cmp.Options{cmpopts.SortSlices(lessAvailablePackageFunc), ignoreSummaryDatesAndFacets}
 		if got, want := responseBeforeUpdate.AvailablePackageSummaries, index_before_update_summaries; !cmp.Equal(got, want, opt1, opt2) {
 			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 		}
@@ -770,7 +940,7 @@ func TestGetAvailablePackageSummaryAfterFluxHelmRepoDelete(t *testing.T) {
 			plugins.Plugin{},
 			corev1.Maintainer{},
 			corev1.PackageAppVersion{})
-		opt2 := cmpopts.SortSlices(lessAvailablePackageFunc)
+		opt2 := cmp.Options{cmpopts.SortSlices(lessAvailablePackageFunc), ignoreSummaryDatesAndFacets}
 		if got, want := responseBeforeDelete.AvailablePackageSummaries, valid_index_package_summaries; !cmp.Equal(got, want, opt1, opt2) {
 			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 		}
@@ -870,7 +1040,7 @@ func TestGetAvailablePackageSummaryAfterCacheResync(t *testing.T) {
 			corev1.Context{}, plugins.Plugin{},
 			corev1.Maintainer{},
 			corev1.PackageAppVersion{})
-		opt2 := cmpopts.SortSlices(lessAvailablePackageFunc)
+		opt2 := cmp.Options{cmpopts.SortSlices(lessAvailablePackageFunc), ignoreSummaryDatesAndFacets}
 		if got, want := responseBeforeResync.AvailablePackageSummaries, valid_index_package_summaries; !cmp.Equal(got, want, opt1, opt2) {
 			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 		}
@@ -1903,7 +2073,7 @@ func TestUpdatePackageRepository(t *testing.T) {
 			repoName:           "repo-1",
 			repoNamespace:      "namespace-1",
 			request:            update_repo_req_1,
-			expectedStatusCode: codes.Internal,
+			expectedStatusCode: codes.FailedPrecondition,
 			pending:            true,
 		},
 		{
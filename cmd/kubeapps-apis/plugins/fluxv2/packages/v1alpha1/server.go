@@ -26,6 +26,7 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	log "k8s.io/klog/v2"
@@ -162,8 +163,9 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	// if the pointer was nil
 	cluster := request.GetContext().GetCluster()
 	if request != nil && cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.Context.Cluster: [%v]",
 			request.Context.Cluster)
 	}
@@ -179,7 +181,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	}
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
-	packageSummaries, err := filterAndPaginateCharts(
+	packageSummaries, facets, err := filterAndPaginateCharts(
 		request.GetFilterOptions(), pageSize, itemOffset, charts)
 	if err != nil {
 		return nil, err
@@ -200,6 +202,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	return &corev1.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: packageSummaries,
 		NextPageToken:             nextPageToken,
+		Facets:                    facets,
 		// TODO (gfichtenholt) Categories?
 		// Just happened to notice that helm plug-in returning this.
 		// Never discussed this and the design doc appears to have a lot of back-and-forth comments
@@ -213,19 +216,20 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 	defer log.Infof("-fluxv2 GetAvailablePackageDetail")
 
 	if request == nil || request.AvailablePackageRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided")
+		return nil, statuserror.FieldErrorf("available_package_ref", "no request AvailablePackageRef provided")
 	}
 
 	packageRef := request.AvailablePackageRef
 	// flux CRDs require a namespace, cluster-wide resources are not supported
 	if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "AvailablePackageReference is missing required 'namespace' field")
+		return nil, statuserror.FieldErrorf("available_package_ref.context.namespace", "AvailablePackageReference is missing required 'namespace' field")
 	}
 
 	cluster := packageRef.Context.Cluster
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.AvailablePackageRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -246,8 +250,9 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 	defer log.Infof("-fluxv2 GetAvailablePackageVersions")
 
 	if request.GetPkgVersion() != "" {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.GetPkgVersion(): [%v]",
 			request.GetPkgVersion())
 	}
@@ -255,13 +260,14 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 	packageRef := request.GetAvailablePackageRef()
 	namespace := packageRef.GetContext().GetNamespace()
 	if namespace == "" || packageRef.GetIdentifier() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "required context or identifier not provided")
+		return nil, statuserror.FieldErrorf("available_package_ref", "required context or identifier not provided")
 	}
 
 	cluster := packageRef.Context.Cluster
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.AvailablePackageRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -298,15 +304,16 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 
 	cluster := request.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.Context.Cluster: [%v]",
 			cluster)
 	}
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
 	installedPkgSummaries, err := s.paginatedInstalledPkgSummaries(
-		ctx, request.GetContext().GetNamespace(), pageSize, itemOffset)
+		ctx, request.GetContext().GetNamespace(), pageSize, itemOffset, request.GetFilterOptions())
 	if err != nil {
 		return nil, err
 	}
@@ -325,24 +332,42 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	return response, nil
 }
 
+// WatchInstalledPackageSummaries streams the changes to the installed packages
+// managed by the 'fluxv2' plugin
+func (s *Server) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	log.Infof("+fluxv2 WatchInstalledPackageSummaries [%v]", request)
+
+	cluster := request.GetContext().GetCluster()
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return statuserror.Errorf(
+			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
+			"not supported yet: request.Context.Cluster: [%v]",
+			cluster)
+	}
+
+	return s.watchInstalledPkgSummaries(stream.Context(), request.GetContext().GetNamespace(), stream.Send)
+}
+
 // GetInstalledPackageDetail returns the package metadata managed by the 'fluxv2' plugin
 func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
 	log.Infof("+fluxv2 GetInstalledPackageDetail [%v]", request)
 
 	if request == nil || request.InstalledPackageRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+		return nil, statuserror.FieldErrorf("installed_package_ref", "no request InstalledPackageRef provided")
 	}
 
 	packageRef := request.InstalledPackageRef
 	// flux CRDs require a namespace, cluster-wide resources are not supported
 	if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
+		return nil, statuserror.FieldErrorf("installed_package_ref.context.namespace", "InstalledPackageReference is missing required 'namespace' field")
 	}
 
 	cluster := packageRef.Context.GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -363,29 +388,31 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	log.Infof("+fluxv2 CreateInstalledPackage [%v]", request)
 
 	if request == nil || request.AvailablePackageRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided")
+		return nil, statuserror.FieldErrorf("available_package_ref", "no request AvailablePackageRef provided")
 	}
 	packageRef := request.AvailablePackageRef
 	if packageRef.GetContext().GetNamespace() == "" || packageRef.GetIdentifier() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "required context or identifier not provided")
+		return nil, statuserror.FieldErrorf("available_package_ref", "required context or identifier not provided")
 	}
 	cluster := packageRef.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.AvailablePackageRef.Context.Cluster: [%v]",
 			cluster)
 	}
 	if request.Name == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
+		return nil, statuserror.FieldErrorf("name", "no request Name provided")
 	}
 	if request.TargetContext == nil || request.TargetContext.Namespace == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "no request TargetContext namespace provided")
+		return nil, statuserror.FieldErrorf("target_context.namespace", "no request TargetContext namespace provided")
 	}
 	cluster = request.TargetContext.GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.TargetContext.Cluster: [%v]",
 			request.TargetContext.Cluster)
 	}
@@ -412,14 +439,15 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	log.Infof("+fluxv2 UpdateInstalledPackage [%v]", request)
 
 	if request == nil || request.InstalledPackageRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+		return nil, statuserror.FieldErrorf("installed_package_ref", "no request InstalledPackageRef provided")
 	}
 
 	installedPackageRef := request.InstalledPackageRef
 	cluster := installedPackageRef.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -438,19 +466,81 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	}
 }
 
+// GetInstalledPackageUpdatePreview returns the changes to resources which would
+// result from creating or updating an installed package.
+func (s *Server) GetInstalledPackageUpdatePreview(ctx context.Context, request *corev1.GetInstalledPackageUpdatePreviewRequest) (*corev1.GetInstalledPackageUpdatePreviewResponse, error) {
+	log.Infof("+fluxv2 GetInstalledPackageUpdatePreview [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref", "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster := installedPackageRef.GetContext().GetCluster()
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return nil, statuserror.Errorf(
+			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
+			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
+			cluster)
+	}
+
+	if changes, err := s.previewRelease(
+		ctx,
+		installedPackageRef,
+		request.AvailablePackageRef,
+		request.PkgVersionReference,
+		request.Values); err != nil {
+		return nil, err
+	} else {
+		return &corev1.GetInstalledPackageUpdatePreviewResponse{
+			ResourceChanges: changes,
+		}, nil
+	}
+}
+
+// CheckInstalledPackagePermissions returns the actions which the user is not
+// allowed to perform to create or update an installed package.
+func (s *Server) CheckInstalledPackagePermissions(ctx context.Context, request *corev1.CheckInstalledPackagePermissionsRequest) (*corev1.CheckInstalledPackagePermissionsResponse, error) {
+	log.Infof("+fluxv2 CheckInstalledPackagePermissions [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref", "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster := installedPackageRef.GetContext().GetCluster()
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return nil, statuserror.Errorf(
+			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
+			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
+			cluster)
+	}
+
+	if actions, err := s.releaseForbiddenActions(ctx, installedPackageRef, request.AvailablePackageRef); err != nil {
+		return nil, err
+	} else {
+		return &corev1.CheckInstalledPackagePermissionsResponse{
+			ForbiddenActions: actions,
+		}, nil
+	}
+}
+
 // DeleteInstalledPackage deletes an installed package.
 func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
 	log.Infof("+fluxv2 DeleteInstalledPackage [%v]", request)
 
 	if request == nil || request.InstalledPackageRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+		return nil, statuserror.FieldErrorf("installed_package_ref", "no request InstalledPackageRef provided")
 	}
 
 	installedPackageRef := request.InstalledPackageRef
 	cluster := installedPackageRef.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -476,10 +566,14 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 		return nil, err
 	}
 	hrName := helmReleaseName(key, rel)
-	refs, err := resourcerefs.GetInstalledPackageResourceRefs(ctx, hrName, s.actionConfigGetter)
+	refs, manifest, err := resourcerefs.GetInstalledPackageResourceRefsAndManifest(ctx, hrName, s.actionConfigGetter)
 	if err != nil {
 		return nil, err
 	} else {
+		// The manifest can be large, so it is only returned when requested.
+		if !request.GetIncludeManifest() {
+			manifest = ""
+		}
 		return &corev1.GetInstalledPackageResourceRefsResponse{
 			Context: &corev1.Context{
 				Cluster: s.kubeappsCluster,
@@ -494,36 +588,76 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 				Namespace: key.Namespace,
 			},
 			ResourceRefs: refs,
+			Manifest:     manifest,
 		}, nil
 	}
 }
 
+// GetInstalledPackageRevisions returns the revisions of an installed package,
+// taken from the history of the helm release managed by the HelmRelease.
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	log.Infof("+fluxv2 GetInstalledPackageRevisions [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, statuserror.FieldErrorf("installed_package_ref", "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster := installedPackageRef.GetContext().GetCluster()
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return nil, statuserror.Errorf(
+			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
+			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
+			cluster)
+	}
+
+	if revisions, err := s.releaseRevisions(ctx, installedPackageRef); err != nil {
+		return nil, err
+	} else {
+		return &corev1.GetInstalledPackageRevisionsResponse{
+			Revisions: revisions,
+		}, nil
+	}
+}
+
+// RollbackInstalledPackage is not supported by this plugin. The values of a
+// helm release revision have the valuesFrom secrets and config maps of the
+// HelmRelease merged in, so they cannot be pinned back in the HelmRelease
+// spec without exposing them, and helm-controller would revert a helm
+// rollback on its next reconciliation.
+func (s *Server) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 RollbackInstalledPackage [%v]", request)
+	return nil, statuserror.Errorf(codes.Unimplemented, statuserror.ReasonNotSupported, "rollbacks of flux helm releases are not supported")
+}
+
 func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPackageRepositoryRequest) (*corev1.AddPackageRepositoryResponse, error) {
 	log.Infof("+fluxv2 AddPackageRepository [%v]", request)
 	if request == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request provided")
+		return nil, statuserror.Errorf(codes.InvalidArgument, statuserror.ReasonInvalidField, "no request provided")
 	}
 	if request.Context == nil || request.Context.Namespace == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "no request Context namespace provided")
+		return nil, statuserror.FieldErrorf("context.namespace", "no request Context namespace provided")
 	}
 	cluster := request.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.Context.Cluster: [%v]",
 			request.Context.Cluster)
 	}
 
 	if request.Name == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
+		return nil, statuserror.FieldErrorf("name", "no request Name provided")
 	}
 
 	name := types.NamespacedName{Name: request.Name, Namespace: request.Context.Namespace}
 
 	if request.GetNamespaceScoped() {
-		return nil, status.Errorf(codes.Unimplemented, "Namespaced-scoped repositories are not supported")
+		return nil, statuserror.Errorf(codes.Unimplemented, statuserror.ReasonNotSupported, "Namespaced-scoped repositories are not supported")
 	} else if request.GetType() != "helm" {
-		return nil, status.Errorf(codes.Unimplemented, "repository type [%s] not supported", request.GetType())
+		return nil, statuserror.Errorf(codes.Unimplemented, statuserror.ReasonNotSupported, "repository type [%s] not supported", request.GetType())
 	}
 
 	if repoRef, err := s.newRepo(ctx, name, request.GetUrl(),
@@ -537,19 +671,20 @@ func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPa
 func (s *Server) GetPackageRepositoryDetail(ctx context.Context, request *corev1.GetPackageRepositoryDetailRequest) (*corev1.GetPackageRepositoryDetailResponse, error) {
 	log.Infof("+fluxv2 GetPackageRepositoryDetail [%v]", request)
 	if request == nil || request.PackageRepoRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided")
+		return nil, statuserror.FieldErrorf("package_repo_ref", "no request AvailablePackageRef provided")
 	}
 
 	repoRef := request.PackageRepoRef
 	// flux CRDs require a namespace, cluster-wide resources are not supported
 	if repoRef.Context == nil || len(repoRef.Context.Namespace) == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "PackageRepositoryReference is missing required namespace")
+		return nil, statuserror.FieldErrorf("package_repo_ref.context.namespace", "PackageRepositoryReference is missing required namespace")
 	}
 
 	cluster := repoRef.Context.Cluster
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.PackageRepoRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -569,8 +704,9 @@ func (s *Server) GetPackageRepositorySummaries(ctx context.Context, request *cor
 	log.Infof("+fluxv2 GetPackageRepositorySummaries [%v]", request)
 	cluster := request.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -588,14 +724,15 @@ func (s *Server) GetPackageRepositorySummaries(ctx context.Context, request *cor
 func (s *Server) UpdatePackageRepository(ctx context.Context, request *corev1.UpdatePackageRepositoryRequest) (*corev1.UpdatePackageRepositoryResponse, error) {
 	log.Infof("+fluxv2 UpdatePackageRepository [%v]", request)
 	if request == nil || request.PackageRepoRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+		return nil, statuserror.FieldErrorf("package_repo_ref", "no request PackageRepoRef provided")
 	}
 
 	repoRef := request.PackageRepoRef
 	cluster := repoRef.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.packageRepoRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -613,14 +750,15 @@ func (s *Server) UpdatePackageRepository(ctx context.Context, request *corev1.Up
 func (s *Server) DeletePackageRepository(ctx context.Context, request *corev1.DeletePackageRepositoryRequest) (*corev1.DeletePackageRepositoryResponse, error) {
 	log.Infof("+fluxv2 DeletePackageRepository [%v]", request)
 	if request == nil || request.PackageRepoRef == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+		return nil, statuserror.FieldErrorf("package_repo_ref", "no request PackageRepoRef provided")
 	}
 
 	repoRef := request.PackageRepoRef
 	cluster := repoRef.GetContext().GetCluster()
 	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
+		return nil, statuserror.Errorf(
 			codes.Unimplemented,
+			statuserror.ReasonNotSupported,
 			"not supported yet: request.packageRepoRef.Context.Cluster: [%v]",
 			cluster)
 	}
@@ -687,3 +825,14 @@ func (s *Server) hasAccessToNamespace(ctx context.Context, gvr schema.GroupVersi
 func GetPluginDetail() *plugins.Plugin {
 	return common.GetPluginDetail()
 }
+
+// GetPluginCapabilities returns the optional features supported by the plugin.
+// Packages can only be installed on the cluster on which Kubeapps is installed.
+func (s *Server) GetPluginCapabilities() *plugins.PluginCapabilities {
+	return &plugins.PluginCapabilities{
+		ReconciliationOptions: true,
+		ServiceAccounts:       true,
+		ValuesSchema:          true,
+		UpdatePreview:         true,
+	}
+}
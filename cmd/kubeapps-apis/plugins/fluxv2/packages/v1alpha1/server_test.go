@@ -300,6 +300,7 @@ func newHelmActionConfig(t *testing.T, namespace string, rels []helmReleaseStub)
 			},
 			Config:   config,
 			Manifest: r.manifest,
+			Version:  r.version,
 		}
 		err := actionConfig.Releases.Create(rel)
 		if err != nil {
@@ -14,6 +14,7 @@ import (
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	apiv1 "k8s.io/api/core/v1"
@@ -97,6 +98,14 @@ func (w *withWatchWrapper) Watch(ctx context.Context, list client.ObjectList, op
 	}
 }
 
+// ignoreSummaryDatesAndFacets ignores the dates of the latest versions, taken
+// from the test index files, and the facets, which are checked in
+// TestGetAvailablePackageSummariesSortAndFacets.
+var ignoreSummaryDatesAndFacets = cmp.Options{
+	cmpopts.IgnoreFields(corev1.AvailablePackageSummary{}, "LatestVersionUpdated"),
+	cmpopts.IgnoreFields(corev1.GetAvailablePackageSummariesResponse{}, "Facets"),
+}
+
 // these are helpers to compare slices ignoring order
 func lessAvailablePackageFunc(p1, p2 *corev1.AvailablePackageSummary) bool {
 	return p1.DisplayName < p2.DisplayName
@@ -0,0 +1,551 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/vmware-tanzu/kubeapps/cmd/assetsvc/pkg/utils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/audit"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/pkg/agent"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"github.com/vmware-tanzu/kubeapps/pkg/versionfilter"
+	"helm.sh/helm/v3/pkg/action"
+	corek8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// UpgradePolicyAnnotation opts a release of one of the configured
+	// namespaces into automatic upgrades when set, to "patch", "minor" or
+	// "major", on the Secret storing a revision of the release. The policy of the latest revision with the annotation applies,
+	// so it is kept when the release is upgraded, and "none" opts out again.
+	UpgradePolicyAnnotation = "kubeapps.dev/upgrade-policy"
+
+	// AutoUpgradeResultAnnotation records, on the Secret storing the latest
+	// revision of the release, the result of its last automatic upgrade.
+	AutoUpgradeResultAnnotation = "kubeapps.dev/auto-upgrade-result"
+
+	DefaultAutoUpgradeIntervalSeconds int32 = 3600
+
+	// The lease held by the replica of kubeapps-apis upgrading releases, to
+	// which the chart restricts the access of the service account by name.
+	autoUpgradeLeaseName = "kubeapps-apis-helm-auto-upgrade"
+)
+
+// autoUpgradeConfig configures the automatic upgrade of releases.
+type autoUpgradeConfig struct {
+	Enabled bool `json:"enabled"`
+	// IntervalSeconds is the interval at which releases are checked for
+	// upgrades.
+	IntervalSeconds int32 `json:"intervalSeconds"`
+	// MaintenanceWindows are the windows during which releases are upgraded.
+	// Releases are upgraded at any time when there is none.
+	MaintenanceWindows []maintenanceWindow `json:"maintenanceWindows"`
+	// Namespaces are the only namespaces in which releases can opt into
+	// automatic upgrades. The upgrade policy of other releases is ignored.
+	Namespaces []string `json:"namespaces"`
+	// ServiceAccountName is the name of the service account, in the namespace
+	// of each release, impersonated to upgrade it, so that an upgrade is
+	// limited to the permissions granted to that service account rather than
+	// those of kubeapps-apis.
+	ServiceAccountName string `json:"serviceAccountName"`
+}
+
+// maintenanceWindow is a daily window, in UTC, during which releases can be
+// upgraded. A window ending before it starts spans midnight.
+type maintenanceWindow struct {
+	// Days are the days of the week of the window, such as "Saturday" or
+	// "sat". Every day when empty.
+	Days []string `json:"days"`
+	// Start and End are times of the day in the "15:04" format.
+	Start string `json:"start"`
+	End   string `json:"end"`
+
+	weekdays   map[time.Weekday]bool
+	start, end time.Duration
+}
+
+// autoUpgradeResult is the result of the automatic upgrade of a release.
+type autoUpgradeResult struct {
+	Time        time.Time `json:"time"`
+	FromVersion string    `json:"fromVersion"`
+	ToVersion   string    `json:"toVersion"`
+	Succeeded   bool      `json:"succeeded"`
+	Message     string    `json:"message,omitempty"`
+}
+
+// releaseToAutoUpgrade is a release opted into automatic upgrades.
+type releaseToAutoUpgrade struct {
+	name      string
+	namespace string
+	policy    pkgutils.UpgradePolicy
+}
+
+// parseAutoUpgradeConfig parses the automatic upgrade options of the plugin
+// configuration, which are specific to the helm plugin.
+func parseAutoUpgradeConfig(pluginConfigPath string) (autoUpgradeConfig, error) {
+	type helmConfig struct {
+		Helm struct {
+			Packages struct {
+				V1alpha1 struct {
+					AutoUpgrade autoUpgradeConfig `json:"autoUpgrade"`
+				} `json:"v1alpha1"`
+			} `json:"packages"`
+		} `json:"helm"`
+	}
+	var config helmConfig
+
+	pluginConfig, err := ioutil.ReadFile(pluginConfigPath)
+	if err != nil {
+		return autoUpgradeConfig{}, fmt.Errorf("unable to open plugin config at %q: %w", pluginConfigPath, err)
+	}
+	err = json.Unmarshal([]byte(pluginConfig), &config)
+	if err != nil {
+		return autoUpgradeConfig{}, fmt.Errorf("unable to unmarshal pluginconfig: %q error: %w", string(pluginConfig), err)
+	}
+
+	autoUpgrade := config.Helm.Packages.V1alpha1.AutoUpgrade
+	if autoUpgrade.Enabled && (len(autoUpgrade.Namespaces) == 0 || autoUpgrade.ServiceAccountName == "") {
+		return autoUpgradeConfig{}, fmt.Errorf("the automatic upgrades require the namespaces in which releases can be upgraded and the name of the service account upgrading them")
+	}
+	if autoUpgrade.IntervalSeconds <= 0 {
+		autoUpgrade.IntervalSeconds = DefaultAutoUpgradeIntervalSeconds
+	}
+	for i := range autoUpgrade.MaintenanceWindows {
+		if err := autoUpgrade.MaintenanceWindows[i].parse(); err != nil {
+			return autoUpgradeConfig{}, fmt.Errorf("invalid auto upgrade maintenance window %d: %w", i, err)
+		}
+	}
+	return autoUpgrade, nil
+}
+
+func (w *maintenanceWindow) parse() error {
+	var err error
+	if w.start, err = parseTimeOfDay(w.Start); err != nil {
+		return err
+	}
+	if w.end, err = parseTimeOfDay(w.End); err != nil {
+		return err
+	}
+	if w.start == w.end {
+		return fmt.Errorf("the window starts and ends at %q", w.Start)
+	}
+	w.weekdays = map[time.Weekday]bool{}
+	for _, day := range w.Days {
+		weekday, err := parseWeekday(day)
+		if err != nil {
+			return err
+		}
+		w.weekdays[weekday] = true
+	}
+	return nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of the day %q, expected the format HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(s, d.String()) || strings.EqualFold(s, d.String()[:3]) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day of the week %q", s)
+}
+
+// contains returns whether t is within the window.
+func (w maintenanceWindow) contains(t time.Time) bool {
+	t = t.UTC()
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	onDay := func(d time.Weekday) bool {
+		return len(w.weekdays) == 0 || w.weekdays[d]
+	}
+	if w.start < w.end {
+		return onDay(t.Weekday()) && sinceMidnight >= w.start && sinceMidnight < w.end
+	}
+	// The window spans midnight, so the time after midnight belongs to the
+	// window of the previous day.
+	if sinceMidnight >= w.start {
+		return onDay(t.Weekday())
+	}
+	return sinceMidnight < w.end && onDay((t.Weekday()+6)%7)
+}
+
+// inMaintenanceWindow returns whether releases can be upgraded at t.
+func (c autoUpgradeConfig) inMaintenanceWindow(t time.Time) bool {
+	if len(c.MaintenanceWindows) == 0 {
+		return true
+	}
+	for _, w := range c.MaintenanceWindows {
+		if w.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// latestMatchingVersion returns the latest of the chart versions to which a
+// release of the current version can be upgraded with the policy, or an
+// empty string if there is none.
+func latestMatchingVersion(currentVersion string, policy pkgutils.UpgradePolicy, chartVersions []models.ChartVersion) (string, error) {
+	current, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		return "", err
+	}
+	constraint, err := pkgutils.VersionConstraintWithUpgradePolicy(currentVersion, policy)
+	if err != nil {
+		return "", err
+	}
+	filter := versionfilter.New(constraint)
+
+	var latest *semver.Version
+	latestVersion := ""
+	for _, cv := range chartVersions {
+		v, err := semver.NewVersion(cv.Version)
+		if err != nil || !v.GreaterThan(current) || !filter.Matches(cv.Version) {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+			latestVersion = cv.Version
+		}
+	}
+	return latestVersion, nil
+}
+
+// releasesToAutoUpgrade returns the releases opted into automatic upgrades
+// from the Secrets storing their revisions. Releases whose latest revision
+// is not deployed, for instance after a failed upgrade, are left untouched.
+func releasesToAutoUpgrade(secrets []corek8sv1.Secret) []releaseToAutoUpgrade {
+	type revisions struct {
+		latest, latestWithPolicy *corek8sv1.Secret
+	}
+	revisionOf := func(s *corek8sv1.Secret) int {
+		revision, _ := strconv.Atoi(s.Labels["version"])
+		return revision
+	}
+
+	keys := []types.NamespacedName{}
+	releases := map[types.NamespacedName]*revisions{}
+	for i := range secrets {
+		secret := &secrets[i]
+		key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Labels["name"]}
+		r, ok := releases[key]
+		if !ok {
+			r = &revisions{}
+			releases[key] = r
+			keys = append(keys, key)
+		}
+		if r.latest == nil || revisionOf(secret) > revisionOf(r.latest) {
+			r.latest = secret
+		}
+		if _, ok := secret.Annotations[UpgradePolicyAnnotation]; ok && (r.latestWithPolicy == nil || revisionOf(secret) > revisionOf(r.latestWithPolicy)) {
+			r.latestWithPolicy = secret
+		}
+	}
+
+	toUpgrade := []releaseToAutoUpgrade{}
+	for _, key := range keys {
+		r := releases[key]
+		if r.latestWithPolicy == nil {
+			continue
+		}
+		policy, err := pkgutils.UpgradePolicyFromString(r.latestWithPolicy.Annotations[UpgradePolicyAnnotation])
+		if err != nil {
+			log.Errorf("Ignoring the upgrade policy of the release %q: %v", key, err)
+			continue
+		}
+		if policy == pkgutils.UpgradePolicyNone {
+			continue
+		}
+		if r.latest.Labels["status"] != "deployed" {
+			log.Infof("Not upgrading the release %q as its latest revision is %s", key, r.latest.Labels["status"])
+			continue
+		}
+		toUpgrade = append(toUpgrade, releaseToAutoUpgrade{
+			name:      key.Name,
+			namespace: key.Namespace,
+			policy:    policy,
+		})
+	}
+	return toUpgrade
+}
+
+// autoUpgrader upgrades the releases opted into automatic upgrades to the
+// latest chart version matching their upgrade policy, so that they behave
+// like the Flux HelmReleases with a version constraint. As there is no user
+// request, the releases of each of the configured namespaces are upgraded by
+// impersonating the configured service account of that namespace. It only
+// acts on the cluster on which kubeapps-apis is installed.
+type autoUpgrader struct {
+	config  autoUpgradeConfig
+	auditor *audit.Auditor
+	now     func() time.Time
+	// serverForNamespace returns the server used to upgrade the releases of
+	// the namespace. It is a field so that it can be switched in tests.
+	serverForNamespace func(namespace string) *Server
+}
+
+func newAutoUpgrader(server *Server, config autoUpgradeConfig, auditor *audit.Auditor) *autoUpgrader {
+	return &autoUpgrader{
+		config:  config,
+		auditor: auditor,
+		now:     time.Now,
+		serverForNamespace: func(namespace string) *Server {
+			return impersonatingServer(server, serviceAccountUserName(namespace, config.ServiceAccountName))
+		},
+	}
+}
+
+// serviceAccountUserName returns the name of the user authenticated by the
+// tokens of the service account.
+func serviceAccountUserName(namespace, name string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+}
+
+// impersonatingServer returns a copy of the server with the service account
+// of kubeapps-apis impersonating the user.
+func impersonatingServer(s *Server, userName string) *Server {
+	clientGetter := clientgetter.NewImpersonatingClientGetter(userName, clientgetter.Options{})
+	actionConfigGetter := clientgetter.NewImpersonatingHelmActionConfigGetter(userName)
+
+	impersonating := *s
+	impersonating.clientGetter = func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+		return clientGetter(ctx)
+	}
+	impersonating.actionConfigGetter = func(ctx context.Context, pkgContext *corev1.Context) (*action.Configuration, error) {
+		return actionConfigGetter(ctx, pkgContext.GetNamespace())
+	}
+	return &impersonating
+}
+
+// startAutoUpgrader starts upgrading releases automatically in the background.
+func startAutoUpgrader(s *Server, config autoUpgradeConfig, auditor *audit.Auditor) error {
+	// Only the lease is managed with the service account of kubeapps-apis
+	// itself. It is held in the namespace of kubeapps-apis, defaulting to the
+	// global packaging namespace when it is not known.
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("unable to get in cluster config: %w", err)
+	}
+	typedClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create the client of the lease: %w", err)
+	}
+	namespace := os.Getenv("POD_NAMESPACE")
+	if namespace == "" {
+		namespace = s.globalPackagingNamespace
+	}
+	return newAutoUpgrader(s, config, auditor).start(context.Background(), typedClient, namespace)
+}
+
+// start runs the automatic upgrades in the background on the replica of
+// kubeapps-apis holding the lease in the namespace, so that each release is
+// upgraded by a single replica.
+func (u *autoUpgrader) start(ctx context.Context, typedClient kubernetes.Interface, namespace string) error {
+	return k8sutils.RunWithLeaderElection(ctx, typedClient, namespace, autoUpgradeLeaseName, u.run)
+}
+
+// run checks the releases for upgrades at the configured interval, until
+// the context is done.
+func (u *autoUpgrader) run(ctx context.Context) {
+	log.Infof("+helm upgrading releases automatically every %ds", u.config.IntervalSeconds)
+	ticker := time.NewTicker(time.Duration(u.config.IntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		if u.config.inMaintenanceWindow(u.now()) {
+			if err := u.upgradeReleases(ctx); err != nil {
+				log.Errorf("Unable to upgrade the releases automatically: %v", err)
+			}
+		} else {
+			log.V(4).Infof("+helm not upgrading releases outside of the maintenance windows")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// upgradeReleases upgrades each release of the configured namespaces opted
+// into automatic upgrades for which there is a newer chart version matching
+// its upgrade policy.
+func (u *autoUpgrader) upgradeReleases(ctx context.Context) error {
+	for _, namespace := range u.config.Namespaces {
+		if err := u.upgradeNamespaceReleases(ctx, namespace); err != nil {
+			log.Errorf("Unable to upgrade the releases of the namespace %q automatically: %v", namespace, err)
+		}
+	}
+	return nil
+}
+
+func (u *autoUpgrader) upgradeNamespaceReleases(ctx context.Context, namespace string) error {
+	server := u.serverForNamespace(namespace)
+	typedClient, _, err := server.GetClients(ctx, server.globalPackagingCluster)
+	if err != nil {
+		return err
+	}
+	secrets, err := typedClient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: helmReleaseSecretsSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the Helm release secrets: %w", err)
+	}
+
+	for _, r := range releasesToAutoUpgrade(secrets.Items) {
+		result, err := u.upgradeRelease(ctx, server, r)
+		if err != nil {
+			log.Errorf("Unable to check the release %s/%s for upgrades: %v", r.namespace, r.name, err)
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		if err := recordAutoUpgradeResult(ctx, typedClient, r, result); err != nil {
+			log.Errorf("Unable to record the result of the upgrade of the release %s/%s: %v", r.namespace, r.name, err)
+		}
+	}
+	return nil
+}
+
+// upgradeRelease upgrades the release to the latest chart version matching
+// its upgrade policy, keeping its values. It returns the result of the
+// upgrade, or nil if the release is up to date.
+func (u *autoUpgrader) upgradeRelease(ctx context.Context, server *Server, r releaseToAutoUpgrade) (*autoUpgradeResult, error) {
+	pkgContext := &corev1.Context{Cluster: server.globalPackagingCluster, Namespace: r.namespace}
+	actionConfig, err := server.actionConfigGetter(ctx, pkgContext)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := agent.GetRelease(actionConfig, r.name)
+	if err != nil {
+		return nil, err
+	}
+
+	// As for the installed package detail, the chart of the release is the
+	// one with that name and version available in the release namespace.
+	currentVersion := rel.Chart.Metadata.Version
+	charts, err := server.assetManager(ctx).GetPaginatedChartListWithFilters(utils.ChartQuery{
+		Namespace:  rel.Namespace,
+		ChartName:  rel.Chart.Metadata.Name,
+		Version:    currentVersion,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the chart of the release: %w", err)
+	}
+	if len(charts) == 0 {
+		log.Infof("Not upgrading the release %s/%s as its chart is not available", r.namespace, r.name)
+		return nil, nil
+	}
+	targetVersion, err := latestMatchingVersion(currentVersion, r.policy, charts[0].ChartVersions)
+	if err != nil {
+		return nil, err
+	}
+	if targetVersion == "" {
+		log.V(4).Infof("The release %s/%s is up to date with the %s upgrade policy", r.namespace, r.name, r.policy)
+		return nil, nil
+	}
+
+	values, err := json.Marshal(rel.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("+helm upgrading the release %s/%s from %s to %s with the %s upgrade policy", r.namespace, r.name, currentVersion, targetVersion, r.policy)
+	result := &autoUpgradeResult{
+		Time:        u.now().UTC(),
+		FromVersion: currentVersion,
+		ToVersion:   targetVersion,
+		Succeeded:   true,
+	}
+	_, err = server.UpdateInstalledPackage(ctx, &corev1.UpdateInstalledPackageRequest{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    pkgContext,
+			Identifier: r.name,
+		},
+		PkgVersionReference: &corev1.VersionReference{
+			Version: targetVersion,
+		},
+		Values: string(values),
+	})
+	u.auditor.Record(audit.Event{
+		Action:     "AutoUpgradeInstalledPackage",
+		User:       audit.User{Username: serviceAccountUserName(r.namespace, u.config.ServiceAccountName)},
+		Plugin:     &audit.Plugin{Name: pluginDetail.Name, Version: pluginDetail.Version},
+		Cluster:    pkgContext.GetCluster(),
+		Namespace:  r.namespace,
+		Identifier: r.name,
+		Version:    targetVersion,
+	}, err)
+	if err != nil {
+		log.Errorf("Unable to upgrade the release %s/%s to %s: %v", r.namespace, r.name, targetVersion, err)
+		result.Succeeded = false
+		result.Message = err.Error()
+	}
+	return result, nil
+}
+
+// recordAutoUpgradeResult records the result on the Secret of the latest
+// revision of the release.
+func recordAutoUpgradeResult(ctx context.Context, typedClient kubernetes.Interface, r releaseToAutoUpgrade, result *autoUpgradeResult) error {
+	secrets, err := typedClient.CoreV1().Secrets(r.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s,name=%s", helmReleaseSecretsSelector, r.name),
+	})
+	if err != nil {
+		return err
+	}
+	var latest *corek8sv1.Secret
+	latestRevision := -1
+	for i := range secrets.Items {
+		revision, _ := strconv.Atoi(secrets.Items[i].Labels["version"])
+		if revision > latestRevision {
+			latest = &secrets.Items[i]
+			latestRevision = revision
+		}
+	}
+	if latest == nil {
+		return fmt.Errorf("no revision found")
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				AutoUpgradeResultAnnotation: string(resultJSON),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = typedClient.CoreV1().Secrets(r.namespace).Patch(ctx, latest.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,591 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/audit"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"google.golang.org/grpc/codes"
+	"helm.sh/helm/v3/pkg/release"
+	corek8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseAutoUpgradeConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		pluginYAMLConf []byte
+		expected       autoUpgradeConfig
+		expectErr      bool
+	}{
+		{
+			name:           "it is disabled by default",
+			pluginYAMLConf: []byte(`core: {}`),
+			expected: autoUpgradeConfig{
+				IntervalSeconds: DefaultAutoUpgradeIntervalSeconds,
+			},
+		},
+		{
+			name: "it parses the interval and maintenance windows",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      autoUpgrade:
+        enabled: true
+        intervalSeconds: 600
+        namespaces: ["team-a", "team-b"]
+        serviceAccountName: kubeapps-auto-upgrade
+        maintenanceWindows:
+          - days: ["Saturday", "sun"]
+            start: "22:00"
+            end: "02:30"
+      `),
+			expected: autoUpgradeConfig{
+				Enabled:            true,
+				IntervalSeconds:    600,
+				Namespaces:         []string{"team-a", "team-b"},
+				ServiceAccountName: "kubeapps-auto-upgrade",
+				MaintenanceWindows: []maintenanceWindow{
+					{
+						Days:     []string{"Saturday", "sun"},
+						Start:    "22:00",
+						End:      "02:30",
+						weekdays: map[time.Weekday]bool{time.Saturday: true, time.Sunday: true},
+						start:    22 * time.Hour,
+						end:      2*time.Hour + 30*time.Minute,
+					},
+				},
+			},
+		},
+		{
+			name: "it errors when enabled without namespaces",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      autoUpgrade:
+        enabled: true
+        serviceAccountName: kubeapps-auto-upgrade
+      `),
+			expectErr: true,
+		},
+		{
+			name: "it errors when enabled without a service account",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      autoUpgrade:
+        enabled: true
+        namespaces: ["team-a"]
+      `),
+			expectErr: true,
+		},
+		{
+			name: "it errors with an invalid time of the day",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      autoUpgrade:
+        maintenanceWindows:
+          - start: "10pm"
+            end: "02:00"
+      `),
+			expectErr: true,
+		},
+		{
+			name: "it errors with an invalid day of the week",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      autoUpgrade:
+        maintenanceWindows:
+          - days: ["weekend"]
+            start: "22:00"
+            end: "02:00"
+      `),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "helm-plugin-config.json")
+			pluginJSONConf, err := yaml.YAMLToJSON(tc.pluginYAMLConf)
+			if err != nil {
+				t.Fatalf("%s", err)
+			}
+			err = ioutil.WriteFile(filename, pluginJSONConf, 0644)
+			if err != nil {
+				t.Fatalf("%s", err)
+			}
+			defer os.Remove(filename)
+
+			config, err := parseAutoUpgradeConfig(filename)
+			if got, want := err != nil, tc.expectErr; got != want {
+				t.Fatalf("got error: %v, want error: %t", err, want)
+			}
+			if tc.expectErr {
+				return
+			}
+			opt := cmp.AllowUnexported(maintenanceWindow{})
+			if got, want := config, tc.expected; !cmp.Equal(want, got, opt) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt))
+			}
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// 2022-06-04 is a Saturday.
+	saturday := func(hour, min int) time.Time {
+		return time.Date(2022, 6, 4, hour, min, 0, 0, time.UTC)
+	}
+	window := func(days []string, start, end string) maintenanceWindow {
+		w := maintenanceWindow{Days: days, Start: start, End: end}
+		if err := w.parse(); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return w
+	}
+
+	testCases := []struct {
+		name     string
+		windows  []maintenanceWindow
+		time     time.Time
+		expected bool
+	}{
+		{
+			name:     "it is always in a window when there is none",
+			time:     saturday(12, 0),
+			expected: true,
+		},
+		{
+			name:     "it is in a daily window",
+			windows:  []maintenanceWindow{window(nil, "02:00", "04:00")},
+			time:     saturday(3, 59),
+			expected: true,
+		},
+		{
+			name:     "it is not in a daily window after its end",
+			windows:  []maintenanceWindow{window(nil, "02:00", "04:00")},
+			time:     saturday(4, 0),
+			expected: false,
+		},
+		{
+			name:     "it is not in a window on another day",
+			windows:  []maintenanceWindow{window([]string{"sun"}, "02:00", "04:00")},
+			time:     saturday(3, 0),
+			expected: false,
+		},
+		{
+			name:     "it is in a window spanning midnight before midnight",
+			windows:  []maintenanceWindow{window([]string{"Saturday"}, "22:00", "02:00")},
+			time:     saturday(23, 0),
+			expected: true,
+		},
+		{
+			name:     "it is in a window spanning midnight after midnight of the next day",
+			windows:  []maintenanceWindow{window([]string{"Friday"}, "22:00", "02:00")},
+			time:     saturday(1, 0),
+			expected: true,
+		},
+		{
+			name:     "it is not in a window spanning midnight after midnight of the same day",
+			windows:  []maintenanceWindow{window([]string{"Saturday"}, "22:00", "02:00")},
+			time:     saturday(1, 0),
+			expected: false,
+		},
+		{
+			name: "it is in any of the windows",
+			windows: []maintenanceWindow{
+				window([]string{"mon"}, "02:00", "04:00"),
+				window([]string{"sat"}, "12:00", "13:00"),
+			},
+			time:     saturday(12, 30),
+			expected: true,
+		},
+		{
+			name:     "it uses the UTC time",
+			windows:  []maintenanceWindow{window(nil, "02:00", "04:00")},
+			time:     saturday(3, 0).In(time.FixedZone("UTC+8", 8*60*60)),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := autoUpgradeConfig{MaintenanceWindows: tc.windows}
+			if got, want := config.inMaintenanceWindow(tc.time), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestLatestMatchingVersion(t *testing.T) {
+	chartVersions := []models.ChartVersion{
+		{Version: "2.0.0"},
+		{Version: "1.3.0-rc.1"},
+		{Version: "1.2.5"},
+		{Version: "1.2.4"},
+		{Version: "1.2.3"},
+		{Version: "1.1.9"},
+	}
+
+	testCases := []struct {
+		name           string
+		currentVersion string
+		policy         pkgutils.UpgradePolicy
+		expected       string
+	}{
+		{"it returns the latest patch version", "1.2.3", pkgutils.UpgradePolicyPatch, "1.2.5"},
+		{"it returns the latest minor version", "1.1.9", pkgutils.UpgradePolicyMinor, "1.2.5"},
+		{"it returns the latest major version", "1.2.3", pkgutils.UpgradePolicyMajor, "2.0.0"},
+		{"it returns no version when up to date", "1.2.5", pkgutils.UpgradePolicyMinor, ""},
+		{"it returns no version with the none policy", "1.2.3", pkgutils.UpgradePolicyNone, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := latestMatchingVersion(tc.currentVersion, tc.policy, chartVersions)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if want := tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func releaseSecret(namespace, name string, revision int, status string, annotations map[string]string) corek8sv1.Secret {
+	return corek8sv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, revision),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    name,
+				"version": fmt.Sprintf("%d", revision),
+				"status":  status,
+			},
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestReleasesToAutoUpgrade(t *testing.T) {
+	minor := map[string]string{UpgradePolicyAnnotation: "minor"}
+
+	testCases := []struct {
+		name     string
+		secrets  []corek8sv1.Secret
+		expected []releaseToAutoUpgrade
+	}{
+		{
+			name: "it returns the releases with an upgrade policy",
+			secrets: []corek8sv1.Secret{
+				releaseSecret("default", "my-apache", 1, "deployed", minor),
+				releaseSecret("default", "my-nginx", 1, "deployed", nil),
+				releaseSecret("other", "my-apache", 1, "deployed", map[string]string{UpgradePolicyAnnotation: "patch"}),
+			},
+			expected: []releaseToAutoUpgrade{
+				{name: "my-apache", namespace: "default", policy: pkgutils.UpgradePolicyMinor},
+				{name: "my-apache", namespace: "other", policy: pkgutils.UpgradePolicyPatch},
+			},
+		},
+		{
+			name: "it uses the policy of the latest revision with an upgrade policy",
+			secrets: []corek8sv1.Secret{
+				releaseSecret("default", "my-apache", 3, "deployed", nil),
+				releaseSecret("default", "my-apache", 2, "superseded", map[string]string{UpgradePolicyAnnotation: "major"}),
+				releaseSecret("default", "my-apache", 1, "superseded", minor),
+			},
+			expected: []releaseToAutoUpgrade{
+				{name: "my-apache", namespace: "default", policy: pkgutils.UpgradePolicyMajor},
+			},
+		},
+		{
+			name: "it ignores releases opted out with the none policy",
+			secrets: []corek8sv1.Secret{
+				releaseSecret("default", "my-apache", 1, "superseded", minor),
+				releaseSecret("default", "my-apache", 2, "deployed", map[string]string{UpgradePolicyAnnotation: "none"}),
+			},
+			expected: []releaseToAutoUpgrade{},
+		},
+		{
+			name: "it ignores releases whose latest revision is not deployed",
+			secrets: []corek8sv1.Secret{
+				releaseSecret("default", "my-apache", 1, "deployed", minor),
+				releaseSecret("default", "my-apache", 2, "failed", nil),
+			},
+			expected: []releaseToAutoUpgrade{},
+		},
+		{
+			name: "it ignores invalid upgrade policies",
+			secrets: []corek8sv1.Secret{
+				releaseSecret("default", "my-apache", 1, "deployed", map[string]string{UpgradePolicyAnnotation: "latest"}),
+			},
+			expected: []releaseToAutoUpgrade{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opt := cmp.AllowUnexported(releaseToAutoUpgrade{})
+			if got, want := releasesToAutoUpgrade(tc.secrets), tc.expected; !cmp.Equal(want, got, opt) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt))
+			}
+		})
+	}
+}
+
+func TestAutoUpgradeRelease(t *testing.T) {
+	now := time.Date(2022, 6, 4, 3, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                 string
+		existingRelease      releaseStub
+		policy               pkgutils.UpgradePolicy
+		expectedResult       *autoUpgradeResult
+		expectedAuditEvents  []audit.Event
+		expectedChartVersion string
+	}{
+		{
+			name: "it upgrades the release to the latest matching version keeping its values",
+			existingRelease: releaseStub{
+				name:           "my-apache",
+				namespace:      "default",
+				chartID:        "bitnami/apache",
+				chartVersion:   "1.18.3",
+				chartNamespace: globalPackagingNamespace,
+				latestVersion:  "1.18.4",
+				values:         "{\"foo\": \"bar\"}",
+				status:         release.StatusDeployed,
+			},
+			policy: pkgutils.UpgradePolicyPatch,
+			expectedResult: &autoUpgradeResult{
+				Time:        now,
+				FromVersion: "1.18.3",
+				ToVersion:   "1.18.4",
+				Succeeded:   true,
+			},
+			expectedAuditEvents: []audit.Event{
+				{
+					Action:     "AutoUpgradeInstalledPackage",
+					User:       audit.User{Username: "system:serviceaccount:default:kubeapps-auto-upgrade"},
+					Plugin:     &audit.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+					Cluster:    globalPackagingCluster,
+					Namespace:  "default",
+					Identifier: "my-apache",
+					Version:    "1.18.4",
+					Outcome:    audit.OutcomeSuccess,
+					Code:       codes.OK.String(),
+				},
+			},
+			expectedChartVersion: "1.18.4",
+		},
+		{
+			name: "it does not upgrade the release without a matching version",
+			existingRelease: releaseStub{
+				name:           "my-apache",
+				namespace:      "default",
+				chartID:        "bitnami/apache",
+				chartVersion:   "1.18.3",
+				chartNamespace: globalPackagingNamespace,
+				latestVersion:  "2.0.0",
+				values:         "{\"foo\": \"bar\"}",
+				status:         release.StatusDeployed,
+			},
+			policy:               pkgutils.UpgradePolicyMinor,
+			expectedChartVersion: "1.18.3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, tc.existingRelease.namespace, []releaseStub{tc.existingRelease}, nil)
+			server, mockDB, cleanup := makeServer(t, true, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+			// The chart is queried once for the latest matching version and,
+			// when upgrading, once more for the installed package detail.
+			populateAssetDB(t, mockDB, []releaseStub{tc.existingRelease})
+			if tc.expectedResult != nil {
+				populateAssetDB(t, mockDB, []releaseStub{tc.existingRelease})
+				populateAssetForTarball(t, mockDB, "bitnami%apache", globalPackagingNamespace, tc.expectedChartVersion)
+			}
+
+			sink := &auditSink{}
+			auditor := audit.NewAuditor(nil, sink)
+			upgrader := newAutoUpgrader(server, autoUpgradeConfig{
+				Namespaces:         []string{tc.existingRelease.namespace},
+				ServiceAccountName: "kubeapps-auto-upgrade",
+			}, auditor)
+			upgrader.now = func() time.Time { return now }
+
+			result, err := upgrader.upgradeRelease(context.Background(), server, releaseToAutoUpgrade{
+				name:      tc.existingRelease.name,
+				namespace: tc.existingRelease.namespace,
+				policy:    tc.policy,
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := result, tc.expectedResult; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			auditor.Close()
+			if got, want := sink.events, tc.expectedAuditEvents; !cmp.Equal(want, got, cmpopts.IgnoreFields(audit.Event{}, "Time")) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.IgnoreFields(audit.Event{}, "Time")))
+			}
+
+			deployedFilter := func(r *release.Release) bool {
+				return r.Info.Status == release.StatusDeployed
+			}
+			releases, err := actionConfig.Releases.Driver.List(deployedFilter)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(releases), 1; got != want {
+				t.Fatalf("got: %d, want: %d", got, want)
+			}
+			if got, want := releases[0].Chart.Metadata.Version, tc.expectedChartVersion; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := releases[0].Config, map[string]interface{}{"foo": "bar"}; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if err := mockDB.ExpectationsWereMet(); err != nil {
+				t.Errorf("%+v", err)
+			}
+		})
+	}
+}
+
+func TestUpgradeReleasesOfConfiguredNamespaces(t *testing.T) {
+	minor := map[string]string{UpgradePolicyAnnotation: "minor"}
+	teamA := releaseSecret("team-a", "my-apache", 1, "deployed", nil)
+	other := releaseSecret("other", "my-apache", 1, "deployed", minor)
+	typedClient := typfake.NewSimpleClientset(&teamA, &other)
+	server := &Server{
+		clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().WithTyped(typedClient).Build(), nil
+		},
+		globalPackagingCluster: globalPackagingCluster,
+	}
+
+	upgrader := newAutoUpgrader(server, autoUpgradeConfig{
+		Namespaces:         []string{"team-a"},
+		ServiceAccountName: "kubeapps-auto-upgrade",
+	}, nil)
+	namespaces := []string{}
+	upgrader.serverForNamespace = func(namespace string) *Server {
+		namespaces = append(namespaces, namespace)
+		return server
+	}
+
+	if err := upgrader.upgradeReleases(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// The releases are only listed, with the server of the namespace, in the
+	// configured namespaces, so that the release opted into upgrades in
+	// another namespace is ignored.
+	if got, want := namespaces, []string{"team-a"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	listed := []string{}
+	for _, action := range typedClient.Actions() {
+		if action.GetVerb() != "list" || action.GetResource().Resource != "secrets" {
+			t.Errorf("unexpected action: %+v", action)
+			continue
+		}
+		listed = append(listed, action.GetNamespace())
+	}
+	if got, want := listed, []string{"team-a"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+// auditSink keeps the audit events written to it.
+type auditSink struct {
+	events []audit.Event
+}
+
+func (s *auditSink) Write(ctx context.Context, event audit.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestRecordAutoUpgradeResult(t *testing.T) {
+	policy := map[string]string{UpgradePolicyAnnotation: "patch"}
+	previous := releaseSecret("default", "my-apache", 1, "superseded", policy)
+	latest := releaseSecret("default", "my-apache", 2, "deployed", nil)
+	other := releaseSecret("default", "my-nginx", 3, "deployed", nil)
+	typedClient := typfake.NewSimpleClientset(&previous, &latest, &other)
+
+	result := &autoUpgradeResult{
+		Time:        time.Date(2022, 6, 4, 3, 0, 0, 0, time.UTC),
+		FromVersion: "1.18.3",
+		ToVersion:   "1.18.4",
+		Succeeded:   false,
+		Message:     "upgrade failed",
+	}
+	err := recordAutoUpgradeResult(context.Background(), typedClient, releaseToAutoUpgrade{
+		name:      "my-apache",
+		namespace: "default",
+		policy:    pkgutils.UpgradePolicyPatch,
+	}, result)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	secret, err := typedClient.CoreV1().Secrets("default").Get(context.Background(), latest.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	recorded := &autoUpgradeResult{}
+	if err := json.Unmarshal([]byte(secret.Annotations[AutoUpgradeResultAnnotation]), recorded); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := recorded, result; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	for _, s := range []corek8sv1.Secret{previous, other} {
+		secret, err := typedClient.CoreV1().Secrets("default").Get(context.Background(), s.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := secret.Annotations, s.Annotations; !cmp.Equal(want, got, cmpopts.EquateEmpty()) {
+			t.Errorf("mismatch for %q (-want +got):\n%s", s.Name, cmp.Diff(want, got))
+		}
+	}
+}
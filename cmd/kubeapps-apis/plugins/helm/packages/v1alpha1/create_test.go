@@ -12,6 +12,7 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/fake"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/action"
@@ -24,6 +25,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name               string
 		releaseStub        releaseStub
+		chartSchema        string
 		request            *corev1.CreateInstalledPackageRequest
 		expectedResponse   *corev1.CreateInstalledPackageResponse
 		expectedStatusCode codes.Code
@@ -81,6 +83,31 @@ func TestCreateInstalledPackage(t *testing.T) {
 				Namespace: "default",
 			},
 		},
+		{
+			name: "returns invalid if the values do not match the chart values schema",
+			releaseStub: releaseStub{
+				chartID:       "bitnami/apache",
+				latestVersion: "1.18.3",
+			},
+			chartSchema: `{"properties": {"foo": {"type": "integer"}}}`,
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.3",
+				},
+				Values: "{\"foo\": \"bar\"}",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
 		{
 			name: "returns invalid if available package ref invalid",
 			request: &corev1.CreateInstalledPackageRequest{
@@ -114,6 +141,9 @@ func TestCreateInstalledPackage(t *testing.T) {
 				},
 			})
 			defer cleanup()
+			if tc.chartSchema != "" {
+				server.chartClientFactory = &fake.ChartClientFactory{Schema: []byte(tc.chartSchema)}
+			}
 			populateAssetDB(t, mockDB, []releaseStub{tc.releaseStub})
 
 			response, err := server.CreateInstalledPackage(context.Background(), tc.request)
@@ -46,6 +46,7 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 					},
 					Identifier: identifier,
 				},
+				IncludeManifest: true,
 			},
 		}
 		if response {
@@ -55,6 +56,7 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 					Namespace: "default",
 				},
 				ResourceRefs: resourcerefstest.TestCases1[tc].ExpectedResourceRefs,
+				Manifest:     resourcerefstest.TestCases1[tc].ExistingReleases[0].Manifest,
 			}
 		}
 		newCase.expectedStatusCode = code
@@ -78,6 +80,13 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 		newTestCase(10, "my-apache", true, codes.OK),
 	}
 
+	// The manifest is only returned when requested.
+	withoutManifest := newTestCase(0, "my-apache", true, codes.OK)
+	withoutManifest.baseTestCase.Name += " without the manifest"
+	withoutManifest.request.IncludeManifest = false
+	withoutManifest.expectedResponse.Manifest = ""
+	testCases = append(testCases, withoutManifest)
+
 	ignoredFields := cmpopts.IgnoreUnexported(
 		corev1.GetInstalledPackageResourceRefsResponse{},
 		corev1.ResourceRef{},
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
 )
 
+var _ pluginsv1alpha1.PluginCapabilitiesProvider = (*Server)(nil)
+
 // Set the pluginDetail once during a module init function so the single struct
 // can be used throughout the plugin.
 var (
@@ -34,7 +37,18 @@ func init() {
 // returning the server implementation.
 func RegisterWithGRPCServer(opts pluginsv1alpha1.GRPCPluginRegistrationOptions) (interface{}, error) {
 	svr := NewServer(opts.ConfigGetter, opts.ClustersConfig.KubeappsClusterName, opts.ClustersConfig.GlobalReposNamespace, opts.PluginConfigPath)
-	v1alpha1.RegisterHelmPackagesServiceServer(opts.Registrar, svr)
+	if opts.PluginConfigPath != "" {
+		autoUpgrade, err := parseAutoUpgradeConfig(opts.PluginConfigPath)
+		if err != nil {
+			return nil, err
+		}
+		if autoUpgrade.Enabled {
+			if err := startAutoUpgrader(svr, autoUpgrade, opts.Auditor); err != nil {
+				return nil, fmt.Errorf("unable to start upgrading releases automatically: %w", err)
+			}
+		}
+	}
+	v1alpha1.RegisterHelmPackagesServiceServer(opts.Registrar, helmPackagesServer{svr})
 	return svr, nil
 }
 
@@ -48,3 +62,13 @@ func RegisterHTTPHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux,
 func GetPluginDetail() *pluginsgrpcv1alpha1.Plugin {
 	return &pluginDetail
 }
+
+// GetPluginCapabilities returns the optional features supported by the plugin.
+func (s *Server) GetPluginCapabilities() *pluginsgrpcv1alpha1.PluginCapabilities {
+	return &pluginsgrpcv1alpha1.PluginCapabilities{
+		Rollback:      true,
+		MultiCluster:  true,
+		ValuesSchema:  true,
+		UpdatePreview: true,
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/vmware-tanzu/kubeapps/cmd/assetsvc/pkg/utils"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// postgresQueryDuration is the duration of the queries run against the
+// assets database. The plugin is loaded into the kubeapps-apis process, so
+// it is exposed on the same /metrics endpoint.
+var postgresQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "kubeapps_apis",
+	Subsystem: "helm",
+	Name:      "postgres_query_duration_seconds",
+	Help:      "Duration of the queries run by the helm plugin against the assets database.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"query", "status"})
+
+// instrumentedAssetManager is an AssetManager recording the duration of each
+// query run by the wrapped manager and, when bound to the context of a traced
+// request with withContext, a span for each query.
+type instrumentedAssetManager struct {
+	utils.AssetManager
+	ctx    context.Context
+	tracer trace.Tracer
+}
+
+func newInstrumentedAssetManager(manager utils.AssetManager) *instrumentedAssetManager {
+	return &instrumentedAssetManager{
+		AssetManager: manager,
+		ctx:          context.Background(),
+		tracer:       otel.Tracer("github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1"),
+	}
+}
+
+// withContext returns a copy of the manager whose queries are traced as part
+// of the request context.
+func (m *instrumentedAssetManager) withContext(ctx context.Context) utils.AssetManager {
+	bound := *m
+	bound.ctx = ctx
+	return &bound
+}
+
+func (m *instrumentedAssetManager) observe(query string, run func() error) {
+	var span trace.Span
+	if trace.SpanFromContext(m.ctx).IsRecording() {
+		_, span = m.tracer.Start(m.ctx, "postgres "+query,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationKey.String(query)),
+		)
+	}
+
+	start := time.Now()
+	err := run()
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	postgresQueryDuration.WithLabelValues(query, status).Observe(time.Since(start).Seconds())
+
+	if span != nil {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+func (m *instrumentedAssetManager) GetChart(namespace, chartID string) (chart models.Chart, err error) {
+	m.observe("GetChart", func() error {
+		chart, err = m.AssetManager.GetChart(namespace, chartID)
+		return err
+	})
+	return chart, err
+}
+
+func (m *instrumentedAssetManager) GetChartVersion(namespace, chartID, version string) (chart models.Chart, err error) {
+	m.observe("GetChartVersion", func() error {
+		chart, err = m.AssetManager.GetChartVersion(namespace, chartID, version)
+		return err
+	})
+	return chart, err
+}
+
+func (m *instrumentedAssetManager) GetChartFiles(namespace, filesID string) (files models.ChartFiles, err error) {
+	m.observe("GetChartFiles", func() error {
+		files, err = m.AssetManager.GetChartFiles(namespace, filesID)
+		return err
+	})
+	return files, err
+}
+
+func (m *instrumentedAssetManager) GetPaginatedChartListWithFilters(cq utils.ChartQuery, startItemNumber, pageSize int) (charts []*models.Chart, err error) {
+	m.observe("GetPaginatedChartListWithFilters", func() error {
+		charts, err = m.AssetManager.GetPaginatedChartListWithFilters(cq, startItemNumber, pageSize)
+		return err
+	})
+	return charts, err
+}
+
+func (m *instrumentedAssetManager) GetAllChartCategories(cq utils.ChartQuery) (categories []*models.ChartCategory, err error) {
+	m.observe("GetAllChartCategories", func() error {
+		categories, err = m.AssetManager.GetAllChartCategories(cq)
+		return err
+	})
+	return categories, err
+}
+
+func (m *instrumentedAssetManager) GetAllChartRepos(cq utils.ChartQuery) (repos []*models.ChartCategory, err error) {
+	m.observe("GetAllChartRepos", func() error {
+		repos, err = m.AssetManager.GetAllChartRepos(cq)
+		return err
+	})
+	return repos, err
+}
+
+func (m *instrumentedAssetManager) GetPaginatedChartListWithFacets(cq utils.ChartQuery, startItemNumber, pageSize int) (charts []*models.Chart, facets utils.ChartFacets, err error) {
+	m.observe("GetPaginatedChartListWithFacets", func() error {
+		charts, facets, err = m.AssetManager.GetPaginatedChartListWithFacets(cq, startItemNumber, pageSize)
+		return err
+	})
+	return charts, facets, err
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func queryCount(t *testing.T, query, status string) uint64 {
+	m := &dto.Metric{}
+	if err := postgresQueryDuration.WithLabelValues(query, status).(prometheus.Histogram).Write(m); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestInstrumentedAssetManagerObservesQueries(t *testing.T) {
+	mock, cleanup, manager := setMockManager(t)
+	defer cleanup()
+	manager = newInstrumentedAssetManager(manager)
+
+	okBefore, errorBefore := queryCount(t, "GetChart", "ok"), queryCount(t, "GetChart", "error")
+
+	mock.ExpectQuery("SELECT info FROM charts").
+		WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(`{"ID": "repo-1/chart-1"}`))
+	if _, err := manager.GetChart(globalPackagingNamespace, "repo-1/chart-1"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	mock.ExpectQuery("SELECT info FROM charts").
+		WillReturnError(fmt.Errorf("boom"))
+	if _, err := manager.GetChart(globalPackagingNamespace, "repo-1/chart-2"); err == nil {
+		t.Fatalf("got: nil, want: error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := queryCount(t, "GetChart", "ok"), okBefore+1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+	if got, want := queryCount(t, "GetChart", "error"), errorBefore+1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
+
+func TestInstrumentedAssetManagerTracesQueries(t *testing.T) {
+	mock, cleanup, manager := setMockManager(t)
+	defer cleanup()
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	instrumented := newInstrumentedAssetManager(manager)
+	instrumented.tracer = tracerProvider.Tracer("test")
+
+	mock.ExpectQuery("SELECT info FROM charts").
+		WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(`{"ID": "repo-1/chart-1"}`))
+	mock.ExpectQuery("SELECT info FROM charts").
+		WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(`{"ID": "repo-1/chart-1"}`))
+
+	// Queries which are not part of a traced request are not traced.
+	if _, err := instrumented.GetChart(globalPackagingNamespace, "repo-1/chart-1"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	ctx, requestSpan := tracerProvider.Tracer("test").Start(context.Background(), "request")
+	if _, err := instrumented.withContext(ctx).GetChart(globalPackagingNamespace, "repo-1/chart-1"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	requestSpan.End()
+
+	names := []string{}
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+		if span.Name() == "postgres GetChart" {
+			if got, want := span.Parent().SpanID(), requestSpan.SpanContext().SpanID(); got != want {
+				t.Errorf("got parent: %s, want: %s", got, want)
+			}
+		}
+	}
+	if got, want := names, []string{"postgres GetChart", "request"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
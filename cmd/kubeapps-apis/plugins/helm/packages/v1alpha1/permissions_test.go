@@ -0,0 +1,171 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/fake"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCheckInstalledPackagePermissions(t *testing.T) {
+	templates := []*chart.File{
+		{
+			Name: "templates/configmap.yaml",
+			Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: apache-config\n"),
+		},
+		{
+			Name: "templates/deployment.yaml",
+			Data: []byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: apache\n"),
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.CheckInstalledPackagePermissionsRequest
+		expectedResponse   *corev1.CheckInstalledPackagePermissionsResponse
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "returns the actions forbidden to create a release",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+			},
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: []*corev1.ForbiddenAction{
+					{ApiVersion: "apps/v1", Resource: "deployments", Namespace: "default", Verbs: []string{"create"}},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns the actions forbidden to upgrade an existing release",
+			existingReleases: []releaseStub{
+				{
+					name:           "my-apache",
+					namespace:      "default",
+					chartID:        "bitnami/apache",
+					chartVersion:   "1.18.3",
+					chartNamespace: globalPackagingNamespace,
+					status:         release.StatusDeployed,
+					version:        1,
+					manifest:       "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: apache-config\n",
+				},
+			},
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+			},
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: []*corev1.ForbiddenAction{
+					{ApiVersion: "apps/v1", Resource: "deployments", Namespace: "default", Verbs: []string{"create", "update", "delete"}},
+					{ApiVersion: "v1", Resource: "configmaps", Namespace: "default", Verbs: []string{"delete"}},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns invalid argument when checking a creation without an available package ref",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-yet-installed",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		corev1.CheckInstalledPackagePermissionsResponse{},
+		corev1.ForbiddenAction{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases, nil)
+			server, mockDB, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+			server.chartClientFactory = &fake.ChartClientFactory{Templates: templates}
+			populateAssetDB(t, mockDB, tc.existingReleases)
+			if tc.expectedResponse != nil {
+				populateAssetForTarball(t, mockDB, "bitnami%apache", globalPackagingNamespace, tc.request.GetPkgVersionReference().GetVersion())
+			}
+
+			// The user can only create and update configmaps.
+			typedClient, _, err := server.GetClients(context.Background(), "default")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			clientSet := typedClient.(*typfake.Clientset)
+			clientSet.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+				{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}}},
+				{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}}},
+			}
+			clientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				attrs := review.Spec.ResourceAttributes
+				review.Status.Allowed = attrs.Resource == "configmaps" && attrs.Verb != "delete"
+				return true, review, nil
+			})
+
+			response, err := server.CheckInstalledPackagePermissions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/fake"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetInstalledPackageUpdatePreview(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		templates          []*chart.File
+		request            *corev1.GetInstalledPackageUpdatePreviewRequest
+		expectedResponse   *corev1.GetInstalledPackageUpdatePreviewResponse
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "returns the resources removed by an upgrade of an existing release",
+			existingReleases: []releaseStub{
+				{
+					name:           "my-apache",
+					namespace:      "default",
+					chartID:        "bitnami/apache",
+					chartVersion:   "1.18.3",
+					chartNamespace: globalPackagingNamespace,
+					status:         release.StatusDeployed,
+					version:        1,
+					manifest:       "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: apache-config\n",
+				},
+			},
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageUpdatePreviewResponse{
+				ResourceChanges: []*corev1.ResourceChange{
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "apache-config",
+							Namespace:  "default",
+						},
+						ChangeType: corev1.ResourceChange_CHANGE_TYPE_DELETED,
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns the resources created, changed and deleted by an upgrade of an existing release",
+			existingReleases: []releaseStub{
+				{
+					name:           "my-apache",
+					namespace:      "default",
+					chartID:        "bitnami/apache",
+					chartVersion:   "1.18.3",
+					chartNamespace: globalPackagingNamespace,
+					status:         release.StatusDeployed,
+					version:        1,
+					manifest: "---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: apache-credentials\n" +
+						"---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: apache-config\ndata:\n  port: \"80\"\n",
+				},
+			},
+			templates: []*chart.File{
+				{
+					Name: "templates/configmap.yaml",
+					Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: apache-config\ndata:\n  port: \"8080\"\n"),
+				},
+				{
+					Name: "templates/service.yaml",
+					Data: []byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: apache\n"),
+				},
+			},
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageUpdatePreviewResponse{
+				ResourceChanges: []*corev1.ResourceChange{
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "apache-config",
+							Namespace:  "default",
+						},
+						ChangeType: corev1.ResourceChange_CHANGE_TYPE_CHANGED,
+					},
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "Service",
+							Name:       "apache",
+							Namespace:  "default",
+						},
+						ChangeType: corev1.ResourceChange_CHANGE_TYPE_CREATED,
+					},
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "Secret",
+							Name:       "apache-credentials",
+							Namespace:  "default",
+						},
+						ChangeType: corev1.ResourceChange_CHANGE_TYPE_DELETED,
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns the preview of the creation of a release",
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageUpdatePreviewResponse{
+				ResourceChanges: []*corev1.ResourceChange{},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns invalid argument when previewing a creation without an available package ref",
+			request: &corev1.GetInstalledPackageUpdatePreviewRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-yet-installed",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredFields := []cmp.Option{
+		cmpopts.IgnoreUnexported(
+			corev1.GetInstalledPackageUpdatePreviewResponse{},
+			corev1.ResourceChange{},
+			corev1.ResourceRef{},
+		),
+		cmpopts.IgnoreFields(corev1.ResourceChange{}, "CurrentManifest", "TargetManifest", "Diff"),
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases, nil)
+			server, mockDB, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+			server.chartClientFactory = &fake.ChartClientFactory{Templates: tc.templates}
+			populateAssetDB(t, mockDB, tc.existingReleases)
+			if tc.expectedResponse != nil {
+				populateAssetForTarball(t, mockDB, "bitnami%apache", globalPackagingNamespace, tc.request.GetPkgVersionReference().GetVersion())
+			}
+
+			response, err := server.GetInstalledPackageUpdatePreview(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoredFields...) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredFields...))
+			}
+
+			// A preview must never change the stored releases.
+			releases, err := actionConfig.Releases.Driver.List(func(*release.Release) bool { return true })
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(releases), len(tc.existingReleases); got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
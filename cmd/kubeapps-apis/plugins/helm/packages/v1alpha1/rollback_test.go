@@ -24,8 +24,8 @@ func TestRollbackInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name               string
 		existingReleases   []releaseStub
-		request            *helmv1.RollbackInstalledPackageRequest
-		expectedResponse   *helmv1.RollbackInstalledPackageResponse
+		request            *corev1.RollbackInstalledPackageRequest
+		expectedResponse   *corev1.RollbackInstalledPackageResponse
 		expectedStatusCode codes.Code
 		expectedRelease    *release.Release
 	}{
@@ -51,7 +51,7 @@ func TestRollbackInstalledPackage(t *testing.T) {
 					version:        1,
 				},
 			},
-			request: &helmv1.RollbackInstalledPackageRequest{
+			request: &corev1.RollbackInstalledPackageRequest{
 				InstalledPackageRef: &corev1.InstalledPackageReference{
 					Context: &corev1.Context{
 						Cluster:   "default",
@@ -59,9 +59,9 @@ func TestRollbackInstalledPackage(t *testing.T) {
 					},
 					Identifier: "my-apache",
 				},
-				ReleaseRevision: 1,
+				Revision: 1,
 			},
-			expectedResponse: &helmv1.RollbackInstalledPackageResponse{
+			expectedResponse: &corev1.RollbackInstalledPackageResponse{
 				InstalledPackageRef: &corev1.InstalledPackageReference{
 					Context: &corev1.Context{
 						Cluster:   "default",
@@ -92,20 +92,33 @@ func TestRollbackInstalledPackage(t *testing.T) {
 		},
 		{
 			name: "returns not found if installed package doesn't exist",
-			request: &helmv1.RollbackInstalledPackageRequest{
+			request: &corev1.RollbackInstalledPackageRequest{
 				InstalledPackageRef: &corev1.InstalledPackageReference{
 					Context: &corev1.Context{
 						Namespace: "default",
 					},
 					Identifier: "not-a-valid-identifier",
 				},
+				Revision: 1,
 			},
 			expectedStatusCode: codes.NotFound,
 		},
+		{
+			name: "returns invalid argument if the revision is not positive",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
 	}
 
 	ignoredUnexported := cmpopts.IgnoreUnexported(
-		helmv1.RollbackInstalledPackageResponse{},
+		corev1.RollbackInstalledPackageResponse{},
 		corev1.InstalledPackageReference{},
 		corev1.Context{},
 		plugins.Plugin{},
@@ -157,3 +170,185 @@ func TestRollbackInstalledPackage(t *testing.T) {
 		})
 	}
 }
+
+// The deprecated helm specific RPC is served with the release revision of the
+// request.
+func TestHelmPackagesServerRollbackInstalledPackage(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:           "my-apache",
+			namespace:      "default",
+			chartID:        "bitnami/apache",
+			chartVersion:   "1.18.3",
+			chartNamespace: globalPackagingNamespace,
+			status:         release.StatusDeployed,
+			version:        2,
+		},
+		{
+			name:           "my-apache",
+			namespace:      "default",
+			chartID:        "bitnami/apache",
+			chartVersion:   "1.18.2",
+			chartNamespace: globalPackagingNamespace,
+			status:         release.StatusSuperseded,
+			version:        1,
+		},
+	}
+	installedPackageRef := &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Cluster:   "default",
+			Namespace: "default",
+		},
+		Identifier: "my-apache",
+	}
+	actionConfig := newActionConfigFixture(t, "default", existingReleases, nil)
+	server, _, cleanup := makeServer(t, true, actionConfig, &v1alpha1.AppRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bitnami",
+			Namespace: globalPackagingNamespace,
+		},
+	})
+	defer cleanup()
+
+	response, err := helmPackagesServer{server}.RollbackInstalledPackage(context.Background(), &helmv1.RollbackInstalledPackageRequest{
+		InstalledPackageRef: installedPackageRef,
+		ReleaseRevision:     1,
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	expectedResponse := &helmv1.RollbackInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    installedPackageRef.Context,
+			Identifier: "my-apache",
+			Plugin:     GetPluginDetail(),
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		helmv1.RollbackInstalledPackageResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		plugins.Plugin{},
+	)
+	if got, want := response, expectedResponse; !cmp.Equal(got, want, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	rel, err := actionConfig.Releases.Last("my-apache")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := rel.Chart.Metadata.Version, "1.18.2"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.GetInstalledPackageRevisionsRequest
+		expectedResponse   *corev1.GetInstalledPackageRevisionsResponse
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "returns the revisions of the installed package, most recent first",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartVersion: "1.18.2",
+					status:       release.StatusSuperseded,
+					version:      1,
+					values:       `{"replicaCount": 2}`,
+				},
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      2,
+				},
+			},
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*corev1.InstalledPackageRevision{
+					{
+						Revision: 2,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.3",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      true,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+							UserReason: "deployed",
+						},
+						ValuesApplied: "{}",
+					},
+					{
+						Revision: 1,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.2",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+							UserReason: "superseded",
+						},
+						ValuesApplied: `{"replicaCount":2}`,
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		corev1.GetInstalledPackageRevisionsResponse{},
+		corev1.InstalledPackageRevision{},
+		corev1.PackageAppVersion{},
+		corev1.InstalledPackageStatus{},
+	)
+	// The fixture releases are created without a deployment time.
+	ignoredFields := cmpopts.IgnoreFields(corev1.InstalledPackageRevision{}, "Updated")
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases, nil)
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported, ignoredFields) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, ignoredFields))
+			}
+		})
+	}
+}
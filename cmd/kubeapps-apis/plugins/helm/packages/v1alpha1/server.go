@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,16 +21,24 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/manifestdiff"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/permissions"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/releasehistory"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/summaries"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/valuesschema"
 	"github.com/vmware-tanzu/kubeapps/pkg/agent"
+	"github.com/vmware-tanzu/kubeapps/pkg/auth"
 	chartutils "github.com/vmware-tanzu/kubeapps/pkg/chart"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"github.com/vmware-tanzu/kubeapps/pkg/dbutils"
 	"github.com/vmware-tanzu/kubeapps/pkg/handlerutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
@@ -37,10 +46,12 @@ import (
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corek8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	log "k8s.io/klog/v2"
@@ -141,7 +152,7 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster
 		log.Infof("+helm using default config since pluginConfigPath is empty")
 	}
 
-	return &Server{
+	s := &Server{
 		clientGetter: clientgetter.NewClientGetter(configGetter, clientgetter.Options{}),
 		actionConfigGetter: func(ctx context.Context, pkgContext *corev1.Context) (*action.Configuration, error) {
 			cluster := pkgContext.GetCluster()
@@ -153,7 +164,7 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster
 			fn := clientgetter.NewHelmActionConfigGetter(configGetter, cluster)
 			return fn(ctx, pkgContext.GetNamespace())
 		},
-		manager:                  manager,
+		manager:                  newInstrumentedAssetManager(manager),
 		globalPackagingNamespace: globalReposNamespace,
 		globalPackagingCluster:   globalPackagingCluster,
 		chartClientFactory:       &chartutils.ChartClientFactory{},
@@ -161,6 +172,8 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster
 		timeoutSeconds:           timeoutSeconds,
 		createReleaseFunc:        agent.CreateRelease,
 	}
+
+	return s
 }
 
 // GetClients ensures a client getter is available and uses it to return both a typed and dynamic k8s client.
@@ -185,6 +198,15 @@ func (s *Server) GetClients(ctx context.Context, cluster string) (kubernetes.Int
 	return typedClient, dynamicClient, nil
 }
 
+// assetManager returns the manager to be used for the queries of the
+// request, so that they are traced as part of the request when instrumented.
+func (s *Server) assetManager(ctx context.Context) utils.AssetManager {
+	if m, ok := s.manager.(*instrumentedAssetManager); ok {
+		return m.withContext(ctx)
+	}
+	return s.manager
+}
+
 // GetManager ensures a manager is available and returns it.
 func (s *Server) GetManager() (utils.AssetManager, error) {
 	if s.manager == nil {
@@ -229,8 +251,9 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		cq.Categories = request.FilterOptions.Categories
 		cq.SearchQuery = request.FilterOptions.Query
 		cq.Repos = request.FilterOptions.Repositories
-		cq.Version = request.FilterOptions.PkgVersion
-		cq.AppVersion = request.FilterOptions.AppVersion
+		cq.VersionConstraint = request.FilterOptions.PkgVersion
+		cq.AppVersionConstraint = request.FilterOptions.AppVersion
+		cq.OrderBy = chartOrderBy(request.FilterOptions.SortBy)
 	}
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
@@ -241,7 +264,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 
 	// This plugin will include, as part of the GetAvailablePackageSummariesResponse,
 	// a "Categories" field containing only the distinct category names considering just the namespace
-	chartCategories, err := s.manager.GetAllChartCategories(utils.ChartQuery{Namespace: namespace})
+	chartCategories, err := s.assetManager(ctx).GetAllChartCategories(utils.ChartQuery{Namespace: namespace})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to fetch chart categories: %v", err)
 	}
@@ -251,9 +274,23 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		categories = append(categories, cat.Name)
 	}
 
-	charts, err := s.manager.GetPaginatedChartListWithFilters(cq, itemOffset, int(pageSize))
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to retrieve charts: %v", err)
+	// The facets are only computed for the first page, as they are the same
+	// for every page of the same filter. They are retrieved together with the
+	// charts so that the charts are loaded only once.
+	var charts []*models.Chart
+	var facets *corev1.AvailablePackageFacets
+	if itemOffset == 0 {
+		var chartFacets utils.ChartFacets
+		charts, chartFacets, err = s.assetManager(ctx).GetPaginatedChartListWithFacets(cq, itemOffset, int(pageSize))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to retrieve charts: %v", err)
+		}
+		facets = availablePackageFacets(chartFacets)
+	} else {
+		charts, err = s.assetManager(ctx).GetPaginatedChartListWithFilters(cq, itemOffset, int(pageSize))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to retrieve charts: %v", err)
+		}
 	}
 
 	// Convert the charts response into a GetAvailablePackageSummariesResponse
@@ -278,9 +315,44 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		AvailablePackageSummaries: responsePackages,
 		NextPageToken:             nextPageToken,
 		Categories:                categories,
+		Facets:                    facets,
 	}, nil
 }
 
+// chartOrderBy returns the order of the chart list for the requested sort option.
+func chartOrderBy(sortBy corev1.FilterOptions_SortBy) string {
+	switch sortBy {
+	case corev1.FilterOptions_SORT_BY_LAST_UPDATED:
+		return utils.OrderByLastUpdated
+	case corev1.FilterOptions_SORT_BY_REPOSITORY:
+		return utils.OrderByRepo
+	default:
+		return utils.OrderByName
+	}
+}
+
+// availablePackageFacets returns the facets of the available packages from
+// the number of charts matching the query for each category and repository.
+func availablePackageFacets(chartFacets utils.ChartFacets) *corev1.AvailablePackageFacets {
+	categories := map[string]int32{}
+	for _, cat := range chartFacets.Categories {
+		if cat.Name != "" {
+			categories[cat.Name] = int32(cat.Count)
+		}
+	}
+	repos := map[string]int32{}
+	total := int32(0)
+	for _, repo := range chartFacets.Repos {
+		repos[repo.Name] = int32(repo.Count)
+		total += int32(repo.Count)
+	}
+	return &corev1.AvailablePackageFacets{
+		Categories:   summaries.FacetCounts(categories),
+		Repositories: summaries.FacetCounts(repos),
+		Plugins:      summaries.FacetCounts(map[string]int32{GetPluginDetail().GetName(): total}),
+	}
+}
+
 // GetAvailablePackageDetail returns the package metadata managed by the 'helm' plugin
 func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.GetAvailablePackageDetailRequest) (*corev1.GetAvailablePackageDetailResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
@@ -315,13 +387,13 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 	var chart models.Chart
 	if version == "" {
 		log.Infof("Requesting chart '%s' (latest version) in ns '%s'", unescapedChartID, namespace)
-		chart, err = s.manager.GetChart(namespace, unescapedChartID)
+		chart, err = s.assetManager(ctx).GetChart(namespace, unescapedChartID)
 	} else {
 		log.Infof("Requesting chart '%s' (version %s) in ns '%s'", unescapedChartID, version, namespace)
-		chart, err = s.manager.GetChartVersion(namespace, unescapedChartID, version)
+		chart, err = s.assetManager(ctx).GetChartVersion(namespace, unescapedChartID, version)
 	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to retrieve chart: %v", err)
+		return nil, chartError(unescapedChartID, err)
 	}
 
 	if len(chart.ChartVersions) == 0 {
@@ -331,7 +403,7 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 		version = chart.ChartVersions[0].Version
 	}
 	fileID := fileIDForChart(unescapedChartID, chart.ChartVersions[0].Version)
-	chartFiles, err := s.manager.GetChartFiles(namespace, fileID)
+	chartFiles, err := s.assetManager(ctx).GetChartFiles(namespace, fileID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to retrieve chart files: %v", err)
 	}
@@ -351,6 +423,15 @@ func fileIDForChart(id, version string) string {
 	return fmt.Sprintf("%s-%s", id, version)
 }
 
+// chartError returns the error for a chart which could not be retrieved from
+// the assets database.
+func chartError(chartID string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "Chart", chartID, "Unable to find the chart %q", chartID)
+	}
+	return status.Errorf(codes.Internal, "Unable to retrieve chart: %v", err)
+}
+
 // GetAvailablePackageVersions returns the package versions managed by the 'helm' plugin
 func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev1.GetAvailablePackageVersionsRequest) (*corev1.GetAvailablePackageVersionsResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetAvailablePackageRef().GetContext().GetCluster(), request.GetAvailablePackageRef().GetContext().GetNamespace())
@@ -377,9 +458,9 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 	}
 
 	log.Infof("Requesting chart '%s' (latest version) in ns '%s'", unescapedChartID, namespace)
-	chart, err := s.manager.GetChart(namespace, unescapedChartID)
+	chart, err := s.assetManager(ctx).GetChart(namespace, unescapedChartID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to retrieve chart: %v", err)
+		return nil, chartError(unescapedChartID, err)
 	}
 
 	return &corev1.GetAvailablePackageVersionsResponse{
@@ -472,17 +553,33 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
+	filter := request.GetFilterOptions()
+	if !pkgutils.PluginMatchesInstalledPackageFilter(GetPluginDetail(), filter) {
+		return &corev1.GetInstalledPackageSummariesResponse{
+			InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
+		}, nil
+	}
+
 	cmd := action.NewList(actionConfig)
 	if request.GetContext().GetNamespace() == "" {
 		cmd.AllNamespaces = true
 	}
 
-	cmd.Limit = int(request.GetPaginationOptions().GetPageSize())
-	cmd.Offset, err = paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
+	pageSize := int(request.GetPaginationOptions().GetPageSize())
+	itemOffset, err := paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 
+	// The filters depend on the status and latest version of each release,
+	// as well as the repository of its chart, so when filtering, all releases
+	// are listed and the pagination is applied to the matching releases.
+	filtered := !pkgutils.IsInstalledPackageFilterEmpty(filter)
+	if !filtered {
+		cmd.Limit = pageSize
+		cmd.Offset = itemOffset
+	}
+
 	// TODO(mnelson): Check whether we need to support ListAll (status == "all" in existing helm support)
 
 	releases, err := cmd.Run()
@@ -490,63 +587,95 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		return nil, status.Errorf(codes.Internal, "Unable to run Helm List action: %v", err)
 	}
 
-	installedPkgSummaries := make([]*corev1.InstalledPackageSummary, len(releases))
+	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
 	cluster := request.GetContext().GetCluster()
 	if cluster == "" {
 		cluster = s.globalPackagingCluster
 	}
-	for i, r := range releases {
-		installedPkgSummaries[i] = installedPkgSummaryFromRelease(r)
-		installedPkgSummaries[i].InstalledPackageRef.Context.Cluster = cluster
-	}
-
 	// Fill in the latest package version for each.
 	// TODO(mnelson): Update to do this with a single query rather than iterating and
 	// querying per release.
-	for i, rel := range releases {
-		// Helm does not store a back-reference to the chart used to create a
-		// release (https://github.com/helm/helm/issues/6464), so for each
-		// release, we look up a chart with than name and version available in
-		// the release namespace, and if one is found, pull out the latest chart
-		// version.
-		cq := utils.ChartQuery{
-			Namespace:  rel.Namespace,
-			ChartName:  rel.Chart.Metadata.Name,
-			Version:    rel.Chart.Metadata.Version,
-			AppVersion: rel.Chart.Metadata.AppVersion,
-		}
-		charts, err := s.manager.GetPaginatedChartListWithFilters(cq, 0, 0)
+	for _, r := range releases {
+		installedPkgSummary, repoName, err := s.installedPkgSummaryWithLatestVersion(ctx, r, cluster)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Error while fetching related charts: %v", err)
-		}
-		// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
-		// Example: global + namespaced repo including an overlapping subset of packages.
-		if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
-			installedPkgSummaries[i].LatestVersion = &corev1.PackageAppVersion{
-				PkgVersion: charts[0].ChartVersions[0].Version,
-				AppVersion: charts[0].ChartVersions[0].AppVersion,
-			}
-		}
-		installedPkgSummaries[i].Status = &corev1.InstalledPackageStatus{
-			Ready:      rel.Info.Status == release.StatusDeployed,
-			Reason:     statusReasonForHelmStatus(rel.Info.Status),
-			UserReason: rel.Info.Status.String(),
+			return nil, err
 		}
-		installedPkgSummaries[i].CurrentVersion = &corev1.PackageAppVersion{
-			PkgVersion: rel.Chart.Metadata.Version,
-			AppVersion: rel.Chart.Metadata.AppVersion,
+		if filtered && !pkgutils.InstalledPackageSummaryMatchesFilter(installedPkgSummary, GetPluginDetail(), repoName, filter) {
+			continue
 		}
+		installedPkgSummaries = append(installedPkgSummaries, installedPkgSummary)
 	}
 
 	response := &corev1.GetInstalledPackageSummariesResponse{
 		InstalledPackageSummaries: installedPkgSummaries,
 	}
-	if cmd.Limit > 0 && len(releases) == cmd.Limit {
-		response.NextPageToken = fmt.Sprintf("%d", cmd.Offset+cmd.Limit)
+	if !filtered {
+		if cmd.Limit > 0 && len(releases) == cmd.Limit {
+			response.NextPageToken = fmt.Sprintf("%d", cmd.Offset+cmd.Limit)
+		}
+		return response, nil
+	}
+
+	if pageSize > 0 {
+		if itemOffset > len(installedPkgSummaries) {
+			itemOffset = len(installedPkgSummaries)
+		}
+		installedPkgSummaries = installedPkgSummaries[itemOffset:]
+		if len(installedPkgSummaries) > pageSize {
+			installedPkgSummaries = installedPkgSummaries[:pageSize]
+			response.NextPageToken = fmt.Sprintf("%d", itemOffset+pageSize)
+		}
+		response.InstalledPackageSummaries = installedPkgSummaries
 	}
 	return response, nil
 }
 
+// installedPkgSummaryWithLatestVersion returns the installed package summary for
+// the release, including the status and the latest package version available,
+// together with the name of the repository of the matching chart, if any.
+func (s *Server) installedPkgSummaryWithLatestVersion(ctx context.Context, rel *release.Release, cluster string) (*corev1.InstalledPackageSummary, string, error) {
+	installedPkgSummary := installedPkgSummaryFromRelease(rel)
+	installedPkgSummary.InstalledPackageRef.Context.Cluster = cluster
+
+	// Helm does not store a back-reference to the chart used to create a
+	// release (https://github.com/helm/helm/issues/6464), so for each
+	// release, we look up a chart with than name and version available in
+	// the release namespace, and if one is found, pull out the latest chart
+	// version.
+	cq := utils.ChartQuery{
+		Namespace:  rel.Namespace,
+		ChartName:  rel.Chart.Metadata.Name,
+		Version:    rel.Chart.Metadata.Version,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}
+	charts, err := s.assetManager(ctx).GetPaginatedChartListWithFilters(cq, 0, 0)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Error while fetching related charts: %v", err)
+	}
+	// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
+	// Example: global + namespaced repo including an overlapping subset of packages.
+	repoName := ""
+	if len(charts) > 0 && charts[0].Repo != nil {
+		repoName = charts[0].Repo.Name
+	}
+	if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
+		installedPkgSummary.LatestVersion = &corev1.PackageAppVersion{
+			PkgVersion: charts[0].ChartVersions[0].Version,
+			AppVersion: charts[0].ChartVersions[0].AppVersion,
+		}
+	}
+	installedPkgSummary.Status = &corev1.InstalledPackageStatus{
+		Ready:      rel.Info.Status == release.StatusDeployed,
+		Reason:     statusReasonForHelmStatus(rel.Info.Status),
+		UserReason: rel.Info.Status.String(),
+	}
+	installedPkgSummary.CurrentVersion = &corev1.PackageAppVersion{
+		PkgVersion: rel.Chart.Metadata.Version,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}
+	return installedPkgSummary, repoName, nil
+}
+
 func statusReasonForHelmStatus(s release.Status) corev1.InstalledPackageStatus_StatusReason {
 	switch s {
 	case release.StatusDeployed:
@@ -582,6 +711,127 @@ func installedPkgSummaryFromRelease(r *release.Release) *corev1.InstalledPackage
 	}
 }
 
+// helmReleaseSecretsSelector selects the secrets used by the Helm storage
+// driver to store each revision of a release.
+const helmReleaseSecretsSelector = "owner=helm"
+
+// WatchInstalledPackageSummaries streams the changes to the installed packages
+// managed by the 'helm' plugin. Helm stores each revision of a release in a
+// secret, so the release secrets are watched and, for each change, the summary
+// of the latest revision of the corresponding release is sent.
+func (s *Server) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetContext().GetCluster(), request.GetContext().GetNamespace())
+	log.Infof("+helm WatchInstalledPackageSummaries %s", contextMsg)
+
+	ctx := stream.Context()
+	cluster := request.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	typedClient, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to get the k8s client: %v", err)
+	}
+	watcher, err := typedClient.CoreV1().Secrets(request.GetContext().GetNamespace()).Watch(ctx, metav1.ListOptions{
+		LabelSelector: helmReleaseSecretsSelector,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to watch the Helm release secrets: %v", err)
+	}
+	defer watcher.Stop()
+
+	// The summaries already sent are tracked per release so that the first
+	// revision of a release is sent as an addition, the removal of the last
+	// revision as a deletion, and the events for the several secrets written
+	// by Helm for a single change of a release are collapsed into one.
+	sentSummaries := map[types.NamespacedName]*corev1.InstalledPackageSummary{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return status.Errorf(codes.Internal, "Unable to watch the Helm release secrets: %v", k8serrors.FromObject(event.Object))
+			}
+			secret, ok := event.Object.(*corek8sv1.Secret)
+			if !ok {
+				continue
+			}
+			key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Labels["name"]}
+			response, err := s.installedPkgSummaryEventForRelease(ctx, cluster, key, sentSummaries[key])
+			if err != nil {
+				return err
+			}
+			if response == nil {
+				continue
+			}
+			if response.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				delete(sentSummaries, key)
+			} else {
+				sentSummaries[key] = response.InstalledPackageSummary
+			}
+			if err = stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// installedPkgSummaryEventForRelease returns the event to be sent for a change
+// to a revision of the release given the summary last sent for it, if any, or
+// nil if there is nothing to send.
+func (s *Server) installedPkgSummaryEventForRelease(ctx context.Context, cluster string, key types.NamespacedName, lastSent *corev1.InstalledPackageSummary) (*corev1.WatchInstalledPackageSummariesResponse, error) {
+	actionConfig, err := s.actionConfigGetter(ctx, &corev1.Context{Cluster: cluster, Namespace: key.Namespace})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	rel, err := agent.GetRelease(actionConfig, key.Name)
+	if err != nil {
+		if !errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.Internal, "Unable to get the release %q: %v", key, err)
+		}
+		if lastSent == nil {
+			return nil, nil
+		}
+		return &corev1.WatchInstalledPackageSummariesResponse{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   cluster,
+						Namespace: key.Namespace,
+					},
+					Identifier: key.Name,
+				},
+				Name: key.Name,
+			},
+		}, nil
+	}
+
+	installedPkgSummary, _, err := s.installedPkgSummaryWithLatestVersion(ctx, rel, cluster)
+	if err != nil {
+		return nil, err
+	}
+	eventType := corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED
+	if lastSent != nil {
+		// Helm writes the secret of a new revision and then updates it, as
+		// well as the secret of the previous revision, so most events do not
+		// change the summary of the release.
+		if proto.Equal(lastSent, installedPkgSummary) {
+			return nil, nil
+		}
+		eventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED
+	}
+	return &corev1.WatchInstalledPackageSummariesResponse{
+		EventType:               eventType,
+		InstalledPackageSummary: installedPkgSummary,
+	}, nil
+}
+
 // GetInstalledPackageDetail returns the package metadata managed by the 'helm' plugin
 func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", request.GetInstalledPackageRef().GetContext().GetCluster(), request.GetInstalledPackageRef().GetContext().GetNamespace())
@@ -600,7 +850,7 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 	release, err := getcmd.Run(identifier)
 	if err != nil {
 		if err == driver.ErrReleaseNotFound {
-			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", identifier, namespace, err)
+			return nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonResourceNotFound, "Release", identifier, "Unable to find Helm release %q in namespace %q: %+v", identifier, namespace, err)
 		}
 		return nil, status.Errorf(codes.Internal, "Unable to run Helm get action: %v", err)
 	}
@@ -628,7 +878,7 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 		Version:    release.Chart.Metadata.Version,
 		AppVersion: release.Chart.Metadata.AppVersion,
 	}
-	charts, err := s.manager.GetPaginatedChartListWithFilters(cq, 0, 0)
+	charts, err := s.assetManager(ctx).GetPaginatedChartListWithFilters(cq, 0, 0)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error while fetching related chart: %v", err)
 	}
@@ -709,7 +959,10 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	}
 	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, chartDetails, typedClient)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Missing permissions %v", err)
+		return nil, err
+	}
+	if err := valuesschema.Validate(request.GetValues(), ch.Values, ch.Schema); err != nil {
+		return nil, err
 	}
 
 	// Create an action config for the target namespace.
@@ -720,7 +973,7 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 
 	release, err := s.createReleaseFunc(actionConfig, request.GetName(), request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets, s.timeoutSeconds)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to create helm release %q in the namespace %q: %v", request.GetName(), request.GetTargetContext().GetNamespace(), err)
+		return nil, releaseActionError("create", request.GetName(), request.GetTargetContext().GetNamespace(), err)
 	}
 
 	cluster := request.GetTargetContext().GetCluster()
@@ -781,7 +1034,10 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	}
 	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, chartDetails, typedClient)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Missing permissions %v", err)
+		return nil, err
+	}
+	if err := valuesschema.Validate(request.GetValues(), ch.Values, ch.Schema); err != nil {
+		return nil, err
 	}
 
 	// Create an action config for the installed pkg context.
@@ -792,7 +1048,7 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 
 	release, err := agent.UpgradeRelease(actionConfig, releaseName, request.GetValues(), ch, registrySecrets, s.timeoutSeconds)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to upgrade helm release %q in the namespace %q: %v", releaseName, installedRef.GetContext().GetNamespace(), err)
+		return nil, releaseActionError("upgrade", releaseName, installedRef.GetContext().GetNamespace(), err)
 	}
 
 	cluster := installedRef.GetContext().GetCluster()
@@ -812,6 +1068,140 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	}, nil
 }
 
+// GetInstalledPackageUpdatePreview returns the changes to resources which would
+// result from installing or upgrading a release with the requested chart version
+// and values, without changing anything on the cluster.
+func (s *Server) GetInstalledPackageUpdatePreview(ctx context.Context, request *corev1.GetInstalledPackageUpdatePreviewRequest) (*corev1.GetInstalledPackageUpdatePreviewResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", installedRef.GetContext().GetCluster(), installedRef.GetContext().GetNamespace())
+	log.Infof("+helm GetInstalledPackageUpdatePreview %s", contextMsg)
+
+	currentManifest, targetRelease, err := s.renderRelease(ctx, installedRef, request.GetAvailablePackageRef(), request.GetPkgVersionReference(), request.GetValues())
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := manifestdiff.DiffManifests(currentManifest, targetRelease.Manifest, installedRef.GetContext().GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageUpdatePreviewResponse{
+		ResourceChanges: changes,
+	}, nil
+}
+
+// CheckInstalledPackagePermissions returns the actions on the resources of
+// the rendered release which the user is not allowed to perform.
+func (s *Server) CheckInstalledPackagePermissions(ctx context.Context, request *corev1.CheckInstalledPackagePermissionsRequest) (*corev1.CheckInstalledPackagePermissionsResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", installedRef.GetContext().GetCluster(), installedRef.GetContext().GetNamespace())
+	log.Infof("+helm CheckInstalledPackagePermissions %s", contextMsg)
+
+	currentManifest, targetRelease, err := s.renderRelease(ctx, installedRef, request.GetAvailablePackageRef(), request.GetPkgVersionReference(), request.GetValues())
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	typedClient, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create kubernetes clientset: %v", err)
+	}
+
+	// As with kubeops, upgrading a release requires permissions to create,
+	// update and delete its resources.
+	action := permissions.ActionCreate
+	if currentManifest != "" {
+		action = permissions.ActionUpgrade
+	}
+	forbiddenActions, err := permissions.ForbiddenActions(typedClient, installedRef.GetContext().GetNamespace(), action, targetRelease.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.CheckInstalledPackagePermissionsResponse{
+		ForbiddenActions: forbiddenActions,
+	}, nil
+}
+
+// renderRelease renders the release which would result from creating or
+// upgrading the installed package with the given chart version and values,
+// without changing anything on the cluster. The current manifest of the
+// release is returned too, which is empty if the release does not yet exist.
+func (s *Server) renderRelease(ctx context.Context, installedRef *corev1.InstalledPackageReference, availablePkgRef *corev1.AvailablePackageReference, versionRef *corev1.VersionReference, values string) (string, *release.Release, error) {
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+
+	// Create an action config for the installed pkg context.
+	actionConfig, err := s.actionConfigGetter(ctx, installedRef.GetContext())
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	// The release is upgraded if it already exists, otherwise it is created.
+	currentManifest := ""
+	releaseExists := true
+	existingRelease, err := agent.GetRelease(actionConfig, releaseName)
+	if err != nil {
+		if !errors.Is(err, driver.ErrReleaseNotFound) {
+			return "", nil, status.Errorf(codes.Internal, "Unable to run Helm get action: %v", err)
+		}
+		releaseExists = false
+	} else {
+		currentManifest = existingRelease.Manifest
+	}
+
+	if availablePkgRef == nil {
+		if !releaseExists {
+			return "", nil, status.Errorf(codes.InvalidArgument, "The available package ref is required to render the creation of %q in the namespace %q.", releaseName, namespace)
+		}
+		detailResponse, err := s.GetInstalledPackageDetail(ctx, &corev1.GetInstalledPackageDetailRequest{
+			InstalledPackageRef: installedRef,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		availablePkgRef = detailResponse.GetInstalledPackageDetail().GetAvailablePackageRef()
+		if availablePkgRef == nil {
+			return "", nil, status.Errorf(codes.FailedPrecondition, "Unable to find the available package used to deploy %q in the namespace %q.", releaseName, namespace)
+		}
+	}
+
+	typedClient, _, err := s.GetClients(ctx, s.globalPackagingCluster)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "Unable to create kubernetes clientset: %v", err)
+	}
+	repoName, chartName, err := pkgutils.SplitChartIdentifier(availablePkgRef.GetIdentifier())
+	if err != nil {
+		return "", nil, err
+	}
+	chartDetails := &chartutils.Details{
+		AppRepositoryResourceName:      repoName,
+		AppRepositoryResourceNamespace: availablePkgRef.GetContext().GetNamespace(),
+		ChartName:                      chartName,
+		Version:                        versionRef.GetVersion(),
+	}
+	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, chartDetails, typedClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var targetRelease *release.Release
+	if releaseExists {
+		targetRelease, err = agent.PreviewUpgradeRelease(actionConfig, releaseName, values, ch, registrySecrets)
+	} else {
+		targetRelease, err = agent.PreviewCreateRelease(actionConfig, releaseName, namespace, values, ch, registrySecrets)
+	}
+	if err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, "Unable to render helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+	return currentManifest, targetRelease, nil
+}
+
 // GetAppRepoAndRelatedSecrets retrieves the given repo from its namespace
 // Depending on the repo namespace and the
 func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, appRepoName, appRepoNamespace string) (*appRepov1.AppRepository, *corek8sv1.Secret, *corek8sv1.Secret, error) {
@@ -832,13 +1222,13 @@ func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, appRepoName, a
 	}
 	appRepoUnstructured, err := dynClient.Resource(gvr).Namespace(appRepoNamespace).Get(ctx, appRepoName, metav1.GetOptions{})
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("unable to get app repository %s/%s: %v", appRepoNamespace, appRepoName, err)
+		return nil, nil, nil, statuserror.FromK8sError("get", "AppRepository", appRepoName, err)
 	}
 
 	var appRepo appRepov1.AppRepository
 	err = runtime.DefaultUnstructuredConverter.FromUnstructured(appRepoUnstructured.UnstructuredContent(), &appRepo)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to convert unstructured AppRepository for %s/%s to a structured AppRepository: %v", appRepoNamespace, appRepoName, err)
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to convert unstructured AppRepository for %s/%s to a structured AppRepository: %v", appRepoNamespace, appRepoName, err)
 	}
 
 	auth := appRepo.Spec.Auth
@@ -847,7 +1237,7 @@ func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, appRepoName, a
 		secretName := auth.CustomCA.SecretKeyRef.Name
 		caCertSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to read secret %q: %v", auth.CustomCA.SecretKeyRef.Name, err)
+			return nil, nil, nil, statuserror.FromK8sError("get", "Secret", secretName, err)
 		}
 	}
 
@@ -856,7 +1246,7 @@ func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, appRepoName, a
 		secretName := auth.Header.SecretKeyRef.Name
 		authSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to read secret %q: %v", secretName, err)
+			return nil, nil, nil, statuserror.FromK8sError("get", "Secret", secretName, err)
 		}
 	}
 
@@ -870,7 +1260,7 @@ func (s *Server) fetchChartWithRegistrySecrets(ctx context.Context, chartDetails
 	// Most of the existing code that we want to reuse is based on having a typed AppRepository.
 	appRepo, caCertSecret, authSecret, err := s.getAppRepoAndRelatedSecrets(ctx, chartDetails.AppRepositoryResourceName, chartDetails.AppRepositoryResourceNamespace)
 	if err != nil {
-		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch app repo %q from namespace %q: %v", chartDetails.AppRepositoryResourceName, chartDetails.AppRepositoryResourceNamespace, err)
+		return nil, nil, err
 	}
 
 	userAgentString := fmt.Sprintf("%s/%s/%s/%s", UserAgentPrefix, pluginDetail.Name, pluginDetail.Version, version)
@@ -879,8 +1269,11 @@ func (s *Server) fetchChartWithRegistrySecrets(ctx context.Context, chartDetails
 	log.Infof("fetching chart %q with user-agent %q", chartID, userAgentString)
 
 	// Look up the cachedChart cached in our DB to populate the tarball URL
-	cachedChart, err := s.manager.GetChartVersion(chartDetails.AppRepositoryResourceNamespace, chartID, chartDetails.Version)
+	cachedChart, err := s.assetManager(ctx).GetChartVersion(chartDetails.AppRepositoryResourceNamespace, chartID, chartDetails.Version)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, utils.ErrChartVersionNotFound) {
+			return nil, nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "Chart", chartID, "Unable to find the chart %s (version %s) in the namespace %q: %v", chartID, chartDetails.Version, chartDetails.AppRepositoryResourceNamespace, err)
+		}
 		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch the chart %s (version %s) from the namespace %q: %v", chartID, chartDetails.Version, chartDetails.AppRepositoryResourceNamespace, err)
 	}
 	var tarballURL string
@@ -906,17 +1299,39 @@ func (s *Server) fetchChartWithRegistrySecrets(ctx context.Context, chartDetails
 		s.chartClientFactory.New(appRepo.Spec.Type, userAgentString),
 	)
 	if err != nil {
-		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch the chart %s from the namespace %q: %v", chartDetails.ChartName, appRepo.Namespace, err)
+		return nil, nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "Chart", chartID, "Unable to fetch the chart %s (version %s) from the repository %q: %v", chartID, chartDetails.Version, appRepo.Name, err)
 	}
 
 	registrySecrets, err := chartutils.RegistrySecretsPerDomain(ctx, appRepo.Spec.DockerRegistrySecrets, appRepo.Namespace, client)
 	if err != nil {
-		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch registry secrets from the namespace %q: %v", appRepo.Namespace, err)
+		return nil, nil, statuserror.FromK8sError("get", "Secret", "", err)
 	}
 
 	return ch, registrySecrets, nil
 }
 
+// releaseActionError returns the error of a Helm action on a release. When
+// the user is not allowed to act on some of the resources of the release, the
+// forbidden resources reported by the Kubernetes API are returned as details.
+func releaseActionError(verb, releaseName, namespace string, err error) error {
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonResourceNotFound, "Release", releaseName, "Unable to find Helm release %q in namespace %q: %+v", releaseName, namespace, err)
+	}
+	if forbiddenActions := auth.ParseForbiddenActions(err.Error()); len(forbiddenActions) > 0 {
+		resources := make([]statuserror.ForbiddenResource, len(forbiddenActions))
+		for i, a := range forbiddenActions {
+			resources[i] = statuserror.ForbiddenResource{
+				APIGroup:  a.APIVersion,
+				Resource:  a.Resource,
+				Namespace: a.Namespace,
+				Verbs:     a.Verbs,
+			}
+		}
+		return statuserror.ForbiddenErrorf(resources, "Forbidden to %s helm release %q in the namespace %q: %v", verb, releaseName, namespace, err)
+	}
+	return statuserror.ResourceErrorf(codes.Internal, statuserror.ReasonResourceError, "Release", releaseName, "Unable to %s helm release %q in the namespace %q: %v", verb, releaseName, namespace, err)
+}
+
 func chartTarballURL(r *models.Repo, cv models.ChartVersion) string {
 	source := strings.TrimSpace(cv.URLs[0])
 	parsedUrl, err := url.ParseRequestURI(source)
@@ -949,34 +1364,61 @@ func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.Del
 	err = agent.DeleteRelease(actionConfig, releaseName, keepHistory, s.timeoutSeconds)
 	if err != nil {
 		log.Errorf("error: %+v", err)
-		if errors.Is(err, driver.ErrReleaseNotFound) {
-			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", releaseName, namespace, err)
-		}
-		return nil, status.Errorf(codes.Internal, "Unable to delete helm release %q in the namespace %q: %v", releaseName, namespace, err)
+		return nil, releaseActionError("delete", releaseName, namespace, err)
 	}
 
 	return &corev1.DeleteInstalledPackageResponse{}, nil
 }
 
-// RollbackInstalledPackage updates an installed package.
-func (s *Server) RollbackInstalledPackage(ctx context.Context, request *helmv1.RollbackInstalledPackageRequest) (*helmv1.RollbackInstalledPackageResponse, error) {
+// GetInstalledPackageRevisions returns the revisions of an installed package,
+// taken from the history of its Helm release.
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	identifier := installedRef.GetIdentifier()
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", installedRef.GetContext().GetCluster(), installedRef.GetContext().GetNamespace())
+	log.Infof("+helm GetInstalledPackageRevisions %s %s", contextMsg, identifier)
+
+	fn := func(ctx context.Context, namespace string) (*action.Configuration, error) {
+		return s.actionConfigGetter(ctx, installedRef.GetContext())
+	}
+
+	revisions, err := releasehistory.GetInstalledPackageRevisions(
+		ctx,
+		types.NamespacedName{
+			Name:      identifier,
+			Namespace: installedRef.GetContext().GetNamespace(),
+		},
+		fn,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// RollbackInstalledPackage rolls back an installed package to a previous revision.
+func (s *Server) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
 	installedRef := request.GetInstalledPackageRef()
 	releaseName := installedRef.GetIdentifier()
 	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", installedRef.GetContext().GetCluster(), installedRef.GetContext().GetNamespace())
 	log.Infof("+helm RollbackInstalledPackage %s", contextMsg)
 
+	if request.GetRevision() <= 0 {
+		return nil, statuserror.FieldErrorf("revision", "Invalid revision %d, the revision to which to rollback must be positive", request.GetRevision())
+	}
+
 	// Create an action config for the installed pkg context.
 	actionConfig, err := s.actionConfigGetter(ctx, installedRef.GetContext())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
 
-	release, err := agent.RollbackRelease(actionConfig, releaseName, int(request.GetReleaseRevision()), s.timeoutSeconds)
+	release, err := agent.RollbackRelease(actionConfig, releaseName, int(request.GetRevision()), s.timeoutSeconds)
 	if err != nil {
-		if errors.Is(err, driver.ErrReleaseNotFound) {
-			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", releaseName, installedRef.GetContext().GetNamespace(), err)
-		}
-		return nil, status.Errorf(codes.Internal, "Unable to rollback helm release %q in the namespace %q: %v", releaseName, installedRef.GetContext().GetNamespace(), err)
+		return nil, releaseActionError("rollback", releaseName, installedRef.GetContext().GetNamespace(), err)
 	}
 
 	cluster := installedRef.GetContext().GetCluster()
@@ -984,7 +1426,7 @@ func (s *Server) RollbackInstalledPackage(ctx context.Context, request *helmv1.R
 		cluster = s.globalPackagingCluster
 	}
 
-	return &helmv1.RollbackInstalledPackageResponse{
+	return &corev1.RollbackInstalledPackageResponse{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
 				Cluster:   cluster,
@@ -996,6 +1438,31 @@ func (s *Server) RollbackInstalledPackage(ctx context.Context, request *helmv1.R
 	}, nil
 }
 
+// helmPackagesServer serves the helm packages service, whose deprecated
+// RollbackInstalledPackage RPC takes the helm specific request rather than
+// the core one implemented by the Server.
+type helmPackagesServer struct {
+	*Server
+}
+
+// RollbackInstalledPackage rolls back an installed package to the release
+// revision of the request.
+//
+// Deprecated: the RollbackInstalledPackage RPC of the core packages service
+// should be used instead.
+func (s helmPackagesServer) RollbackInstalledPackage(ctx context.Context, request *helmv1.RollbackInstalledPackageRequest) (*helmv1.RollbackInstalledPackageResponse, error) {
+	response, err := s.Server.RollbackInstalledPackage(ctx, &corev1.RollbackInstalledPackageRequest{
+		InstalledPackageRef: request.GetInstalledPackageRef(),
+		Revision:            request.GetReleaseRevision(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &helmv1.RollbackInstalledPackageResponse{
+		InstalledPackageRef: response.GetInstalledPackageRef(),
+	}, nil
+}
+
 // GetInstalledPackageResourceRefs returns the references for the Kubernetes
 // resources created by an installed package.
 func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *corev1.GetInstalledPackageResourceRefsRequest) (*corev1.GetInstalledPackageResourceRefsResponse, error) {
@@ -1012,14 +1479,19 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 		return actionGetter, nil
 	}
 
-	refs, err := resourcerefs.GetInstalledPackageResourceRefs(
+	refs, manifest, err := resourcerefs.GetInstalledPackageResourceRefsAndManifest(
 		ctx, types.NamespacedName{Name: identifier, Namespace: pkgRef.Context.Namespace}, fn)
 	if err != nil {
 		return nil, err
 	} else {
+		// The manifest can be large, so it is only returned when requested.
+		if !request.GetIncludeManifest() {
+			manifest = ""
+		}
 		return &corev1.GetInstalledPackageResourceRefsResponse{
 			Context:      pkgRef.GetContext(),
 			ResourceRefs: refs,
+			Manifest:     manifest,
 		}, nil
 	}
 }
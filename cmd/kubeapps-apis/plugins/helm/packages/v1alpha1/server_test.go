@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	sqldriver "database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -15,6 +16,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
@@ -27,12 +29,14 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 	"sigs.k8s.io/yaml"
 
 	"github.com/vmware-tanzu/kubeapps/pkg/agent"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/fake"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"github.com/vmware-tanzu/kubeapps/pkg/dbutils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -316,6 +320,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 					{
 						Name:        "chart-2",
@@ -332,6 +337,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 					{
 						Name:        "chart-3-global",
@@ -348,6 +354,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-3-global",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 				},
 				Categories: []string{"cat1"},
@@ -384,6 +391,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 					{
 						Name:        "chart-2",
@@ -400,6 +408,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 				},
 				Categories: []string{"cat1"},
@@ -437,6 +446,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 					{
 						Name:        "chart-2",
@@ -453,6 +463,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 				},
 				Categories: []string{"cat1"},
@@ -530,6 +541,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 				},
 				NextPageToken: "2",
@@ -574,6 +586,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-3",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 				},
 				NextPageToken: "",
@@ -627,6 +640,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-1",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 					{
 						Name:        "chart-2",
@@ -643,6 +657,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-2",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 					{
 						Name:        "chart-3",
@@ -659,6 +674,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 							Identifier: "repo-1/chart-3",
 							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
 						},
+						RepositoryName: "repo-1",
 					},
 				},
 				Categories: []string{"bar", "foo"},
@@ -685,30 +701,18 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				// Checking if the WHERE condtion is properly applied
 
 				// Check returned categories
-				catrows := sqlmock.NewRows([]string{"name", "count"})
-
-				// Generate the categories from the tc.charts input
-				dict := make(map[string]int)
-				for _, chart := range tc.charts {
-					dict[chart.Category] = dict[chart.Category] + 1
-				}
-				// Ensure we've got a fixed order for the results.
-				categories := []string{}
-				for category := range dict {
-					categories = append(categories, category)
-				}
-				sort.Strings(categories)
-				for _, category := range categories {
-					catrows.AddRow(category, dict[category])
-				}
-
 				mock.ExpectQuery("SELECT (info ->> 'category')*").
 					WithArgs(tc.expectDBQueryNamespace, server.globalPackagingNamespace).
-					WillReturnRows(catrows)
+					WillReturnRows(makeCountRows(tc.charts, func(chart *models.Chart) string { return chart.Category }))
 
 				mock.ExpectQuery("SELECT info FROM").
 					WithArgs(tc.expectDBQueryNamespace, server.globalPackagingNamespace).
 					WillReturnRows(rows)
+
+				// The facets are only counted for the first page.
+				if pageToken := tc.request.GetPaginationOptions().GetPageToken(); tc.statusCode == codes.OK && (pageToken == "" || pageToken == "0") {
+					expectFacetQueries(mock, tc.charts, tc.expectDBQueryNamespace, server.globalPackagingNamespace)
+				}
 			}
 
 			availablePackageSummaries, err := server.GetAvailablePackageSummaries(context.Background(), tc.request)
@@ -719,8 +723,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 
 			if tc.statusCode == codes.OK {
 				opt1 := cmpopts.IgnoreUnexported(corev1.GetAvailablePackageSummariesResponse{}, corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.PackageAppVersion{})
-				if got, want := availablePackageSummaries, tc.expectedResponse; !cmp.Equal(got, want, opt1) {
-					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
+				// The facets are checked in TestGetAvailablePackageSummariesFacets.
+				opt2 := cmpopts.IgnoreFields(corev1.GetAvailablePackageSummariesResponse{}, "Facets")
+				if got, want := availablePackageSummaries, tc.expectedResponse; !cmp.Equal(got, want, opt1, opt2) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 				}
 			}
 			// we make sure that all expectations were met
@@ -731,6 +737,173 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 	}
 }
 
+// makeCountRows returns the rows of the number of charts for each key, as
+// returned when grouping the charts by category or repository.
+func makeCountRows(charts []*models.Chart, key func(*models.Chart) string) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"name", "count"})
+	dict := make(map[string]int)
+	for _, chart := range charts {
+		dict[key(chart)] = dict[key(chart)] + 1
+	}
+	// Ensure we've got a fixed order for the results.
+	names := []string{}
+	for name := range dict {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rows.AddRow(name, dict[name])
+	}
+	return rows
+}
+
+// expectFacetQueries expects the queries counting the charts for each
+// category and repository.
+func expectFacetQueries(mock sqlmock.Sqlmock, charts []*models.Chart, args ...sqldriver.Value) {
+	mock.ExpectQuery("SELECT (info ->> 'category')*").
+		WithArgs(args...).
+		WillReturnRows(makeCountRows(charts, func(chart *models.Chart) string { return chart.Category }))
+	mock.ExpectQuery("SELECT repo_name AS name").
+		WithArgs(args...).
+		WillReturnRows(makeCountRows(charts, func(chart *models.Chart) string { return chart.Repo.Name }))
+}
+
+func TestGetAvailablePackageSummariesFacets(t *testing.T) {
+	charts := []*models.Chart{
+		makeChart("chart-1", "repo-1", "http://chart-1", "my-ns", []string{"3.0.0"}, "foo"),
+		makeChart("chart-2", "repo-2", "http://chart-2", "my-ns", []string{"2.0.0"}, "foo"),
+		makeChart("chart-3", "repo-1", "http://chart-3", "my-ns", []string{"2.0.0"}, ""),
+	}
+	charts[1].ChartVersions[0].Created = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	server, mock, cleanup := makeServer(t, true, nil)
+	defer cleanup()
+
+	request := &corev1.GetAvailablePackageSummariesRequest{
+		Context: &corev1.Context{
+			Namespace: "my-ns",
+		},
+		FilterOptions: &corev1.FilterOptions{
+			Categories: []string{"foo", ""},
+			SortBy:     corev1.FilterOptions_SORT_BY_LAST_UPDATED,
+		},
+	}
+
+	mock.ExpectQuery("SELECT (info ->> 'category')*").
+		WithArgs("my-ns", server.globalPackagingNamespace).
+		WillReturnRows(makeCountRows(charts, func(chart *models.Chart) string { return chart.Category }))
+	rows := sqlmock.NewRows([]string{"info"})
+	for _, row := range makeChartRowsJSON(t, []*models.Chart{charts[1], charts[0]}, "", 0) {
+		rows.AddRow(row)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (info->>'category' = $3) ORDER BY (info->'chartVersions'->0->>'created')::timestamptz DESC NULLS LAST")).
+		WithArgs("my-ns", server.globalPackagingNamespace, "foo").
+		WillReturnRows(rows)
+	expectFacetQueries(mock, charts[:2], "my-ns", server.globalPackagingNamespace, "foo")
+
+	response, err := server.GetAvailablePackageSummaries(context.Background(), request)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if got, want := []string{response.AvailablePackageSummaries[0].Name, response.AvailablePackageSummaries[1].Name}, []string{"chart-2", "chart-1"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got, want := response.AvailablePackageSummaries[0].LatestVersionUpdated.AsTime(), charts[1].ChartVersions[0].Created; !got.Equal(want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	expectedFacets := &corev1.AvailablePackageFacets{
+		Categories: []*corev1.FacetCount{
+			{Value: "foo", Count: 2},
+		},
+		Repositories: []*corev1.FacetCount{
+			{Value: "repo-1", Count: 1},
+			{Value: "repo-2", Count: 1},
+		},
+		Plugins: []*corev1.FacetCount{
+			{Value: "helm.packages", Count: 2},
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageFacets{}, corev1.FacetCount{})
+	if got, want := response.Facets, expectedFacets; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetAvailablePackageSummariesVersionConstraints(t *testing.T) {
+	charts := []*models.Chart{
+		makeChart("chart-1", "repo-1", "http://chart-1", "my-ns", []string{"3.0.0", "2.1.0", "2.0.0"}, "foo"),
+		makeChart("chart-2", "repo-1", "http://chart-2", "my-ns", []string{"1.0.0"}, "foo"),
+	}
+
+	server, mock, cleanup := makeServer(t, true, nil)
+	defer cleanup()
+
+	request := &corev1.GetAvailablePackageSummariesRequest{
+		Context: &corev1.Context{
+			Namespace: "my-ns",
+		},
+		FilterOptions: &corev1.FilterOptions{
+			PkgVersion: ">=2 <3",
+		},
+	}
+
+	mock.ExpectQuery("SELECT (info ->> 'category')*").
+		WithArgs("my-ns", server.globalPackagingNamespace).
+		WillReturnRows(makeCountRows(charts, func(chart *models.Chart) string { return chart.Category }))
+	// The whole list is queried only once for both the summaries and the
+	// facets, as the version constraints are matched once the charts are
+	// retrieved.
+	rows := sqlmock.NewRows([]string{"info"})
+	for _, row := range makeChartRowsJSON(t, charts, "", 0) {
+		rows.AddRow(row)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY (info->>'name') ASC ")).
+		WithArgs("my-ns", server.globalPackagingNamespace).
+		WillReturnRows(rows)
+
+	response, err := server.GetAvailablePackageSummaries(context.Background(), request)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	expectedSummaries := []*corev1.AvailablePackageSummary{
+		{
+			Name:        "chart-1",
+			DisplayName: "chart-1",
+			LatestVersion: &corev1.PackageAppVersion{
+				PkgVersion: "2.1.0",
+				AppVersion: DefaultAppVersion,
+			},
+			IconUrl:          DefaultChartIconURL,
+			Categories:       []string{"foo"},
+			ShortDescription: DefaultChartDescription,
+			RepositoryName:   "repo-1",
+			AvailablePackageRef: &corev1.AvailablePackageReference{
+				Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+				Identifier: "repo-1/chart-1",
+				Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+			},
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, corev1.PackageAppVersion{}, plugins.Plugin{})
+	if got, want := response.AvailablePackageSummaries, expectedSummaries; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+	if got, want := response.GetFacets().GetPlugins()[0].GetCount(), int32(1); got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestAvailablePackageDetailFromChart(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1053,6 +1226,18 @@ func TestGetAvailablePackageVersions(t *testing.T) {
 			},
 			expectedStatusCode: codes.InvalidArgument,
 		},
+		{
+			name: "it returns not found if the chart does not exist",
+			request: &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "bitnami/not-a-chart",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
 		{
 			name:   "it returns the package version summary",
 			charts: []*models.Chart{makeChart("apache", "bitnami", "http://apache", "kubeapps", []string{"3.0.0", "2.0.0", "1.0.0"}, DefaultChartCategory)},
@@ -1099,17 +1284,28 @@ func TestGetAvailablePackageVersions(t *testing.T) {
 				}
 				rows.AddRow(string(chartJSON))
 			}
-			if tc.expectedStatusCode == codes.OK {
+			if tc.expectedStatusCode == codes.OK || tc.expectedStatusCode == codes.NotFound {
 				mock.ExpectQuery("SELECT info FROM").
 					WithArgs(tc.request.AvailablePackageRef.Context.Namespace, tc.request.AvailablePackageRef.Identifier).
 					WillReturnRows(rows)
 			}
+			if tc.expectedStatusCode == codes.NotFound {
+				// the fallback query for mirrored charts
+				mock.ExpectQuery("SELECT info FROM").
+					WithArgs(tc.request.AvailablePackageRef.Context.Namespace, strings.Replace(tc.request.AvailablePackageRef.Identifier, "/", "%", 1)).
+					WillReturnRows(sqlmock.NewRows([]string{"info"}))
+			}
 
 			response, err := server.GetAvailablePackageVersions(context.Background(), tc.request)
 
 			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
 				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
 			}
+			if tc.expectedStatusCode == codes.NotFound {
+				if got, want := statuserror.Reason(err), statuserror.ReasonPackageNotFound; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			}
 
 			// We don't need to check anything else for non-OK codes.
 			if tc.expectedStatusCode != codes.OK {
@@ -1727,6 +1923,160 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageSummariesWithFilters(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:          "my-release-1",
+			namespace:     "namespace-1",
+			chartID:       "bitnami/apache",
+			chartVersion:  "1.2.3",
+			latestVersion: "1.3.0",
+			status:        release.StatusDeployed,
+			version:       1,
+		},
+		{
+			name:          "my-release-2",
+			namespace:     "namespace-2",
+			chartID:       "other-repo/nginx",
+			chartVersion:  "3.4.5",
+			latestVersion: "3.4.5",
+			status:        release.StatusFailed,
+			version:       1,
+		},
+		{
+			name:          "my-release-3",
+			namespace:     "namespace-3",
+			chartID:       "bitnami/redis",
+			chartVersion:  "4.5.6",
+			latestVersion: "4.5.6",
+			status:        release.StatusDeployed,
+			version:       1,
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		request               *corev1.GetInstalledPackageSummariesRequest
+		expectedDBQueries     bool
+		expectedIdentifiers   []string
+		expectedNextPageToken string
+	}{
+		{
+			name: "returns the installed packages with a status reason",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+						corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+					},
+				},
+			},
+			expectedDBQueries:   true,
+			expectedIdentifiers: []string{"my-release-2"},
+		},
+		{
+			name: "returns the installed packages from a repository with an upgrade available",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Repositories:     []string{"bitnami"},
+					UpgradeAvailable: true,
+				},
+			},
+			expectedDBQueries:   true,
+			expectedIdentifiers: []string{"my-release-1"},
+		},
+		{
+			name: "returns the installed packages matching a query",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Query: "RELEASE-3",
+				},
+			},
+			expectedDBQueries:   true,
+			expectedIdentifiers: []string{"my-release-3"},
+		},
+		{
+			name: "paginates the matching installed packages",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				PaginationOptions: &corev1.PaginationOptions{
+					PageSize: 1,
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Repositories: []string{"bitnami"},
+				},
+			},
+			expectedDBQueries:     true,
+			expectedIdentifiers:   []string{"my-release-1"},
+			expectedNextPageToken: "1",
+		},
+		{
+			name: "returns the last page of the matching installed packages",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				PaginationOptions: &corev1.PaginationOptions{
+					PageSize:  1,
+					PageToken: "1",
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Repositories: []string{"bitnami"},
+				},
+			},
+			expectedDBQueries:   true,
+			expectedIdentifiers: []string{"my-release-3"},
+		},
+		{
+			name: "returns no installed packages when the plugin is filtered out",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Plugins: []string{"fluxv2.packages"},
+				},
+			},
+			expectedIdentifiers: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "", existingReleases, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			if tc.expectedDBQueries {
+				// Every release is queried when filtering, with the chart
+				// repository taken from the chart ID of the release stub.
+				for _, rel := range existingReleases {
+					chart := chartAssetForReleaseStub(&rel)
+					chart.Repo.Name = strings.Split(rel.chartID, "/")[0]
+					chartJSON, err := json.Marshal(chart)
+					if err != nil {
+						t.Fatalf("%+v", err)
+					}
+					mock.ExpectQuery("SELECT info FROM").
+						WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(string(chartJSON)))
+				}
+			}
+
+			response, err := server.GetInstalledPackageSummaries(context.Background(), tc.request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			identifiers := []string{}
+			for _, summary := range response.InstalledPackageSummaries {
+				identifiers = append(identifiers, summary.GetInstalledPackageRef().GetIdentifier())
+			}
+			if got, want := identifiers, tc.expectedIdentifiers; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := response.NextPageToken, tc.expectedNextPageToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageDetail(t *testing.T) {
 	customDetailRevision2, err := anypb.New(&helmv1.InstalledPackageDetailCustomDataHelm{
 		ReleaseRevision: 2,
@@ -2099,3 +2449,55 @@ type releaseStub struct {
 	status         release.Status
 	manifest       string
 }
+
+func TestReleaseActionError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			name:           "it returns not found if the release does not exist",
+			err:            driver.ErrReleaseNotFound,
+			expectedCode:   codes.NotFound,
+			expectedReason: statuserror.ReasonResourceNotFound,
+		},
+		{
+			name:           "it returns permission denied with the forbidden resources",
+			err:            fmt.Errorf(`rendered manifests contain a resource that already exists: deployments.apps "foo" is forbidden: User "bar" cannot create resource "deployments" in API group "apps" in the namespace "default"`),
+			expectedCode:   codes.PermissionDenied,
+			expectedReason: statuserror.ReasonResourceForbidden,
+		},
+		{
+			name:           "it returns an internal error otherwise",
+			err:            fmt.Errorf("boom!"),
+			expectedCode:   codes.Internal,
+			expectedReason: statuserror.ReasonResourceError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := releaseActionError("create", "foo", "default", tc.err)
+
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+			if got, want := statuserror.Reason(err), tc.expectedReason; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+
+	err := releaseActionError("create", "foo", "default", fmt.Errorf(`User "bar" cannot create resource "deployments" in API group "apps" in the namespace "default"`))
+	resourceTypes := []string{}
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ResourceInfo); ok {
+			resourceTypes = append(resourceTypes, info.GetResourceType())
+		}
+	}
+	if got, want := resourceTypes, []string{"deployments.apps"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"helm.sh/helm/v3/pkg/release"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func helmReleaseSecret(name, namespace string) *k8scorev1.Secret {
+	return &k8scorev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1." + name + ".v1",
+			Namespace: namespace,
+			Labels: map[string]string{
+				"owner": "helm",
+				"name":  name,
+			},
+		},
+	}
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	const releaseNamespace = "namespace-1"
+	existingRelease := releaseStub{
+		name:         "my-release-1",
+		namespace:    releaseNamespace,
+		chartVersion: "1.2.3",
+		status:       release.StatusDeployed,
+		version:      1,
+	}
+	expectedSummary := &corev1.InstalledPackageSummary{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   globalPackagingCluster,
+				Namespace: releaseNamespace,
+			},
+			Identifier: "my-release-1",
+		},
+		Name:    "my-release-1",
+		IconUrl: "https://example.com/icon.png",
+		PkgVersionReference: &corev1.VersionReference{
+			Version: "1.2.3",
+		},
+		CurrentVersion: &corev1.PackageAppVersion{
+			PkgVersion: "1.2.3",
+			AppVersion: DefaultAppVersion,
+		},
+		LatestVersion: &corev1.PackageAppVersion{
+			PkgVersion: "1.2.3",
+			AppVersion: DefaultAppVersion,
+		},
+		Status: &corev1.InstalledPackageStatus{
+			Ready:      true,
+			Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+			UserReason: "deployed",
+		},
+	}
+
+	failedSummary := proto.Clone(expectedSummary).(*corev1.InstalledPackageSummary)
+	failedSummary.Status = &corev1.InstalledPackageStatus{
+		Ready:      false,
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+		UserReason: "failed",
+	}
+
+	actionConfig := newActionConfigFixture(t, releaseNamespace, []releaseStub{existingRelease}, nil)
+	server, mock, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+	// The latest version is queried for each event of the existing release.
+	populateAssetDB(t, mock, []releaseStub{existingRelease, existingRelease, existingRelease})
+
+	// The fake watcher is unbuffered, so that sending an event returns only
+	// once the previous event has been handled.
+	fakeWatcher := watch.NewFake()
+	clientSet := typfake.NewSimpleClientset()
+	clientSet.PrependWatchReactor("secrets", k8stesting.DefaultWatchReactor(fakeWatcher, nil))
+	server.clientGetter = func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+		return clientgetter.NewBuilder().WithTyped(clientSet).Build(), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &plugin_test.TestWatchInstalledPackageSummariesStream{
+		Ctx: ctx,
+		OnSend: func(r *corev1.WatchInstalledPackageSummariesResponse) {
+			if r.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				cancel()
+			}
+		},
+	}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- server.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+			Context: &corev1.Context{Namespace: releaseNamespace},
+		}, stream)
+	}()
+
+	fakeWatcher.Add(helmReleaseSecret("my-release-1", releaseNamespace))
+	// A modification which does not change the release is not sent.
+	fakeWatcher.Modify(helmReleaseSecret("my-release-1", releaseNamespace))
+	// A secret for a release which was never sent nor exists is ignored.
+	fakeWatcher.Delete(helmReleaseSecret("unknown-release", releaseNamespace))
+
+	rel, err := actionConfig.Releases.Get(existingRelease.name, existingRelease.version)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	rel.Info.Status = release.StatusFailed
+	if err = actionConfig.Releases.Update(rel); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fakeWatcher.Modify(helmReleaseSecret("my-release-1", releaseNamespace))
+	fakeWatcher.Delete(helmReleaseSecret("unknown-release", releaseNamespace))
+
+	if _, err = actionConfig.Releases.Delete(existingRelease.name, existingRelease.version); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fakeWatcher.Delete(helmReleaseSecret("my-release-1", releaseNamespace))
+
+	if got, want := status.Code(<-errCh), codes.OK; got != want {
+		t.Fatalf("got: %+v, want: %+v", got, want)
+	}
+
+	expectedResponses := []*corev1.WatchInstalledPackageSummariesResponse{
+		{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: expectedSummary,
+		},
+		{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED,
+			InstalledPackageSummary: failedSummary,
+		},
+		{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: expectedSummary.InstalledPackageRef,
+				Name:                "my-release-1",
+			},
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(corev1.WatchInstalledPackageSummariesResponse{}, corev1.InstalledPackageSummary{}, corev1.InstalledPackageReference{}, corev1.Context{}, corev1.VersionReference{}, corev1.InstalledPackageStatus{}, corev1.PackageAppVersion{})
+	if got, want := stream.Responses, expectedResponses; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
 )
 
+var _ pluginsv1alpha1.PluginCapabilitiesProvider = (*Server)(nil)
+
 // Set the pluginDetail once during a module init function so the single struct
 // can be used throughout the plugin.
 var pluginDetail pluginsgrpcv1alpha1.Plugin
@@ -43,3 +45,13 @@ func RegisterHTTPHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux,
 func GetPluginDetail() *pluginsgrpcv1alpha1.Plugin {
 	return &pluginDetail
 }
+
+// GetPluginCapabilities returns the optional features supported by the plugin.
+func (s *Server) GetPluginCapabilities() *pluginsgrpcv1alpha1.PluginCapabilities {
+	return &pluginsgrpcv1alpha1.PluginCapabilities{
+		ReconciliationOptions: true,
+		ServiceAccounts:       true,
+		MultiCluster:          true,
+		ValuesSchema:          true,
+	}
+}
@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,17 +18,28 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/permissions"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/summaries"
+	"github.com/vmware-tanzu/kubeapps/pkg/versionfilter"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	k8scorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	log "k8s.io/klog/v2"
 )
 
 const PACKAGES_CHANNEL_BUFFER_SIZE = 20
 
+// packageRepositoryRefAnnotation is set by kapp-controller on each Package
+// installed from a PackageRepository, with the value "namespace/name".
+const packageRepositoryRefAnnotation = "packaging.carvel.dev/package-repository-ref"
+
 // GetAvailablePackageSummaries returns the available packages managed by the 'kapp_controller' plugin
 func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
 	// Retrieve parameters from the request
@@ -55,82 +67,70 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	// Filter the package metadatas using any specified filter.
 	pkgMetadatas = FilterMetadatas(pkgMetadatas, request.GetFilterOptions())
 
-	// Update the slice to be the correct page of results.
-	startAt := 0
-	if pageSize > 0 {
-		startAt = itemOffset
-		if startAt > len(pkgMetadatas) {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination arguments %v", request.GetPaginationOptions())
-		}
-		pkgMetadatas = pkgMetadatas[startAt:]
-		if len(pkgMetadatas) > int(pageSize) {
-			pkgMetadatas = pkgMetadatas[:pageSize]
-		}
+	if pageSize > 0 && itemOffset > len(pkgMetadatas) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination arguments %v", request.GetPaginationOptions())
 	}
 
-	// Create a channel to receive all packages available in the namespace.
-	// Using a buffered channel so that we don't block the network request if we
-	// can't process fast enough.
-	getPkgsChannel := make(chan *datapackagingv1alpha1.Package, PACKAGES_CHANNEL_BUFFER_SIZE)
-	var getPkgsError error
-	go func() {
-		getPkgsError = s.getPkgs(ctx, cluster, namespace, getPkgsChannel)
-	}()
-
-	// Skip through the packages until we get to the first item in our
-	// paginated results.
-	currentPkg := <-getPkgsChannel
-	for currentPkg != nil && len(pkgMetadatas) > 0 && currentPkg.Spec.RefName != pkgMetadatas[0].Name {
-		currentPkg = <-getPkgsChannel
+	// Fetch all the packages, as the latest version of each package is needed
+	// to sort the summaries and to count the facets.
+	pkgs, err := s.getPkgsWithFieldSelector(ctx, cluster, namespace, "")
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "Package", "", err)
+	}
+	pkgsByRefName := map[string][]*datapackagingv1alpha1.Package{}
+	for _, pkg := range pkgs {
+		pkgsByRefName[pkg.Spec.RefName] = append(pkgsByRefName[pkg.Spec.RefName], pkg)
 	}
 
-	availablePackageSummaries := make([]*corev1.AvailablePackageSummary, len(pkgMetadatas))
-	categories := []string{}
-	pkgsForMeta := []*datapackagingv1alpha1.Package{}
-	for i, pkgMetadata := range pkgMetadatas {
-		// currentPkg will be nil if the channel is closed and there's no
-		// more items to consume.
-		if currentPkg == nil {
+	pkgVersionFilter := versionfilter.New(request.GetFilterOptions().GetPkgVersion())
+	appVersionFilter := versionfilter.New(request.GetFilterOptions().GetAppVersion())
+	availablePackageSummaries := make([]*corev1.AvailablePackageSummary, 0, len(pkgMetadatas))
+	for _, pkgMetadata := range pkgMetadatas {
+		// Some repositories have invalid data (TAP 1.0.2) where a package is
+		// present *without* corresponding metadata, those packages are ignored.
+		pkgsForMeta := pkgsByRefName[pkgMetadata.Name]
+		if len(pkgsForMeta) == 0 {
 			return nil, statuserror.FromK8sError("get", "Package", pkgMetadata.Name, fmt.Errorf("no package versions for the package %q", pkgMetadata.Name))
 		}
-		// The kapp-controller returns both packages and package metadata
-		// in order. But some repositories have invalid data (TAP 1.0.2)
-		// where a package is present *without* corresponding metadata.
-		for currentPkg.Spec.RefName != pkgMetadata.Name {
-			if currentPkg.Spec.RefName > pkgMetadata.Name {
-				return nil, status.Errorf(codes.Internal, fmt.Sprintf("unexpected order for kapp-controller packages, expected %q, found %q", pkgMetadata.Name, currentPkg.Spec.RefName))
-			}
-			log.Errorf("Package %q did not have a corresponding metadata", currentPkg.Spec.RefName)
-			currentPkg = <-getPkgsChannel
-		}
 		// Collect the packages for a particular refName to be able to send the
 		// latest semver version. For the moment, kapp-controller just returns
 		// CRs with the default alpha sorting of the CR name.
 		// Ref https://kubernetes.slack.com/archives/CH8KCCKA5/p1646285201181119
-		pkgsForMeta = append(pkgsForMeta, currentPkg)
-		currentPkg = <-getPkgsChannel
-		for currentPkg != nil && currentPkg.Spec.RefName == pkgMetadata.Name {
-			pkgsForMeta = append(pkgsForMeta, currentPkg)
-			currentPkg = <-getPkgsChannel
-		}
-		// At this point, we have all the packages collected that match
-		// this ref name, and currentPkg is for the next meta name.
 		pkgVersionMap, err := getPkgVersionsMap(pkgsForMeta)
 		if err != nil || len(pkgVersionMap[pkgMetadata.Name]) == 0 {
 			return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to calculate package versions map for packages: %v, err: %v", pkgsForMeta, err))
 		}
-		latestVersion := pkgVersionMap[pkgMetadata.Name][0].version.String()
-		availablePackageSummary := s.buildAvailablePackageSummary(pkgMetadata, latestVersion, cluster)
-		availablePackageSummaries[i] = availablePackageSummary
-		categories = append(categories, availablePackageSummary.Categories...)
+		// Only the versions matching the version filters are considered, so
+		// the latest version of the summary is the latest matching one.
+		pkgVersions := filterPkgVersions(pkgVersionMap[pkgMetadata.Name], pkgVersionFilter, appVersionFilter)
+		if len(pkgVersions) == 0 {
+			continue
+		}
+		availablePackageSummaries = append(availablePackageSummaries, s.buildAvailablePackageSummary(pkgMetadata, pkgVersions[0], cluster))
+	}
+	summaries.Sort(availablePackageSummaries, request.GetFilterOptions().GetSortBy())
 
-		// Reset the packages for the current meta name.
-		pkgsForMeta = pkgsForMeta[:0]
+	// The facets are only computed for the first page, as they are the same
+	// for every page of the same filter.
+	var facets *corev1.AvailablePackageFacets
+	if itemOffset == 0 {
+		facets = summaries.Facets(availablePackageSummaries, GetPluginDetail().GetName())
 	}
 
-	// Verify no error during go routine.
-	if getPkgsError != nil {
-		return nil, statuserror.FromK8sError("get", "Package", "", err)
+	// Update the slice to be the correct page of results.
+	if pageSize > 0 {
+		if itemOffset > len(availablePackageSummaries) {
+			itemOffset = len(availablePackageSummaries)
+		}
+		availablePackageSummaries = availablePackageSummaries[itemOffset:]
+		if len(availablePackageSummaries) > int(pageSize) {
+			availablePackageSummaries = availablePackageSummaries[:pageSize]
+		}
+	}
+
+	categories := []string{}
+	for _, availablePackageSummary := range availablePackageSummaries {
+		categories = append(categories, availablePackageSummary.Categories...)
 	}
 
 	// Only return a next page token if the request was for pagination and
@@ -142,6 +142,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	response := &corev1.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: availablePackageSummaries,
 		Categories:                categories,
+		Facets:                    facets,
 		NextPageToken:             nextPageToken,
 	}
 	return response, nil
@@ -242,7 +243,7 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 			}
 		}
 		if foundPkgSemver.version == nil {
-			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("unable to find %q package with version %q", identifier, requestedPkgVersion))
+			return nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "Package", identifier, "unable to find %q package with version %q", identifier, requestedPkgVersion)
 		}
 	} else {
 		// If the pkgVersion wasn't specified, grab the packages to find the latest.
@@ -250,7 +251,7 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 			foundPkgSemver = &pkgVersionsMap[identifier][0]
 			requestedPkgVersion = foundPkgSemver.version.String()
 		} else {
-			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("unable to find any versions for the package %q", identifier))
+			return nil, statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "Package", identifier, "unable to find any versions for the package %q", identifier)
 		}
 	}
 
@@ -279,6 +280,12 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	if err != nil {
 		return nil, err
 	}
+	filter := request.GetFilterOptions()
+	if !pkgutils.PluginMatchesInstalledPackageFilter(GetPluginDetail(), filter) {
+		return &corev1.GetInstalledPackageSummariesResponse{
+			InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
+		}, nil
+	}
 
 	// Assume the default cluster if none is specified
 	if cluster == "" {
@@ -291,13 +298,24 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	if err != nil {
 		return nil, statuserror.FromK8sError("get", "PackageInstall", "", err)
 	}
+	// The package installs are listed by namespace, but are sorted by name,
+	// as the core server merges the summaries of the plugins by name.
+	sort.SliceStable(pkgInstalls, func(i, j int) bool {
+		if pkgInstalls[i].Name != pkgInstalls[j].Name {
+			return pkgInstalls[i].Name < pkgInstalls[j].Name
+		}
+		return pkgInstalls[i].Namespace < pkgInstalls[j].Namespace
+	})
 
 	// paginate the list of results
 	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
 
+	// When filtering, the summaries of all the package installs are required
+	// to paginate the matching results.
+	filtered := !pkgutils.IsInstalledPackageFilterEmpty(filter)
 	if len(pkgInstalls) > 0 {
 		startAt := -1
-		if pageSize > 0 {
+		if pageSize > 0 && !filtered {
 			startAt = itemOffset
 			if startAt > len(pkgInstalls) {
 				return nil, status.Errorf(codes.InvalidArgument, "invalid pagination arguments %v", request.GetPaginationOptions())
@@ -308,135 +326,281 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 			}
 		}
 
-		// Collect a set of all package names with their corresponding package
-		// metadata and package version data.
-		// Complication 1: PackageMetadata and Package resources are not CRs,
-		// but rather aggregated API resources, which means a PackageInstall in
-		// namespace "default" may refer to a Package (and metadata) in the same
-		// "default" namespace, or from the global namespace.
-		// Complication 2: PackageMetadata and Package resources for can be
-		// present in different namespaces with the same name and different
-		// versions etc.
-		// With this in mind, we create a data structure that records the
-		// metadata and versions per package refName per namespace.
-		// TODO(minelson) move this struct initialization out.
-		type pkgMetaAndVersionsData struct {
-			meta     *datapackagingv1alpha1.PackageMetadata
-			versions map[string]*datapackagingv1alpha1.Package
-		}
-		pkgDatas := make(map[string]map[string]*pkgMetaAndVersionsData)
-		for _, pkgInstall := range pkgInstalls {
-			pkgDataForNamespaces, ok := pkgDatas[pkgInstall.Spec.PackageRef.RefName]
+		installedPkgSummaries, err = s.buildInstalledPackageSummaries(ctx, cluster, namespace, pkgInstalls, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if pageSize > 0 && filtered {
+			if itemOffset > len(installedPkgSummaries) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pagination arguments %v", request.GetPaginationOptions())
+			}
+			installedPkgSummaries = installedPkgSummaries[itemOffset:]
+			if len(installedPkgSummaries) > int(pageSize) {
+				installedPkgSummaries = installedPkgSummaries[:pageSize]
+			}
+		}
+	}
+
+	// Only return a next page token if the request was for pagination and
+	// the results are a full page.
+	nextPageToken := ""
+	if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
+		nextPageToken = fmt.Sprintf("%d", itemOffset+int(pageSize))
+	}
+	response := &corev1.GetInstalledPackageSummariesResponse{
+		InstalledPackageSummaries: installedPkgSummaries,
+		NextPageToken:             nextPageToken,
+	}
+	return response, nil
+}
+
+// WatchInstalledPackageSummaries streams the changes to the installed packages managed by the 'kapp_controller' plugin
+func (s *Server) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	// Retrieve parameters from the request
+	namespace := request.GetContext().GetNamespace()
+	cluster := request.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q)", cluster, namespace)
+	log.Infof("+kapp-controller WatchInstalledPackageSummaries %s", contextMsg)
+
+	// Assume the default cluster if none is specified
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	ctx := stream.Context()
+	watcher, err := s.watchPkgInstalls(ctx, cluster, namespace)
+	if err != nil {
+		return statuserror.FromK8sError("watch", "PackageInstall", "", err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return statuserror.FromK8sError("watch", "PackageInstall", "", errors.FromObject(event.Object))
+			}
+			unstructuredPkgInstall, ok := event.Object.(*unstructured.Unstructured)
 			if !ok {
-				pkgDataForNamespaces = map[string]*pkgMetaAndVersionsData{}
-				pkgDatas[pkgInstall.Spec.PackageRef.RefName] = pkgDataForNamespaces
+				continue
+			}
+			pkgInstall := &packagingv1alpha1.PackageInstall{}
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPkgInstall.Object, pkgInstall)
+			if err != nil {
+				return status.Errorf(codes.Internal, "unable to convert the PackageInstall: %v", err)
+			}
+
+			response := &corev1.WatchInstalledPackageSummariesResponse{}
+			switch event.Type {
+			case watch.Added:
+				response.EventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED
+			case watch.Modified:
+				response.EventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED
+			case watch.Deleted:
+				response.EventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED
+			default:
+				continue
 			}
-			// As each package install could potentially be from a pkg in the same
-			// namespace or a package in the global namespace, we track both.
-			for _, ns := range []string{pkgInstall.Namespace, s.globalPackagingNamespace} {
-				pkgData, ok := pkgDataForNamespaces[ns]
-				if !ok {
-					pkgData = &pkgMetaAndVersionsData{
-						versions: make(map[string]*datapackagingv1alpha1.Package),
-					}
-					pkgDataForNamespaces[ns] = pkgData
+
+			if event.Type == watch.Deleted {
+				response.InstalledPackageSummary = &corev1.InstalledPackageSummary{
+					InstalledPackageRef: &corev1.InstalledPackageReference{
+						Context: &corev1.Context{
+							Cluster:   cluster,
+							Namespace: pkgInstall.Namespace,
+						},
+						Plugin:     &pluginDetail,
+						Identifier: pkgInstall.Name,
+					},
+					Name: pkgInstall.Name,
 				}
-				_, ok = pkgData.versions[pkgInstall.Status.Version]
-				if !ok {
-					pkgData.versions[pkgInstall.Status.Version] = nil
+			} else {
+				installedPkgSummary, err := s.buildInstalledPackageSummaryForPkgInstall(ctx, cluster, pkgInstall)
+				if err != nil {
+					return err
 				}
+				response.InstalledPackageSummary = installedPkgSummary
 			}
-		}
 
-		// First get all the package metadatas for the namespace (or across
-		// namespaces) and filter to match the pkgInstalls. While filtering, we
-		// populate the collected package data with the metadata.
-		pkgMetadatas, err := s.getPkgMetadatas(ctx, cluster, namespace)
-		if err != nil {
-			return nil, statuserror.FromK8sError("get", "PackageMetadata", "", err)
-		}
-		for _, pm := range pkgMetadatas {
-			if pkgDataForNamespaces, ok := pkgDatas[pm.Name]; ok {
-				if pkgData, ok := pkgDataForNamespaces[pm.Namespace]; ok {
-					pkgData.meta = pm
-				}
+			if err = stream.Send(response); err != nil {
+				return err
 			}
 		}
+	}
+}
+
+// buildInstalledPackageSummaryForPkgInstall returns the installed package summary
+// for a single package install, fetching only the package metadata and packages
+// which it refers to.
+func (s *Server) buildInstalledPackageSummaryForPkgInstall(ctx context.Context, cluster string, pkgInstall *packagingv1alpha1.PackageInstall) (*corev1.InstalledPackageSummary, error) {
+	// As with the installed package detail, the package metadata and packages
+	// available in the namespace of the package install include those of the
+	// global namespace.
+	pkgName := pkgInstall.Spec.PackageRef.RefName
+	pkgMetadata, err := s.getPkgMetadata(ctx, cluster, pkgInstall.Namespace, pkgName)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "PackageMetadata", pkgName, err)
+	}
+
+	// Use the field selector to return only Package CRs that match on the spec.refName.
+	fieldSelector := fmt.Sprintf("spec.refName=%s", pkgName)
+	pkgs, err := s.getPkgsWithFieldSelector(ctx, cluster, pkgInstall.Namespace, fieldSelector)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "Package", "", err)
+	}
+	pkgVersionsMap, err := getPkgVersionsMap(pkgs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to calculate package versions map: %v", err)
+	}
+
+	installedPkgSummary, err := s.buildInstalledPackageSummary(pkgInstall, pkgMetadata, pkgVersionsMap, cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create the InstalledPackageSummary: %v", err)
+	}
+	return installedPkgSummary, nil
+}
+
+// buildInstalledPackageSummaries returns the installed package summaries for the
+// given package installs, fetching the package metadatas and packages in the
+// namespace (or across namespaces) required to populate them. Only the summaries
+// matching the filter options, if any, are returned.
+func (s *Server) buildInstalledPackageSummaries(ctx context.Context, cluster, namespace string, pkgInstalls []*packagingv1alpha1.PackageInstall, filter *corev1.InstalledPackageFilterOptions) ([]*corev1.InstalledPackageSummary, error) {
+	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
 
-		// Create a channel to receive all packages available in the namespace
-		// (or across namespaces) Using a buffered channel so that we don't
-		// block the network request if we can't process fast enough.
-		getPkgsChannel := make(chan *datapackagingv1alpha1.Package, PACKAGES_CHANNEL_BUFFER_SIZE)
-		var getPkgsError error
-		go func() {
-			getPkgsError = s.getPkgs(ctx, cluster, namespace, getPkgsChannel)
-		}()
-
-		// For each package, we check if we need it to populate our
-		// package data.
-		pkgsForVersionMap := []*datapackagingv1alpha1.Package{}
-		for pkg := range getPkgsChannel {
-			pkgDataForNamespaces, ok := pkgDatas[pkg.Spec.RefName]
+	// Collect a set of all package names with their corresponding package
+	// metadata and package version data.
+	// Complication 1: PackageMetadata and Package resources are not CRs,
+	// but rather aggregated API resources, which means a PackageInstall in
+	// namespace "default" may refer to a Package (and metadata) in the same
+	// "default" namespace, or from the global namespace.
+	// Complication 2: PackageMetadata and Package resources for can be
+	// present in different namespaces with the same name and different
+	// versions etc.
+	// With this in mind, we create a data structure that records the
+	// metadata and versions per package refName per namespace.
+	// TODO(minelson) move this struct initialization out.
+	type pkgMetaAndVersionsData struct {
+		meta     *datapackagingv1alpha1.PackageMetadata
+		versions map[string]*datapackagingv1alpha1.Package
+	}
+	pkgDatas := make(map[string]map[string]*pkgMetaAndVersionsData)
+	for _, pkgInstall := range pkgInstalls {
+		pkgDataForNamespaces, ok := pkgDatas[pkgInstall.Spec.PackageRef.RefName]
+		if !ok {
+			pkgDataForNamespaces = map[string]*pkgMetaAndVersionsData{}
+			pkgDatas[pkgInstall.Spec.PackageRef.RefName] = pkgDataForNamespaces
+		}
+		// As each package install could potentially be from a pkg in the same
+		// namespace or a package in the global namespace, we track both.
+		for _, ns := range []string{pkgInstall.Namespace, s.globalPackagingNamespace} {
+			pkgData, ok := pkgDataForNamespaces[ns]
 			if !ok {
-				continue
+				pkgData = &pkgMetaAndVersionsData{
+					versions: make(map[string]*datapackagingv1alpha1.Package),
+				}
+				pkgDataForNamespaces[ns] = pkgData
 			}
-			pkgData, ok := pkgDataForNamespaces[pkg.Namespace]
+			_, ok = pkgData.versions[pkgInstall.Status.Version]
 			if !ok {
-				continue
+				pkgData.versions[pkgInstall.Status.Version] = nil
 			}
-			pkgsForVersionMap = append(pkgsForVersionMap, pkg)
-			_, ok = pkgData.versions[pkg.Spec.Version]
-			if !ok {
-				continue
+		}
+	}
+
+	// First get all the package metadatas for the namespace (or across
+	// namespaces) and filter to match the pkgInstalls. While filtering, we
+	// populate the collected package data with the metadata.
+	pkgMetadatas, err := s.getPkgMetadatas(ctx, cluster, namespace)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "PackageMetadata", "", err)
+	}
+	for _, pm := range pkgMetadatas {
+		if pkgDataForNamespaces, ok := pkgDatas[pm.Name]; ok {
+			if pkgData, ok := pkgDataForNamespaces[pm.Namespace]; ok {
+				pkgData.meta = pm
 			}
-			pkgData.versions[pkg.Spec.Version] = pkg
 		}
+	}
+
+	// Create a channel to receive all packages available in the namespace
+	// (or across namespaces) Using a buffered channel so that we don't
+	// block the network request if we can't process fast enough.
+	getPkgsChannel := make(chan *datapackagingv1alpha1.Package, PACKAGES_CHANNEL_BUFFER_SIZE)
+	var getPkgsError error
+	go func() {
+		getPkgsError = s.getPkgs(ctx, cluster, namespace, getPkgsChannel)
+	}()
 
-		// Verify no error during go routine.
-		if getPkgsError != nil {
-			return nil, statuserror.FromK8sError("get", "Package", "", err)
+	// For each package, we check if we need it to populate our
+	// package data.
+	pkgsForVersionMap := []*datapackagingv1alpha1.Package{}
+	for pkg := range getPkgsChannel {
+		pkgDataForNamespaces, ok := pkgDatas[pkg.Spec.RefName]
+		if !ok {
+			continue
+		}
+		pkgData, ok := pkgDataForNamespaces[pkg.Namespace]
+		if !ok {
+			continue
 		}
+		pkgsForVersionMap = append(pkgsForVersionMap, pkg)
+		_, ok = pkgData.versions[pkg.Spec.Version]
+		if !ok {
+			continue
+		}
+		pkgData.versions[pkg.Spec.Version] = pkg
+	}
+
+	// Verify no error during go routine.
+	if getPkgsError != nil {
+		return nil, statuserror.FromK8sError("get", "Package", "", err)
+	}
 
-		// Calculate the version map for all packages that we're interested
-		// in for this paginated call.
-		// TODO(minelson): Check if getPkgVersionsMap currently handles packages
-		// in different namespaces (suspect not).
-		pkgVersionsMap, err := getPkgVersionsMap(pkgsForVersionMap)
+	// Calculate the version map for all packages that we're interested
+	// in for this paginated call.
+	// TODO(minelson): Check if getPkgVersionsMap currently handles packages
+	// in different namespaces (suspect not).
+	pkgVersionsMap, err := getPkgVersionsMap(pkgsForVersionMap)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to calculate package versions map: %v", err))
+	}
+
+	// We now have all the data we need to return the result:
+	for _, pkgi := range pkgInstalls {
+		pkgName := pkgi.Spec.PackageRef.RefName
+		pkgDataForNamespaces := pkgDatas[pkgName]
+		// Check if there was package metadata for the specific namespace.
+		pkgData := pkgDataForNamespaces[pkgi.Namespace]
+		if pkgData.meta == nil {
+			pkgData = pkgDataForNamespaces[s.globalPackagingNamespace]
+		}
+		// generate the installedPackageSummary from the fetched information
+		installedPackageSummary, err := s.buildInstalledPackageSummary(pkgi, pkgData.meta, pkgVersionsMap, cluster)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to calculate package versions map: %v", err))
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to create the InstalledPackageSummary: %v", err))
 		}
 
-		// We now have all the data we need to return the result:
-		for _, pkgi := range pkgInstalls {
-			pkgName := pkgi.Spec.PackageRef.RefName
-			pkgDataForNamespaces := pkgDatas[pkgName]
-			// Check if there was package metadata for the specific namespace.
-			pkgData := pkgDataForNamespaces[pkgi.Namespace]
-			if pkgData.meta == nil {
-				pkgData = pkgDataForNamespaces[s.globalPackagingNamespace]
+		// skip the installed packages not matching the filter options, if any
+		if !pkgutils.IsInstalledPackageFilterEmpty(filter) {
+			repoName := ""
+			if pkg := pkgData.versions[pkgi.Status.Version]; pkg != nil {
+				repoName = packageRepositoryName(pkg)
 			}
-			// generate the installedPackageSummary from the fetched information
-			installedPackageSummary, err := s.buildInstalledPackageSummary(pkgi, pkgData.meta, pkgVersionsMap, cluster)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to create the InstalledPackageSummary: %v", err))
+			if !pkgutils.InstalledPackageSummaryMatchesFilter(installedPackageSummary, GetPluginDetail(), repoName, filter) {
+				continue
 			}
-
-			// append the availablePackageSummary to the slice
-			installedPkgSummaries = append(installedPkgSummaries, installedPackageSummary)
 		}
-	}
 
-	// Only return a next page token if the request was for pagination and
-	// the results are a full page.
-	nextPageToken := ""
-	if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
-		nextPageToken = fmt.Sprintf("%d", itemOffset+int(pageSize))
-	}
-	response := &corev1.GetInstalledPackageSummariesResponse{
-		InstalledPackageSummaries: installedPkgSummaries,
-		NextPageToken:             nextPageToken,
+		// append the availablePackageSummary to the slice
+		installedPkgSummaries = append(installedPkgSummaries, installedPackageSummary)
 	}
-	return response, nil
+	return installedPkgSummaries, nil
 }
 
 // GetInstalledPackageDetail returns the package metadata managed by the 'kapp_controller' plugin
@@ -577,6 +741,11 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		return nil, statuserror.FromK8sError("get", "PackageMetadata", packageRef.Identifier, err)
 	}
 
+	// validate the values against the values schema of the package
+	if err := s.validateValues(ctx, packageCluster, packageNamespace, pkgMetadata.Name, pkgVersion, values); err != nil {
+		return nil, err
+	}
+
 	// build a new secret object with the values
 	secret, err := s.buildSecret(installedPackageName, values, targetNamespace)
 	if err != nil {
@@ -684,6 +853,11 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 		return nil, statuserror.FromK8sError("get", "PackageInstall", installedPackageName, err)
 	}
 
+	// validate the values against the values schema of the package
+	if err := s.validateValues(ctx, packageCluster, packageNamespace, pkgInstall.Spec.PackageRef.RefName, pkgVersion, values); err != nil {
+		return nil, err
+	}
+
 	// Calculate the constraints and prerelease fields
 	versionConstraints, err := pkgutils.VersionConstraintWithUpgradePolicy(pkgVersion, s.pluginConfig.defaultUpgradePolicy)
 	if err != nil {
@@ -860,3 +1034,80 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 		ResourceRefs: refs,
 	}, nil
 }
+
+// GetInstalledPackageUpdatePreview is not supported by the 'kapp_controller' plugin: the templates of a package
+// are fetched and rendered by kapp-controller itself (using imgpkg, ytt, etc.), which cannot be done without changing
+// the cluster. This is reported by the plugin capabilities, so that clients do not offer the preview.
+func (s *Server) GetInstalledPackageUpdatePreview(ctx context.Context, request *corev1.GetInstalledPackageUpdatePreviewRequest) (*corev1.GetInstalledPackageUpdatePreviewResponse, error) {
+	log.Infof("+kapp-controller GetInstalledPackageUpdatePreview")
+	return nil, status.Errorf(codes.Unimplemented, "previewing the changes of an update is not supported by the kapp_controller plugin")
+}
+
+// CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform to create or update an installed package managed by the 'kapp_controller' plugin
+func (s *Server) CheckInstalledPackagePermissions(ctx context.Context, request *corev1.CheckInstalledPackagePermissionsRequest) (*corev1.CheckInstalledPackagePermissionsResponse, error) {
+	// Retrieve parameters from the request
+	cluster := request.GetInstalledPackageRef().GetContext().GetCluster()
+	namespace := request.GetInstalledPackageRef().GetContext().GetNamespace()
+	identifier := request.GetInstalledPackageRef().GetIdentifier()
+	contextMsg := fmt.Sprintf("(cluster=%q, namespace=%q, id=%q)", cluster, namespace, identifier)
+	log.Infof("+kapp-controller CheckInstalledPackagePermissions %s", contextMsg)
+
+	// Validate the request
+	if request == nil || request.GetInstalledPackageRef() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+	if namespace == "" || identifier == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "required context or identifier not provided")
+	}
+
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	typedClient, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resources of the package are deployed by kapp-controller using the service account
+	// of the PackageInstall, so only the PackageInstall and its values Secret are checked.
+	action := permissions.ActionUpdate
+	if _, err := s.getPkgInstall(ctx, cluster, namespace, identifier); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, statuserror.FromK8sError("get", "PackageInstall", identifier, err)
+		}
+		if request.GetAvailablePackageRef() == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided to check the creation of %q", identifier)
+		}
+		action = permissions.ActionCreate
+	}
+
+	secret, err := s.buildSecret(identifier, request.GetValues(), namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to build the values Secret: '%v'", err)
+	}
+	manifest := strings.Join([]string{
+		permissions.ResourceManifest(packagingv1alpha1.SchemeGroupVersion.String(), pkgInstallResource, identifier, namespace),
+		permissions.ResourceManifest(k8scorev1.SchemeGroupVersion.String(), "Secret", secret.Name, namespace),
+	}, "---\n")
+	actions, err := permissions.ForbiddenActions(typedClient, namespace, action, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.CheckInstalledPackagePermissionsResponse{
+		ForbiddenActions: actions,
+	}, nil
+}
+
+// validateValues validates the values against the values schema of the
+// requested version of the package, if any.
+func (s *Server) validateValues(ctx context.Context, cluster, namespace, refName, version, values string) error {
+	// Use the field selector to return only Package CRs that match on the spec.refName.
+	fieldSelector := fmt.Sprintf("spec.refName=%s", refName)
+	pkgs, err := s.getPkgsWithFieldSelector(ctx, cluster, namespace, fieldSelector)
+	if err != nil {
+		return statuserror.FromK8sError("get", "Package", refName, err)
+	}
+	return validatePkgValues(pkgs, refName, version, values)
+}
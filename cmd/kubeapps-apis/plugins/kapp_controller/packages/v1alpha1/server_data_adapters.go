@@ -19,12 +19,13 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	log "k8s.io/klog/v2"
 )
 
-func (s *Server) buildAvailablePackageSummary(pkgMetadata *datapackagingv1alpha1.PackageMetadata, latestVersion string, cluster string) *corev1.AvailablePackageSummary {
+func (s *Server) buildAvailablePackageSummary(pkgMetadata *datapackagingv1alpha1.PackageMetadata, latestPkg pkgSemver, cluster string) *corev1.AvailablePackageSummary {
 	var iconStringBuilder strings.Builder
 
 	// Carvel uses base64-encoded SVG data for IconSVGBase64, whereas we need
@@ -51,13 +52,17 @@ func (s *Server) buildAvailablePackageSummary(pkgMetadata *datapackagingv1alpha1
 		// Currently, PkgVersion and AppVersion are the same
 		// https://kubernetes.slack.com/archives/CH8KCCKA5/p1636386358322000?thread_ts=1636371493.320900&cid=CH8KCCKA5
 		LatestVersion: &corev1.PackageAppVersion{
-			PkgVersion: latestVersion,
-			AppVersion: latestVersion,
+			PkgVersion: latestPkg.version.String(),
+			AppVersion: latestPkg.version.String(),
 		},
 		IconUrl:          iconStringBuilder.String(),
 		DisplayName:      pkgMetadata.Spec.DisplayName,
 		ShortDescription: pkgMetadata.Spec.ShortDescription,
 		Categories:       pkgMetadata.Spec.Categories,
+		RepositoryName:   packageRepositoryName(latestPkg.pkg),
+	}
+	if !latestPkg.pkg.Spec.ReleasedAt.IsZero() {
+		availablePackageSummary.LatestVersionUpdated = timestamppb.New(latestPkg.pkg.Spec.ReleasedAt.Time)
 	}
 
 	return availablePackageSummary
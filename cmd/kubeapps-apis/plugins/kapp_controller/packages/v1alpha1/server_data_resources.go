@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -302,6 +303,15 @@ func (s *Server) getPkgInstalls(ctx context.Context, cluster, namespace string)
 	return pkgInstalls, nil
 }
 
+// watchPkgInstalls returns a watcher for the package installs in the given cluster and namespace
+func (s *Server) watchPkgInstalls(ctx context.Context, cluster, namespace string) (watch.Interface, error) {
+	resource, err := s.getPkgInstallResource(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Watch(ctx, metav1.ListOptions{})
+}
+
 // getPkgRepositories returns the list of package repositories for the given cluster and namespace
 func (s *Server) getPkgRepositories(ctx context.Context, cluster, namespace string) ([]*packagingv1alpha1.PackageRepository, error) {
 	resource, err := s.getPkgRepositoryResource(ctx, cluster, namespace)
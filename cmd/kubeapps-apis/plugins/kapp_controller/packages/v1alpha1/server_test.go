@@ -31,20 +31,25 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	pluginv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	disfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/yaml"
 )
 
@@ -52,10 +57,12 @@ var ignoreUnexported = cmpopts.IgnoreUnexported(
 	corev1.AvailablePackageDetail{},
 	corev1.AvailablePackageReference{},
 	corev1.AvailablePackageSummary{},
+	corev1.CheckInstalledPackagePermissionsResponse{},
 	corev1.Context{},
 	corev1.CreateInstalledPackageResponse{},
 	corev1.CreateInstalledPackageResponse{},
 	corev1.DeleteInstalledPackageResponse{},
+	corev1.ForbiddenAction{},
 	corev1.GetAvailablePackageVersionsResponse{},
 	corev1.GetAvailablePackageVersionsResponse{},
 	corev1.GetInstalledPackageResourceRefsResponse{},
@@ -73,6 +80,7 @@ var ignoreUnexported = cmpopts.IgnoreUnexported(
 	kappControllerPluginParsedConfig{},
 	pluginv1.Plugin{},
 	v1alpha1.PackageRepository{},
+	timestamppb.Timestamp{},
 )
 
 var defaultContext = &corev1.Context{Cluster: "default", Namespace: "default"}
@@ -308,9 +316,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.3",
 						AppVersion: "1.2.3",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "A great game for arcade gamers",
-					Categories:       []string{"logging", "daemon-set"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
 				},
 				{
 					AvailablePackageRef: &corev1.AvailablePackageReference{
@@ -324,9 +333,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.5",
 						AppVersion: "1.2.5",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "An awesome game from the 90's",
-					Categories:       []string{"platforms", "rpg"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1997, time.December, 25, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "An awesome game from the 90's",
+					Categories:           []string{"platforms", "rpg"},
 				},
 			},
 		},
@@ -443,9 +453,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.3",
 						AppVersion: "1.2.3",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "A great game for arcade gamers",
-					Categories:       []string{"logging", "daemon-set"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
 				},
 				{
 					AvailablePackageRef: &corev1.AvailablePackageReference{
@@ -459,9 +470,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.5",
 						AppVersion: "1.2.5",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "An awesome game from the 90's",
-					Categories:       []string{"platforms", "rpg"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1997, time.December, 25, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "An awesome game from the 90's",
+					Categories:           []string{"platforms", "rpg"},
 				},
 			},
 		},
@@ -520,9 +532,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.3",
 						AppVersion: "1.2.3",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "A great game for arcade gamers",
-					Categories:       []string{"logging", "daemon-set"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
 				},
 			},
 		},
@@ -617,9 +630,111 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.10",
 						AppVersion: "1.2.10",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "A great game for arcade gamers",
-					Categories:       []string{"logging", "daemon-set"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
+				},
+			},
+		},
+		{
+			name: "it returns the latest version matching the semver constraint of the version filters",
+			existingObjects: []k8sruntime.Object{
+				&datapackagingv1alpha1.PackageMetadata{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgMetadataResource,
+						APIVersion: datapackagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "tetris.foo.example.com",
+					},
+					Spec: datapackagingv1alpha1.PackageMetadataSpec{
+						DisplayName:        "Classic Tetris",
+						IconSVGBase64:      "Tm90IHJlYWxseSBTVkcK",
+						ShortDescription:   "A great game for arcade gamers",
+						LongDescription:    "A few sentences but not really a readme",
+						Categories:         []string{"logging", "daemon-set"},
+						Maintainers:        []datapackagingv1alpha1.Maintainer{{Name: "person1"}, {Name: "person2"}},
+						SupportDescription: "Some support information",
+						ProviderName:       "Tetris inc.",
+					},
+				},
+				&datapackagingv1alpha1.Package{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgResource,
+						APIVersion: datapackagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "tetris.foo.example.com.1.2.3",
+					},
+					Spec: datapackagingv1alpha1.PackageSpec{
+						RefName:                         "tetris.foo.example.com",
+						Version:                         "1.2.3",
+						Licenses:                        []string{"my-license"},
+						ReleaseNotes:                    "release notes",
+						CapactiyRequirementsDescription: "capacity description",
+						ReleasedAt:                      metav1.Time{Time: time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+				&datapackagingv1alpha1.Package{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgResource,
+						APIVersion: datapackagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "tetris.foo.example.com.1.2.10",
+					},
+					Spec: datapackagingv1alpha1.PackageSpec{
+						RefName:                         "tetris.foo.example.com",
+						Version:                         "1.2.10",
+						Licenses:                        []string{"my-license"},
+						ReleaseNotes:                    "release notes",
+						CapactiyRequirementsDescription: "capacity description",
+						ReleasedAt:                      metav1.Time{Time: time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+				&datapackagingv1alpha1.Package{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgResource,
+						APIVersion: datapackagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "tetris.foo.example.com.1.2.4",
+					},
+					Spec: datapackagingv1alpha1.PackageSpec{
+						RefName:                         "tetris.foo.example.com",
+						Version:                         "1.2.4",
+						Licenses:                        []string{"my-license"},
+						ReleaseNotes:                    "release notes",
+						CapactiyRequirementsDescription: "capacity description",
+						ReleasedAt:                      metav1.Time{Time: time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			},
+			filterOptions: corev1.FilterOptions{
+				PkgVersion: ">=1.2.4 <1.2.10",
+			},
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				{
+					AvailablePackageRef: &corev1.AvailablePackageReference{
+						Context:    defaultContext,
+						Plugin:     &pluginDetail,
+						Identifier: "tetris.foo.example.com",
+					},
+					Name:        "tetris.foo.example.com",
+					DisplayName: "Classic Tetris",
+					LatestVersion: &corev1.PackageAppVersion{
+						PkgVersion: "1.2.4",
+						AppVersion: "1.2.4",
+					},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
 				},
 			},
 		},
@@ -758,9 +873,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.5",
 						AppVersion: "1.2.5",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "An awesome game from the 90's",
-					Categories:       []string{"platforms", "rpg"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1997, time.December, 25, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "An awesome game from the 90's",
+					Categories:           []string{"platforms", "rpg"},
 				},
 				{
 					AvailablePackageRef: &corev1.AvailablePackageReference{
@@ -774,9 +890,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "3.2.5",
 						AppVersion: "3.2.5",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "Another awesome game from the 90's",
-					Categories:       []string{"platforms", "rpg"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1997, time.December, 25, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "Another awesome game from the 90's",
+					Categories:           []string{"platforms", "rpg"},
 				},
 			},
 		},
@@ -877,9 +994,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.3",
 						AppVersion: "1.2.3",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "A great game for arcade gamers",
-					Categories:       []string{"logging", "daemon-set"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
 				},
 			},
 		},
@@ -979,9 +1097,10 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						PkgVersion: "1.2.3",
 						AppVersion: "1.2.3",
 					},
-					IconUrl:          "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
-					ShortDescription: "A great game for arcade gamers",
-					Categories:       []string{"logging", "daemon-set"},
+					LatestVersionUpdated: timestamppb.New(time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+					IconUrl:              "data:image/svg+xml;base64,Tm90IHJlYWxseSBTVkcK",
+					ShortDescription:     "A great game for arcade gamers",
+					Categories:           []string{"logging", "daemon-set"},
 				},
 			},
 		},
@@ -1031,6 +1150,132 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 	}
 }
 
+func TestGetAvailablePackageSummariesSortAndFacets(t *testing.T) {
+	pkgMeta := func(name string, categories ...string) k8sruntime.Object {
+		return &datapackagingv1alpha1.PackageMetadata{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgMetadataResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: datapackagingv1alpha1.PackageMetadataSpec{
+				DisplayName: name,
+				Categories:  categories,
+			},
+		}
+	}
+	pkg := func(refName, version, repoRef string, releasedAt time.Time) k8sruntime.Object {
+		return &datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        refName + "." + version,
+				Annotations: map[string]string{packageRepositoryRefAnnotation: repoRef},
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName:    refName,
+				Version:    version,
+				ReleasedAt: metav1.Time{Time: releasedAt},
+			},
+		}
+	}
+	existingObjects := []k8sruntime.Object{
+		pkgMeta("tetris.foo.example.com", "arcade"),
+		pkgMeta("tombi.foo.example.com", "platforms", "rpg"),
+		pkgMeta("tunotherone.foo.example.com", "arcade"),
+		pkg("tetris.foo.example.com", "1.2.3", "default/tce-repo", time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)),
+		pkg("tombi.foo.example.com", "1.2.5", "default/tce-repo", time.Date(1997, time.December, 25, 0, 0, 0, 0, time.UTC)),
+		pkg("tunotherone.foo.example.com", "3.2.5", "kapp-controller-packaging-global/another-repo", time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	testCases := []struct {
+		name              string
+		paginationOptions *corev1.PaginationOptions
+		sortBy            corev1.FilterOptions_SortBy
+		expectedNames     []string
+		expectedFacets    *corev1.AvailablePackageFacets
+	}{
+		{
+			name:          "it sorts the summaries by the release date of their latest version and returns the facets",
+			sortBy:        corev1.FilterOptions_SORT_BY_LAST_UPDATED,
+			expectedNames: []string{"tombi.foo.example.com", "tunotherone.foo.example.com", "tetris.foo.example.com"},
+			expectedFacets: &corev1.AvailablePackageFacets{
+				Categories: []*corev1.FacetCount{
+					{Value: "arcade", Count: 2},
+					{Value: "platforms", Count: 1},
+					{Value: "rpg", Count: 1},
+				},
+				Repositories: []*corev1.FacetCount{
+					{Value: "tce-repo", Count: 2},
+					{Value: "another-repo", Count: 1},
+				},
+				Plugins: []*corev1.FacetCount{
+					{Value: "kapp_controller.packages", Count: 3},
+				},
+			},
+		},
+		{
+			name:              "it sorts the summaries by repository and returns no facets after the first page",
+			paginationOptions: &corev1.PaginationOptions{PageToken: "1", PageSize: 2},
+			sortBy:            corev1.FilterOptions_SORT_BY_REPOSITORY,
+			expectedNames:     []string{"tetris.foo.example.com", "tombi.foo.example.com"},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(corev1.AvailablePackageFacets{}, corev1.FacetCount{})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var unstructuredObjects []k8sruntime.Object
+			for _, obj := range existingObjects {
+				unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+			}
+
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().
+						WithDynamic(dynfake.NewSimpleDynamicClientWithCustomListKinds(
+							k8sruntime.NewScheme(),
+							map[schema.GroupVersionResource]string{
+								{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+								{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
+							},
+							unstructuredObjects...,
+						)).Build(), nil
+				},
+			}
+
+			response, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context:           defaultContext,
+				PaginationOptions: tc.paginationOptions,
+				FilterOptions:     &corev1.FilterOptions{SortBy: tc.sortBy},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			names := []string{}
+			for _, summary := range response.AvailablePackageSummaries {
+				names = append(names, summary.Name)
+			}
+			if got, want := names, tc.expectedNames; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := response.Facets, tc.expectedFacets; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
 func TestGetAvailablePackageVersions(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -2546,6 +2791,347 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageSummariesWithFilters(t *testing.T) {
+	pkgInstall := func(name, refName, version string) *packagingv1alpha1.PackageInstall {
+		return &packagingv1alpha1.PackageInstall{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgInstallResource,
+				APIVersion: packagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: packagingv1alpha1.PackageInstallSpec{
+				ServiceAccountName: "default",
+				PackageRef: &packagingv1alpha1.PackageRef{
+					RefName: refName,
+					VersionSelection: &vendirversions.VersionSelectionSemver{
+						Constraints: version,
+					},
+				},
+			},
+			Status: packagingv1alpha1.PackageInstallStatus{
+				GenericStatus: kappctrlv1alpha1.GenericStatus{
+					ObservedGeneration: 1,
+					Conditions: []kappctrlv1alpha1.Condition{{
+						Type:   kappctrlv1alpha1.ReconcileSucceeded,
+						Status: k8scorev1.ConditionTrue,
+					}},
+				},
+				Version:              version,
+				LastAttemptedVersion: version,
+			},
+		}
+	}
+	pkgMetadata := func(name, displayName string) *datapackagingv1alpha1.PackageMetadata {
+		return &datapackagingv1alpha1.PackageMetadata{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgMetadataResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: datapackagingv1alpha1.PackageMetadataSpec{
+				DisplayName: displayName,
+			},
+		}
+	}
+	pkg := func(refName, version string, annotations map[string]string) *datapackagingv1alpha1.Package {
+		return &datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        refName + "." + version,
+				Annotations: annotations,
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: refName,
+				Version: version,
+			},
+		}
+	}
+	repoAnnotations := map[string]string{packageRepositoryRefAnnotation: "default/tce-repo"}
+	// The package installs of another namespace are listed after those of
+	// the default namespace, but are sorted by name.
+	otherNamespaceMetadata := pkgMetadata("tetris.foo.example.com", "Classic Tetris")
+	otherNamespaceMetadata.Namespace = "z-ns"
+	otherNamespacePkg := pkg("tetris.foo.example.com", "1.2.3", nil)
+	otherNamespacePkg.Namespace = "z-ns"
+	otherNamespaceInstall := pkgInstall("a-tetris", "tetris.foo.example.com", "1.2.3")
+	otherNamespaceInstall.Namespace = "z-ns"
+	existingObjects := []k8sruntime.Object{
+		pkgMetadata("tetris.foo.example.com", "Classic Tetris"),
+		pkg("tetris.foo.example.com", "1.2.3", repoAnnotations),
+		pkg("tetris.foo.example.com", "2.0.0", repoAnnotations),
+		pkgInstall("my-tetris", "tetris.foo.example.com", "1.2.3"),
+		pkgMetadata("pacman.foo.example.com", "Pacman"),
+		pkg("pacman.foo.example.com", "1.0.0", nil),
+		pkgInstall("my-pacman", "pacman.foo.example.com", "1.0.0"),
+		otherNamespaceMetadata,
+		otherNamespacePkg,
+		otherNamespaceInstall,
+	}
+
+	testCases := []struct {
+		name                  string
+		request               *corev1.GetInstalledPackageSummariesRequest
+		expectedIdentifiers   []string
+		expectedNextPageToken string
+	}{
+		{
+			name: "it returns the installed packages matching a query on the display name",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context:       defaultContext,
+				FilterOptions: &corev1.InstalledPackageFilterOptions{Query: "classic"},
+			},
+			expectedIdentifiers: []string{"my-tetris"},
+		},
+		{
+			name: "it returns the installed packages from a package repository",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context:       defaultContext,
+				FilterOptions: &corev1.InstalledPackageFilterOptions{Repositories: []string{"tce-repo"}},
+			},
+			expectedIdentifiers: []string{"my-tetris"},
+		},
+		{
+			name: "it returns the installed packages with an upgrade available",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context:       defaultContext,
+				FilterOptions: &corev1.InstalledPackageFilterOptions{UpgradeAvailable: true},
+			},
+			expectedIdentifiers: []string{"my-tetris"},
+		},
+		{
+			name: "it paginates the matching installed packages",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: defaultContext,
+				PaginationOptions: &corev1.PaginationOptions{
+					PageSize: 1,
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{Repositories: []string{"tce-repo"}},
+			},
+			expectedIdentifiers:   []string{"my-tetris"},
+			expectedNextPageToken: "1",
+		},
+		{
+			name: "it returns an empty last page of the matching installed packages",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: defaultContext,
+				PaginationOptions: &corev1.PaginationOptions{
+					PageSize:  1,
+					PageToken: "1",
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{Repositories: []string{"tce-repo"}},
+			},
+			expectedIdentifiers: []string{},
+		},
+		{
+			name: "it returns the installed packages of all the namespaces sorted by name",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Cluster: defaultContext.Cluster},
+			},
+			expectedIdentifiers: []string{"a-tetris", "my-pacman", "my-tetris"},
+		},
+		{
+			name: "it returns no installed packages when the plugin is filtered out",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context:       defaultContext,
+				FilterOptions: &corev1.InstalledPackageFilterOptions{Plugins: []string{"helm.packages"}},
+			},
+			expectedIdentifiers: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var unstructuredObjects []k8sruntime.Object
+			for _, obj := range existingObjects {
+				unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+			}
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
+				},
+				unstructuredObjects...,
+			)
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().WithDynamic(dynamicClient).Build(), nil
+				},
+				globalPackagingNamespace: globalPackagingNamespace,
+			}
+
+			response, err := s.GetInstalledPackageSummaries(context.Background(), tc.request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			identifiers := []string{}
+			for _, summary := range response.InstalledPackageSummaries {
+				identifiers = append(identifiers, summary.GetInstalledPackageRef().GetIdentifier())
+			}
+			if got, want := identifiers, tc.expectedIdentifiers; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := response.NextPageToken, tc.expectedNextPageToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	pkgInstall := &packagingv1alpha1.PackageInstall{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgInstallResource,
+			APIVersion: packagingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-installation",
+		},
+		Spec: packagingv1alpha1.PackageInstallSpec{
+			ServiceAccountName: "default",
+			PackageRef: &packagingv1alpha1.PackageRef{
+				RefName: "tetris.foo.example.com",
+				VersionSelection: &vendirversions.VersionSelectionSemver{
+					Constraints: "1.2.3",
+				},
+			},
+		},
+		Status: packagingv1alpha1.PackageInstallStatus{
+			GenericStatus: kappctrlv1alpha1.GenericStatus{
+				ObservedGeneration: 1,
+				Conditions: []kappctrlv1alpha1.Condition{{
+					Type:    kappctrlv1alpha1.ReconcileSucceeded,
+					Status:  k8scorev1.ConditionTrue,
+					Reason:  "baz",
+					Message: "qux",
+				}},
+				FriendlyDescription: "foo",
+				UsefulErrorMessage:  "Deployed",
+			},
+			Version:              "1.2.3",
+			LastAttemptedVersion: "1.2.3",
+		},
+	}
+	existingObjects := []k8sruntime.Object{
+		&datapackagingv1alpha1.PackageMetadata{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgMetadataResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com",
+			},
+			Spec: datapackagingv1alpha1.PackageMetadataSpec{
+				DisplayName:      "Classic Tetris",
+				IconSVGBase64:    "Tm90IHJlYWxseSBTVkcK",
+				ShortDescription: "A great game for arcade gamers",
+			},
+		},
+		&datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com.1.2.3",
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: "1.2.3",
+			},
+		},
+		pkgInstall,
+	}
+
+	var unstructuredObjects []k8sruntime.Object
+	for _, obj := range existingObjects {
+		unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+	}
+	dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		k8sruntime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+			{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
+			{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
+		},
+		unstructuredObjects...,
+	)
+
+	// The fake dynamic client does not send events for existing objects, so
+	// the events are sent explicitly.
+	fakeWatcher := watch.NewFakeWithChanSize(2, false)
+	fakeWatcher.Add(unstructuredObjects[2])
+	fakeWatcher.Delete(unstructuredObjects[2])
+	dynamicClient.PrependWatchReactor(pkgInstallsResource, k8stesting.DefaultWatchReactor(fakeWatcher, nil))
+
+	s := Server{
+		pluginConfig: defaultPluginConfig,
+		clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().WithDynamic(dynamicClient).Build(), nil
+		},
+		globalPackagingNamespace: globalPackagingNamespace,
+	}
+
+	// The summary sent for an addition is the same as that returned by
+	// GetInstalledPackageSummaries.
+	summariesResponse, err := s.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{Context: defaultContext})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &plugin_test.TestWatchInstalledPackageSummariesStream{
+		Ctx: ctx,
+		OnSend: func(r *corev1.WatchInstalledPackageSummariesResponse) {
+			if r.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				cancel()
+			}
+		},
+	}
+
+	err = s.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{Context: defaultContext}, stream)
+	if got, want := status.Code(err), codes.OK; got != want {
+		t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+	}
+
+	expectedResponses := []*corev1.WatchInstalledPackageSummariesResponse{
+		{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: summariesResponse.InstalledPackageSummaries[0],
+		},
+		{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: summariesResponse.InstalledPackageSummaries[0].InstalledPackageRef,
+				Name:                "my-installation",
+			},
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(corev1.WatchInstalledPackageSummariesResponse{})
+	if got, want := stream.Responses, expectedResponses; !cmp.Equal(got, want, ignoreUnexported, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported, opts))
+	}
+}
+
 func TestGetInstalledPackageDetail(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -5570,6 +6156,157 @@ func TestDeleteInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestCheckInstalledPackagePermissions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.CheckInstalledPackagePermissionsRequest
+		existingObjects    []k8sruntime.Object
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.CheckInstalledPackagePermissionsResponse
+	}{
+		{
+			name: "returns the actions forbidden to create the PackageInstall and its values",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "tetris.foo.example.com",
+				},
+				Values: "foo: bar",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: []*corev1.ForbiddenAction{
+					{ApiVersion: packagingAPIVersion, Resource: pkgInstallsResource, Namespace: "default", Verbs: []string{"create"}},
+					{ApiVersion: "v1", Resource: "secrets", Namespace: "default", Verbs: []string{"create"}},
+				},
+			},
+		},
+		{
+			name: "returns no actions when the user can update the existing PackageInstall",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+				Values: "foo: bar",
+			},
+			existingObjects: []k8sruntime.Object{
+				&packagingv1alpha1.PackageInstall{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgInstallResource,
+						APIVersion: packagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-installation",
+					},
+					Spec: packagingv1alpha1.PackageInstallSpec{
+						ServiceAccountName: "default",
+						PackageRef: &packagingv1alpha1.PackageRef{
+							RefName: "tetris.foo.example.com",
+						},
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.CheckInstalledPackagePermissionsResponse{
+				ForbiddenActions: []*corev1.ForbiddenAction{},
+			},
+		},
+		{
+			name: "returns invalid argument when checking a creation without an available package ref",
+			request: &corev1.CheckInstalledPackagePermissionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var unstructuredObjects []k8sruntime.Object
+			for _, obj := range tc.existingObjects {
+				unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+			}
+
+			// The user can update but not create resources.
+			typedClient := typfake.NewSimpleClientset()
+			typedClient.Discovery().(*disfake.FakeDiscovery).Resources = []*metav1.APIResourceList{
+				{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "secrets", Kind: "Secret", Namespaced: true}}},
+				{GroupVersion: packagingAPIVersion, APIResources: []metav1.APIResource{{Name: pkgInstallsResource, Kind: pkgInstallResource, Namespaced: true}}},
+			}
+			typedClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb != "create"
+				return true, review, nil
+			})
+
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().
+						WithTyped(typedClient).
+						WithDynamic(dynfake.NewSimpleDynamicClientWithCustomListKinds(
+							k8sruntime.NewScheme(),
+							map[schema.GroupVersionResource]string{
+								{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}: pkgInstallResource + "List",
+							},
+							unstructuredObjects...,
+						)).Build(), nil
+				},
+			}
+
+			response, err := s.CheckInstalledPackagePermissions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+			}
+			// If we were expecting an error, continue to the next test.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageUpdatePreview(t *testing.T) {
+	s := Server{
+		pluginConfig: defaultPluginConfig,
+	}
+
+	_, err := s.GetInstalledPackageUpdatePreview(context.Background(), &corev1.GetInstalledPackageUpdatePreviewRequest{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Namespace: "default",
+				Cluster:   "default",
+			},
+			Identifier: "my-installation",
+		},
+	})
+
+	if got, want := status.Code(err), codes.Unimplemented; got != want {
+		t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+	}
+	if s.GetPluginCapabilities().GetUpdatePreview() {
+		t.Errorf("got: update preview capability, want: none")
+	}
+}
+
 func TestGetInstalledPackageResourceRefs(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -16,7 +16,12 @@ import (
 	vendirversions "github.com/vmware-tanzu/carvel-vendir/pkg/vendir/versions/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/valuesschema"
+	"github.com/vmware-tanzu/kubeapps/pkg/versionfilter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
 )
 
 type pkgSemver struct {
@@ -24,6 +29,15 @@ type pkgSemver struct {
 	version *semver.Version
 }
 
+// packageRepositoryName returns the name of the package repository providing
+// the package, from the annotation set by kapp-controller, if any.
+func packageRepositoryName(pkg *datapackagingv1alpha1.Package) string {
+	if repoRef := strings.Split(pkg.Annotations[packageRepositoryRefAnnotation], "/"); len(repoRef) == 2 {
+		return repoRef[1]
+	}
+	return ""
+}
+
 // pkgVersionsMap recturns a map of packages keyed by the packagemetadataName.
 //
 // A Package CR in carvel is really a particular version of a package, so we need
@@ -48,6 +62,22 @@ func getPkgVersionsMap(packages []*datapackagingv1alpha1.Package) (map[string][]
 	return pkgVersionsMap, nil
 }
 
+// filterPkgVersions returns the versions of a package matching the version
+// filters. As the app version of a package is its version, both filters are
+// matched against it.
+func filterPkgVersions(versions []pkgSemver, pkgVersion, appVersion *versionfilter.Filter) []pkgSemver {
+	if pkgVersion == nil && appVersion == nil {
+		return versions
+	}
+	matching := []pkgSemver{}
+	for _, v := range versions {
+		if pkgVersion.Matches(v.pkg.Spec.Version) && appVersion.Matches(v.pkg.Spec.Version) {
+			matching = append(matching, v)
+		}
+	}
+	return matching
+}
+
 // latestMatchingVersion returns the latest version of a package that matches the given version constraint.
 func latestMatchingVersion(versions []pkgSemver, constraints string) (*semver.Version, error) {
 	// constraints can be a single one (e.g., ">1.2.3") or a range (e.g., ">1.0.0 <2.0.0 || 3.0.0")
@@ -226,6 +256,31 @@ func prereleasesVersionSelection(prereleasesVersionSelection []string) *vendirve
 	}
 }
 
+// validatePkgValues validates the values against the OpenAPI values schema of
+// the package with the given refName and version, if it has one. The
+// defaults of the schema are taken into account, as kapp-controller does.
+func validatePkgValues(pkgs []*datapackagingv1alpha1.Package, refName, version, values string) error {
+	for _, pkg := range pkgs {
+		if pkg.Spec.RefName != refName || pkg.Spec.Version != version {
+			continue
+		}
+		schema := pkg.Spec.ValuesSchema.OpenAPIv3.Raw
+		if len(schema) == 0 {
+			return nil
+		}
+		defaultValuesYaml, err := pkgutils.DefaultValuesFromSchema(schema, false)
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to get the default values of package %q: %v", pkg.Name, err)
+		}
+		defaultValues := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(defaultValuesYaml), &defaultValues); err != nil {
+			return status.Errorf(codes.Internal, "unable to parse the default values of package %q: %v", pkg.Name, err)
+		}
+		return valuesschema.Validate(values, defaultValues, schema)
+	}
+	return nil
+}
+
 // implementing a custom ConfigFactory to allow for customizing the *rest.Config
 // https://kubernetes.slack.com/archives/CH8KCCKA5/p1642015047046200
 type ConfigurableConfigFactoryImpl struct {
@@ -15,7 +15,10 @@ import (
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
 	vendirversions "github.com/vmware-tanzu/carvel-vendir/pkg/vendir/versions/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestGetPkgVersionsMap(t *testing.T) {
@@ -744,3 +747,54 @@ func TestFilterMetadatas(t *testing.T) {
 	}
 
 }
+
+func TestValidatePkgValues(t *testing.T) {
+	pkgs := []*datapackagingv1alpha1.Package{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tetris.foo.example.com.1.2.3"},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: "1.2.3",
+				ValuesSchema: datapackagingv1alpha1.ValuesSchema{
+					OpenAPIv3: runtime.RawExtension{Raw: []byte(`{"properties":{"port":{"type":"integer","default":80},"hostname":{"type":"string"}},"required":["port"]}`)},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tetris.foo.example.com.1.2.4"},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: "1.2.4",
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		version       string
+		values        string
+		expectedError string
+	}{
+		{"valid values", "1.2.3", "hostname: example.com", ""},
+		{"required values from the schema defaults", "1.2.3", "", ""},
+		{"invalid values", "1.2.3", "port: foo", "The values do not match the values schema: values.port: Invalid type. Expected: integer, given: string"},
+		{"package without a values schema", "1.2.4", "port: foo", ""},
+		{"unknown package version", "1.2.5", "port: foo", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePkgValues(pkgs, "tetris.foo.example.com", tt.version, tt.values)
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Fatalf("got: %+v, want: nil", err)
+				}
+				return
+			}
+			if got, want := status.Code(err), codes.InvalidArgument; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := status.Convert(err).Message(), tt.expectedError; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
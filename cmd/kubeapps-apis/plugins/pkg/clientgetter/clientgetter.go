@@ -85,21 +85,40 @@ func NewHelmActionConfigGetter(configGetter core.KubernetesConfigGetter, cluster
 		if err != nil {
 			return nil, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
 		}
+		return helmActionConfigHelper(config, namespace)
+	}
+}
 
-		restClientGetter := agent.NewConfigFlagsFromCluster(namespace, config)
-		clientSet, err := kubernetes.NewForConfig(config)
+// NewImpersonatingHelmActionConfigGetter returns an "out-of-band" helm action
+// config getter for the cluster on which kubeapps-apis is executing, rather
+// than for the request context, so that the helm plugin can run out-of-request
+// actions, such as the automatic upgrade of releases. The service account of
+// kubeapps-apis impersonates the given user, so that the actions are limited
+// to the permissions of that user rather than those of kubeapps-apis.
+func NewImpersonatingHelmActionConfigGetter(userName string) HelmActionConfigGetterFunc {
+	return func(ctx context.Context, namespace string) (*action.Configuration, error) {
+		config, err := impersonatingInClusterConfig(userName)
 		if err != nil {
-			return nil, status.Errorf(codes.FailedPrecondition, "unable to create kubernetes client due to: %v", err)
+			return nil, err
 		}
-		// TODO(mnelson): Update to allow different helm storage options.
-		storage := agent.StorageForSecrets(namespace, clientSet)
-		return &action.Configuration{
-			RESTClientGetter: restClientGetter,
-			KubeClient:       kube.New(restClientGetter),
-			Releases:         storage,
-			Log:              log.Infof,
-		}, nil
+		return helmActionConfigHelper(config, namespace)
+	}
+}
+
+func helmActionConfigHelper(config *rest.Config, namespace string) (*action.Configuration, error) {
+	restClientGetter := agent.NewConfigFlagsFromCluster(namespace, config)
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to create kubernetes client due to: %v", err)
 	}
+	// TODO(mnelson): Update to allow different helm storage options.
+	storage := agent.StorageForSecrets(namespace, clientSet)
+	return &action.Configuration{
+		RESTClientGetter: restClientGetter,
+		KubeClient:       kube.New(restClientGetter),
+		Releases:         storage,
+		Log:              log.Infof,
+	}, nil
 }
 
 func NewClientGetter(configGetter core.KubernetesConfigGetter, options Options) ClientGetterFunc {
@@ -148,6 +167,31 @@ func NewBackgroundClientGetter(configGetter core.KubernetesConfigGetter, options
 	}
 }
 
+// NewImpersonatingClientGetter returns an "out-of-band" client getter for the
+// cluster on which kubeapps-apis is executing, with its service account
+// impersonating the given user, as for NewImpersonatingHelmActionConfigGetter.
+func NewImpersonatingClientGetter(userName string, options Options) BackgroundClientGetterFunc {
+	return func(ctx context.Context) (ClientInterfaces, error) {
+		config, err := impersonatingInClusterConfig(userName)
+		if err != nil {
+			return nil, err
+		}
+		return clientGetterHelper(config, options)
+	}
+}
+
+func impersonatingInClusterConfig(userName string) (*rest.Config, error) {
+	if userName == "" {
+		return nil, status.Errorf(codes.Internal, "user to impersonate required")
+	}
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to get in cluster config due to: %v", err)
+	}
+	config.Impersonate = rest.ImpersonationConfig{UserName: userName}
+	return config, nil
+}
+
 // just a convenience func as a shortcut to get API Extension client in one line
 func (cg BackgroundClientGetterFunc) ApiExt(ctx context.Context) (apiext.Interface, error) {
 	if clientInterfaces, err := cg(ctx); err != nil {
@@ -0,0 +1,57 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sutils
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	leaseDuration = 60 * time.Second
+	renewDeadline = 30 * time.Second
+	retryPeriod   = 10 * time.Second
+)
+
+// RunWithLeaderElection calls run in the background on the replica of
+// kubeapps-apis holding the lease of the given name in the namespace, so that
+// a background task is run by a single replica at a time. The context passed
+// to run is done when the lease is lost, and run is called again whenever
+// the lease is acquired again, until the context is done.
+func RunWithLeaderElection(ctx context.Context, client kubernetes.Interface, namespace, leaseName string, run func(ctx context.Context)) error {
+	identity, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	lock, err := resourcelock.New(resourcelock.LeasesResourceLock, namespace, leaseName,
+		client.CoreV1(), client.CoordinationV1(), resourcelock.ResourceLockConfig{Identity: identity})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for ctx.Err() == nil {
+			leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+				Lock:            lock,
+				LeaseDuration:   leaseDuration,
+				RenewDeadline:   renewDeadline,
+				RetryPeriod:     retryPeriod,
+				ReleaseOnCancel: true,
+				Callbacks: leaderelection.LeaderCallbacks{
+					OnStartedLeading: run,
+					OnStoppedLeading: func() {
+						log.Infof("%q stopped leading %s/%s", identity, namespace, leaseName)
+					},
+				},
+			})
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifestdiff
+
+import (
+	"bufio"
+	goerrs "errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// resource is a single object from a yaml manifest together with its
+// normalized yaml representation.
+type resource struct {
+	ref      *corev1.ResourceRef
+	manifest string
+}
+
+// DiffManifests returns the changes to resources required to go from the
+// current to the target multi-document yaml manifest, as rendered by Helm
+// for example. Resources are matched on their group, kind, namespace and
+// name, so that a change of API version alone is reported as a change rather
+// than a deletion and creation. Unchanged resources are omitted. Resources
+// which do not specify a namespace are assumed to be in the pkgNamespace.
+//
+// The resulting changes are ordered as the resources appear in the target
+// manifest, followed by the deleted resources in the order they appear in the
+// current manifest.
+func DiffManifests(current, target, pkgNamespace string) ([]*corev1.ResourceChange, error) {
+	currentResources, err := resourcesFromManifest(current, pkgNamespace)
+	if err != nil {
+		return nil, err
+	}
+	targetResources, err := resourcesFromManifest(target, pkgNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	currentByKey := map[string]resource{}
+	for _, r := range currentResources {
+		currentByKey[keyForRef(r.ref)] = r
+	}
+
+	changes := []*corev1.ResourceChange{}
+	seen := map[string]bool{}
+	for _, t := range targetResources {
+		key := keyForRef(t.ref)
+		seen[key] = true
+		c, ok := currentByKey[key]
+		if !ok {
+			changes = append(changes, &corev1.ResourceChange{
+				ResourceRef:    t.ref,
+				ChangeType:     corev1.ResourceChange_CHANGE_TYPE_CREATED,
+				TargetManifest: t.manifest,
+				Diff:           unifiedDiff("", t.manifest),
+			})
+			continue
+		}
+		if c.manifest == t.manifest {
+			continue
+		}
+		changes = append(changes, &corev1.ResourceChange{
+			ResourceRef:     t.ref,
+			ChangeType:      corev1.ResourceChange_CHANGE_TYPE_CHANGED,
+			CurrentManifest: c.manifest,
+			TargetManifest:  t.manifest,
+			Diff:            unifiedDiff(c.manifest, t.manifest),
+		})
+	}
+
+	for _, c := range currentResources {
+		if seen[keyForRef(c.ref)] {
+			continue
+		}
+		changes = append(changes, &corev1.ResourceChange{
+			ResourceRef:     c.ref,
+			ChangeType:      corev1.ResourceChange_CHANGE_TYPE_DELETED,
+			CurrentManifest: c.manifest,
+			Diff:            unifiedDiff(c.manifest, ""),
+		})
+	}
+
+	return changes, nil
+}
+
+// resourcesFromManifest splits a multi-document yaml manifest into the
+// individual resources, expanding any lists.
+func resourcesFromManifest(m, pkgNamespace string) ([]resource, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(strings.NewReader(m)))
+	resources := []resource{}
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if goerrs.Is(err, io.EOF) {
+				break
+			}
+			return nil, status.Errorf(codes.Internal, "Unable to read yaml manifest: %v", err)
+		}
+		obj := map[string]interface{}{}
+		if err = sigsyaml.Unmarshal(doc, &obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to decode yaml manifest: %v", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		kind, _ := obj["kind"].(string)
+		if kind == "" {
+			continue
+		}
+		if strings.HasSuffix(kind, "List") {
+			items, _ := obj["items"].([]interface{})
+			for _, i := range items {
+				item, ok := i.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				r, err := resourceFromObject(item, pkgNamespace)
+				if err != nil {
+					return nil, err
+				}
+				resources = append(resources, r)
+			}
+			continue
+		}
+		r, err := resourceFromObject(obj, pkgNamespace)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func resourceFromObject(obj map[string]interface{}, pkgNamespace string) (resource, error) {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	if namespace == "" {
+		namespace = pkgNamespace
+	}
+
+	// Marshalling the decoded object normalizes the manifest (sorted keys,
+	// no comments) so that only semantic differences are reported.
+	normalized, err := sigsyaml.Marshal(obj)
+	if err != nil {
+		return resource{}, status.Errorf(codes.Internal, "Unable to encode yaml manifest: %v", err)
+	}
+	return resource{
+		ref: &corev1.ResourceRef{
+			ApiVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+			Namespace:  namespace,
+		},
+		manifest: string(normalized),
+	}, nil
+}
+
+func keyForRef(ref *corev1.ResourceRef) string {
+	group := ""
+	if gv, err := schema.ParseGroupVersion(ref.ApiVersion); err == nil {
+		group = gv.Group
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", group, ref.Kind, ref.Namespace, ref.Name)
+}
+
+func unifiedDiff(current, target string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(current),
+		B:        difflib.SplitLines(target),
+		FromFile: "current",
+		ToFile:   "target",
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
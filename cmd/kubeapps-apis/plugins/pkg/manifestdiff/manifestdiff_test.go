@@ -0,0 +1,174 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifestdiff
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	configMapV1 = `
+---
+# Source: apache/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: apache-config
+data:
+  foo: bar
+`
+	configMapV2 = `
+---
+# Source: apache/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: apache-config
+data:
+  foo: baz
+`
+	deploymentV1 = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: apache
+  namespace: other
+spec:
+  replicas: 1
+`
+	deploymentV1Beta2 = `
+---
+apiVersion: apps/v1beta2
+kind: Deployment
+metadata:
+  name: apache
+  namespace: other
+spec:
+  replicas: 1
+`
+	serviceList = `
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: apache-svc
+`
+)
+
+func TestDiffManifests(t *testing.T) {
+	testCases := []struct {
+		name               string
+		current            string
+		target             string
+		expectedChanges    []*corev1.ResourceChange
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:            "returns no changes for identical manifests",
+			current:         configMapV1 + deploymentV1,
+			target:          configMapV1 + deploymentV1,
+			expectedChanges: []*corev1.ResourceChange{},
+		},
+		{
+			name:    "returns all resources as created when there is no current manifest",
+			current: "",
+			target:  configMapV1 + serviceList,
+			expectedChanges: []*corev1.ResourceChange{
+				{
+					ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "ConfigMap", Name: "apache-config", Namespace: "default"},
+					ChangeType:  corev1.ResourceChange_CHANGE_TYPE_CREATED,
+				},
+				{
+					ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "apache-svc", Namespace: "default"},
+					ChangeType:  corev1.ResourceChange_CHANGE_TYPE_CREATED,
+				},
+			},
+		},
+		{
+			name:    "returns changed and deleted resources",
+			current: configMapV1 + deploymentV1,
+			target:  configMapV2,
+			expectedChanges: []*corev1.ResourceChange{
+				{
+					ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "ConfigMap", Name: "apache-config", Namespace: "default"},
+					ChangeType:  corev1.ResourceChange_CHANGE_TYPE_CHANGED,
+				},
+				{
+					ResourceRef: &corev1.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "apache", Namespace: "other"},
+					ChangeType:  corev1.ResourceChange_CHANGE_TYPE_DELETED,
+				},
+			},
+		},
+		{
+			name:    "treats a change of api version as a change rather than a replacement",
+			current: deploymentV1Beta2,
+			target:  deploymentV1,
+			expectedChanges: []*corev1.ResourceChange{
+				{
+					ResourceRef: &corev1.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "apache", Namespace: "other"},
+					ChangeType:  corev1.ResourceChange_CHANGE_TYPE_CHANGED,
+				},
+			},
+		},
+		{
+			name:               "returns an error for an invalid manifest",
+			current:            "apiVersion: v1\nkind: [",
+			expectedStatusCode: codes.Internal,
+		},
+	}
+
+	ignoredFields := []cmp.Option{
+		cmpopts.IgnoreUnexported(corev1.ResourceChange{}, corev1.ResourceRef{}),
+		cmpopts.IgnoreFields(corev1.ResourceChange{}, "CurrentManifest", "TargetManifest", "Diff"),
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changes, err := DiffManifests(tc.current, tc.target, "default")
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := changes, tc.expectedChanges; !cmp.Equal(want, got, ignoredFields...) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredFields...))
+			}
+		})
+	}
+}
+
+func TestDiffManifestsIncludesUnifiedDiff(t *testing.T) {
+	changes, err := DiffManifests(configMapV1, configMapV2, "default")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := len(changes), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+
+	expectedDiff := `--- current
++++ target
+@@ -1,6 +1,6 @@
+ apiVersion: v1
+ data:
+-  foo: bar
++  foo: baz
+ kind: ConfigMap
+ metadata:
+   name: apache-config
+`
+	if got, want := changes[0].Diff, expectedDiff; got != want {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
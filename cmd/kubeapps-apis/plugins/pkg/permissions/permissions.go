@@ -0,0 +1,67 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package permissions checks whether the user is allowed to perform the
+// actions required to create or update the resources of an installed package.
+package permissions
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// ActionCreate checks that the resources can be created.
+	ActionCreate = "create"
+	// ActionUpdate checks that the resources can be updated.
+	ActionUpdate = "update"
+	// ActionUpgrade checks that the resources can be created, updated and
+	// deleted, as is required to upgrade a release.
+	ActionUpgrade = "upgrade"
+)
+
+// ForbiddenActions returns the actions on the resources of the manifest which
+// the user of the client is not allowed to perform, sorted by namespace, API
+// version and resource. Resources without a namespace are checked in the
+// given namespace.
+func ForbiddenActions(client kubernetes.Interface, namespace, action, manifest string) ([]*corev1.ForbiddenAction, error) {
+	actions, err := auth.NewAuthForClient(client).GetForbiddenActions(namespace, action, manifest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to check the permissions of the user: %v", err)
+	}
+
+	forbiddenActions := []*corev1.ForbiddenAction{}
+	for _, a := range actions {
+		forbiddenActions = append(forbiddenActions, &corev1.ForbiddenAction{
+			ApiVersion:  a.APIVersion,
+			Resource:    a.Resource,
+			Namespace:   a.Namespace,
+			ClusterWide: a.ClusterWide,
+			Verbs:       a.Verbs,
+		})
+	}
+	sort.SliceStable(forbiddenActions, func(i, j int) bool {
+		a, b := forbiddenActions[i], forbiddenActions[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ApiVersion != b.ApiVersion {
+			return a.ApiVersion < b.ApiVersion
+		}
+		return a.Resource < b.Resource
+	})
+	return forbiddenActions, nil
+}
+
+// ResourceManifest returns the manifest of a resource with only its type and
+// name, for checking the permissions on resources which are not rendered,
+// such as the custom resources created on behalf of the user.
+func ResourceManifest(apiVersion, kind, name, namespace string) string {
+	return fmt.Sprintf("apiVersion: %s\nkind: %s\nmetadata:\n  name: %s\n  namespace: %s\n", apiVersion, kind, name, namespace)
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newClient returns a fake client for a user who can only create and update
+// configmaps.
+func newClient() *typfake.Clientset {
+	client := typfake.NewSimpleClientset()
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+				{Name: "secrets", Kind: "Secret", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "rbac.authorization.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "clusterroles", Kind: "ClusterRole", Namespaced: false},
+			},
+		},
+	}
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = attrs.Resource == "configmaps" && (attrs.Verb == "create" || attrs.Verb == "update")
+		return true, review, nil
+	})
+	return client
+}
+
+func TestForbiddenActions(t *testing.T) {
+	const manifest = `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-secret
+  namespace: other
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: my-role
+`
+
+	testCases := []struct {
+		name            string
+		action          string
+		manifest        string
+		expectedActions []*corev1.ForbiddenAction
+	}{
+		{
+			name:     "it returns the forbidden actions to create the resources",
+			action:   ActionCreate,
+			manifest: manifest,
+			expectedActions: []*corev1.ForbiddenAction{
+				{ApiVersion: "rbac.authorization.k8s.io/v1", Resource: "clusterroles", ClusterWide: true, Verbs: []string{"create"}},
+				{ApiVersion: "v1", Resource: "secrets", Namespace: "other", Verbs: []string{"create"}},
+			},
+		},
+		{
+			name:     "it returns the forbidden actions to upgrade the resources",
+			action:   ActionUpgrade,
+			manifest: manifest,
+			expectedActions: []*corev1.ForbiddenAction{
+				{ApiVersion: "rbac.authorization.k8s.io/v1", Resource: "clusterroles", ClusterWide: true, Verbs: []string{"create", "update", "delete"}},
+				{ApiVersion: "v1", Resource: "configmaps", Namespace: "default", Verbs: []string{"delete"}},
+				{ApiVersion: "v1", Resource: "secrets", Namespace: "other", Verbs: []string{"create", "update", "delete"}},
+			},
+		},
+		{
+			name:            "it returns no actions when all are allowed",
+			action:          ActionUpdate,
+			manifest:        ResourceManifest("v1", "ConfigMap", "my-config", "default"),
+			expectedActions: []*corev1.ForbiddenAction{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actions, err := ForbiddenActions(newClient(), "default", tc.action, tc.manifest)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.ForbiddenAction{})
+			if got, want := actions, tc.expectedActions; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgutils
+
+import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+)
+
+// IsInstalledPackageFilterEmpty returns whether the filter options would
+// match every installed package, in which case plugins can avoid building the
+// summaries of the installed packages outside of the requested page.
+func IsInstalledPackageFilterEmpty(filter *corev1.InstalledPackageFilterOptions) bool {
+	return filter.GetQuery() == "" &&
+		len(filter.GetStatusReasons()) == 0 &&
+		len(filter.GetPlugins()) == 0 &&
+		len(filter.GetRepositories()) == 0 &&
+		!filter.GetUpgradeAvailable()
+}
+
+// PluginMatchesInstalledPackageFilter returns whether the installed packages
+// managed by the plugin can match the filter options.
+func PluginMatchesInstalledPackageFilter(plugin *plugins.Plugin, filter *corev1.InstalledPackageFilterOptions) bool {
+	if len(filter.GetPlugins()) == 0 {
+		return true
+	}
+	for _, p := range filter.GetPlugins() {
+		if p == plugin.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// RepositoryMatchesInstalledPackageFilter returns whether the installed
+// packages from the repository can match the filter options.
+func RepositoryMatchesInstalledPackageFilter(repository string, filter *corev1.InstalledPackageFilterOptions) bool {
+	if len(filter.GetRepositories()) == 0 {
+		return true
+	}
+	for _, repo := range filter.GetRepositories() {
+		if repo == repository {
+			return true
+		}
+	}
+	return false
+}
+
+// InstalledPackageSummaryMatchesFilter returns whether the installed package
+// summary, managed by the plugin and installed from the repository, matches
+// all of the filter options.
+func InstalledPackageSummaryMatchesFilter(summary *corev1.InstalledPackageSummary, plugin *plugins.Plugin, repository string, filter *corev1.InstalledPackageFilterOptions) bool {
+	if !PluginMatchesInstalledPackageFilter(plugin, filter) {
+		return false
+	}
+
+	if query := strings.ToLower(filter.GetQuery()); query != "" {
+		if !strings.Contains(strings.ToLower(summary.GetName()), query) &&
+			!strings.Contains(strings.ToLower(summary.GetPkgDisplayName()), query) {
+			return false
+		}
+	}
+
+	if len(filter.GetStatusReasons()) > 0 {
+		found := false
+		for _, reason := range filter.GetStatusReasons() {
+			if reason == summary.GetStatus().GetReason() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if !RepositoryMatchesInstalledPackageFilter(repository, filter) {
+		return false
+	}
+
+	if filter.GetUpgradeAvailable() && !IsUpgradeAvailable(summary) {
+		return false
+	}
+
+	return true
+}
+
+// IsUpgradeAvailable returns whether the latest version available for the
+// installed package is greater than the current version. Versions which are not
+// valid semantic versions are only compared for equality.
+func IsUpgradeAvailable(summary *corev1.InstalledPackageSummary) bool {
+	latest := summary.GetLatestVersion().GetPkgVersion()
+	current := summary.GetCurrentVersion().GetPkgVersion()
+	if latest == "" || current == "" {
+		return false
+	}
+	latestVersion, err := semver.NewVersion(latest)
+	if err != nil {
+		return latest != current
+	}
+	currentVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return latest != current
+	}
+	return latestVersion.GreaterThan(currentVersion)
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgutils
+
+import (
+	"testing"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+)
+
+func TestInstalledPackageSummaryMatchesFilter(t *testing.T) {
+	plugin := &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"}
+	summary := &corev1.InstalledPackageSummary{
+		Name:           "my-apache",
+		PkgDisplayName: "Apache",
+		CurrentVersion: &corev1.PackageAppVersion{PkgVersion: "1.2.3"},
+		LatestVersion:  &corev1.PackageAppVersion{PkgVersion: "1.3.0"},
+		Status: &corev1.InstalledPackageStatus{
+			Reason: corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   *corev1.InstalledPackageFilterOptions
+		expected bool
+	}{
+		{
+			name:     "matches when there is no filter",
+			filter:   nil,
+			expected: true,
+		},
+		{
+			name:     "matches a case-insensitive query on the name",
+			filter:   &corev1.InstalledPackageFilterOptions{Query: "MY-APA"},
+			expected: true,
+		},
+		{
+			name:     "matches a query on the package display name",
+			filter:   &corev1.InstalledPackageFilterOptions{Query: "apache"},
+			expected: true,
+		},
+		{
+			name:     "does not match a different query",
+			filter:   &corev1.InstalledPackageFilterOptions{Query: "nginx"},
+			expected: false,
+		},
+		{
+			name: "matches any of the status reasons",
+			filter: &corev1.InstalledPackageFilterOptions{
+				StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+					corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+					corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "does not match other status reasons",
+			filter: &corev1.InstalledPackageFilterOptions{
+				StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+					corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+				},
+			},
+			expected: false,
+		},
+		{
+			name:     "matches the plugin",
+			filter:   &corev1.InstalledPackageFilterOptions{Plugins: []string{"fluxv2.packages", "helm.packages"}},
+			expected: true,
+		},
+		{
+			name:     "does not match other plugins",
+			filter:   &corev1.InstalledPackageFilterOptions{Plugins: []string{"fluxv2.packages"}},
+			expected: false,
+		},
+		{
+			name:     "matches the repository",
+			filter:   &corev1.InstalledPackageFilterOptions{Repositories: []string{"bitnami"}},
+			expected: true,
+		},
+		{
+			name:     "does not match other repositories",
+			filter:   &corev1.InstalledPackageFilterOptions{Repositories: []string{"other-repo"}},
+			expected: false,
+		},
+		{
+			name:     "matches when an upgrade is available",
+			filter:   &corev1.InstalledPackageFilterOptions{UpgradeAvailable: true},
+			expected: true,
+		},
+		{
+			name: "requires all the filters to match",
+			filter: &corev1.InstalledPackageFilterOptions{
+				Query:        "apache",
+				Repositories: []string{"other-repo"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := InstalledPackageSummaryMatchesFilter(summary, plugin, "bitnami", tc.filter), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestIsUpgradeAvailable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  string
+		latest   string
+		expected bool
+	}{
+		{"a greater latest version is an upgrade", "1.2.3", "1.10.0", true},
+		{"the same version is not an upgrade", "1.2.3", "1.2.3", false},
+		{"a lower latest version is not an upgrade", "2.0.0", "1.10.0", false},
+		{"a pre-release of the current version is not an upgrade", "1.2.3", "1.2.3-rc1", false},
+		{"an unknown latest version is not an upgrade", "1.2.3", "", false},
+		{"different non-semver versions are an upgrade", "latest", "stable", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			summary := &corev1.InstalledPackageSummary{
+				CurrentVersion: &corev1.PackageAppVersion{PkgVersion: tc.current},
+				LatestVersion:  &corev1.PackageAppVersion{PkgVersion: tc.latest},
+			}
+			if got, want := IsUpgradeAvailable(summary), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v3" // The usual "sigs.k8s.io/yaml" doesn't work: https://github.com/vmware-tanzu/kubeapps/pull/4050
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
@@ -154,12 +155,16 @@ func AvailablePackageSummaryFromChart(chart *models.Chart, plugin *plugins.Plugi
 		Plugin:     plugin,
 	}
 	pkg.AvailablePackageRef.Context = &corev1.Context{Namespace: chart.Repo.Namespace}
+	pkg.RepositoryName = chart.Repo.Name
 
 	if chart.ChartVersions != nil || len(chart.ChartVersions) != 0 {
 		pkg.LatestVersion = &corev1.PackageAppVersion{
 			PkgVersion: chart.ChartVersions[0].Version,
 			AppVersion: chart.ChartVersions[0].AppVersion,
 		}
+		if created := chart.ChartVersions[0].Created; !created.IsZero() {
+			pkg.LatestVersionUpdated = timestamppb.New(created)
+		}
 	}
 
 	return pkg, nil
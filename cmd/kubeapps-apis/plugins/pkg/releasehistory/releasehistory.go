@@ -0,0 +1,111 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package releasehistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// GetInstalledPackageRevisions returns the revisions of the Helm release,
+// most recent first.
+func GetInstalledPackageRevisions(
+	ctx context.Context,
+	helmReleaseName types.NamespacedName,
+	actionConfigGetter clientgetter.HelmActionConfigGetterFunc) ([]*corev1.InstalledPackageRevision, error) {
+	namespace := helmReleaseName.Namespace
+
+	actionConfig, err := actionConfigGetter(ctx, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	releases, err := action.NewHistory(actionConfig).Run(helmReleaseName.Name)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", helmReleaseName.Name, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to run Helm history action: %v", err)
+	}
+
+	return RevisionsFromReleases(releases)
+}
+
+// RevisionsFromReleases converts the releases of a Helm release history into
+// installed package revisions, most recent first.
+func RevisionsFromReleases(releases []*release.Release) ([]*corev1.InstalledPackageRevision, error) {
+	sorted := make([]*release.Release, len(releases))
+	copy(sorted, releases)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Version > sorted[j].Version
+	})
+
+	revisions := make([]*corev1.InstalledPackageRevision, 0, len(sorted))
+	for _, r := range sorted {
+		revision, err := revisionFromRelease(r)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+func revisionFromRelease(r *release.Release) (*corev1.InstalledPackageRevision, error) {
+	revision := &corev1.InstalledPackageRevision{
+		Revision: int32(r.Version),
+	}
+
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		revision.Version = &corev1.PackageAppVersion{
+			PkgVersion: r.Chart.Metadata.Version,
+			AppVersion: r.Chart.Metadata.AppVersion,
+		}
+	}
+
+	if r.Info != nil {
+		revision.Status = &corev1.InstalledPackageStatus{
+			Ready:      r.Info.Status == release.StatusDeployed,
+			Reason:     statusReasonForHelmStatus(r.Info.Status),
+			UserReason: r.Info.Status.String(),
+		}
+		if !r.Info.LastDeployed.IsZero() {
+			revision.Updated = timestamppb.New(r.Info.LastDeployed.Time)
+		}
+	}
+
+	if r.Config != nil {
+		valuesMarshalled, err := json.Marshal(r.Config)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to marshal Helm release values: %v", err)
+		}
+		revision.ValuesApplied = string(valuesMarshalled)
+	}
+
+	return revision, nil
+}
+
+func statusReasonForHelmStatus(s release.Status) corev1.InstalledPackageStatus_StatusReason {
+	switch s {
+	case release.StatusDeployed:
+		return corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED
+	case release.StatusFailed:
+		return corev1.InstalledPackageStatus_STATUS_REASON_FAILED
+	case release.StatusPendingInstall, release.StatusPendingRollback, release.StatusPendingUpgrade, release.StatusUninstalling:
+		return corev1.InstalledPackageStatus_STATUS_REASON_PENDING
+	}
+	return corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package releasehistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+)
+
+func TestRevisionsFromReleases(t *testing.T) {
+	deployed := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+	releases := []*release.Release{
+		{
+			Name:    "my-apache",
+			Version: 1,
+			Info: &release.Info{
+				Status:       release.StatusSuperseded,
+				LastDeployed: helmtime.Time{Time: deployed},
+			},
+			Chart: &chart.Chart{
+				Metadata: &chart.Metadata{Version: "1.18.2", AppVersion: "2.4.48"},
+			},
+			Config: map[string]interface{}{"replicaCount": 1},
+		},
+		{
+			Name:    "my-apache",
+			Version: 2,
+			Info: &release.Info{
+				Status:       release.StatusDeployed,
+				LastDeployed: helmtime.Time{Time: deployed.Add(time.Hour)},
+			},
+			Chart: &chart.Chart{
+				Metadata: &chart.Metadata{Version: "1.18.3", AppVersion: "2.4.49"},
+			},
+		},
+	}
+
+	expected := []*corev1.InstalledPackageRevision{
+		{
+			Revision: 2,
+			Version:  &corev1.PackageAppVersion{PkgVersion: "1.18.3", AppVersion: "2.4.49"},
+			Status: &corev1.InstalledPackageStatus{
+				Ready:      true,
+				Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+				UserReason: "deployed",
+			},
+			Updated: timestamppb.New(deployed.Add(time.Hour)),
+		},
+		{
+			Revision: 1,
+			Version:  &corev1.PackageAppVersion{PkgVersion: "1.18.2", AppVersion: "2.4.48"},
+			Status: &corev1.InstalledPackageStatus{
+				Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+				UserReason: "superseded",
+			},
+			Updated:       timestamppb.New(deployed),
+			ValuesApplied: `{"replicaCount":1}`,
+		},
+	}
+
+	revisions, err := RevisionsFromReleases(releases)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	opts := cmpopts.IgnoreUnexported(corev1.InstalledPackageRevision{}, corev1.PackageAppVersion{}, corev1.InstalledPackageStatus{}, timestamppb.Timestamp{})
+	if got, want := revisions, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+}
@@ -91,11 +91,21 @@ func GetInstalledPackageResourceRefs(
 	ctx context.Context,
 	helmReleaseName types.NamespacedName,
 	actionConfigGetter clientgetter.HelmActionConfigGetterFunc) ([]*corev1.ResourceRef, error) {
+	refs, _, err := GetInstalledPackageResourceRefsAndManifest(ctx, helmReleaseName, actionConfigGetter)
+	return refs, err
+}
+
+// GetInstalledPackageResourceRefsAndManifest returns the resource refs of the
+// Helm release together with its manifest, from which the refs are read.
+func GetInstalledPackageResourceRefsAndManifest(
+	ctx context.Context,
+	helmReleaseName types.NamespacedName,
+	actionConfigGetter clientgetter.HelmActionConfigGetterFunc) ([]*corev1.ResourceRef, string, error) {
 	namespace := helmReleaseName.Namespace
 
 	actionConfig, err := actionConfigGetter(ctx, namespace)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
 
 	// Grab the released manifest from the release.
@@ -109,15 +119,15 @@ func GetInstalledPackageResourceRefs(
 	release, err := getcmd.Run(helmReleaseName.Name)
 	if err != nil {
 		if err == driver.ErrReleaseNotFound {
-			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", helmReleaseName, namespace, err)
+			return nil, "", status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", helmReleaseName, namespace, err)
 		}
-		return nil, status.Errorf(codes.Internal, "Unable to run Helm get action: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "Unable to run Helm get action: %v", err)
 	}
 
 	refs, err := ResourceRefsFromManifest(release.Manifest, namespace)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	} else {
-		return refs, nil
+		return refs, release.Manifest, nil
 	}
 }
@@ -4,25 +4,185 @@
 package statuserror
 
 import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
 	"k8s.io/apimachinery/pkg/api/errors"
 )
 
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details attached to
+// the errors returned by the Kubeapps APIs.
+const ErrorDomain = "kubeapps.dev"
+
+// Stable reasons of the google.rpc.ErrorInfo details, which clients can rely
+// on to tell failures apart rather than parsing the error message.
+const (
+	// ReasonResourceNotFound is used when a Kubernetes resource does not exist.
+	ReasonResourceNotFound = "RESOURCE_NOT_FOUND"
+	// ReasonResourceForbidden is used when the user is not allowed to act on
+	// a Kubernetes resource.
+	ReasonResourceForbidden = "RESOURCE_FORBIDDEN"
+	// ReasonResourceAlreadyExists is used when a Kubernetes resource to be
+	// created already exists.
+	ReasonResourceAlreadyExists = "RESOURCE_ALREADY_EXISTS"
+	// ReasonResourceError is used for any other failure acting on a
+	// Kubernetes resource.
+	ReasonResourceError = "RESOURCE_ERROR"
+	// ReasonUnauthenticated is used when the user credentials are missing or
+	// invalid.
+	ReasonUnauthenticated = "UNAUTHENTICATED"
+	// ReasonInvalidField is used when a field of the request is invalid.
+	ReasonInvalidField = "INVALID_FIELD"
+	// ReasonPluginNotFound is used when no plugin is registered for a request.
+	ReasonPluginNotFound = "PLUGIN_NOT_FOUND"
+	// ReasonRepositoryNotReady is used when a package repository has not yet
+	// been successfully indexed.
+	ReasonRepositoryNotReady = "REPOSITORY_NOT_READY"
+	// ReasonPackageNotFound is used when an available package, or the
+	// requested version of it, does not exist.
+	ReasonPackageNotFound = "PACKAGE_NOT_FOUND"
+	// ReasonValuesInvalid is used when the values of a package do not match
+	// its values schema.
+	ReasonValuesInvalid = "VALUES_INVALID"
+	// ReasonPendingReconciliation is used when a resource cannot be changed
+	// until its pending reconciliation completes.
+	ReasonPendingReconciliation = "PENDING_RECONCILIATION"
+	// ReasonNotSupported is used when a plugin does not support the requested
+	// operation, such as acting on other clusters.
+	ReasonNotSupported = "NOT_SUPPORTED"
+)
+
 // FromK8sResourceError generates a grpc status error from a Kubernetes error
 // when querying a resource.
+// The error includes ErrorInfo and ResourceInfo details about the resource.
 func FromK8sError(verb, resource, identifier string, err error) error {
 	if identifier == "" {
 		identifier = "all"
 	}
+	var code codes.Code
+	var reason, msg string
 	if errors.IsNotFound(err) {
-		return status.Errorf(codes.NotFound, "unable to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
+		code, reason = codes.NotFound, ReasonResourceNotFound
+		msg = fmt.Sprintf("unable to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
 	} else if errors.IsForbidden(err) {
-		return status.Errorf(codes.PermissionDenied, "Forbidden to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
+		code, reason = codes.PermissionDenied, ReasonResourceForbidden
+		msg = fmt.Sprintf("Forbidden to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
 	} else if errors.IsUnauthorized(err) {
-		return status.Errorf(codes.Unauthenticated, "Authorization required to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
+		code, reason = codes.Unauthenticated, ReasonUnauthenticated
+		msg = fmt.Sprintf("Authorization required to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
 	} else if errors.IsAlreadyExists(err) {
-		return status.Errorf(codes.AlreadyExists, "Cannot %s the %s '%s' due to '%v' as it already exists", verb, resource, identifier, err)
+		code, reason = codes.AlreadyExists, ReasonResourceAlreadyExists
+		msg = fmt.Sprintf("Cannot %s the %s '%s' due to '%v' as it already exists", verb, resource, identifier, err)
+	} else {
+		code, reason = codes.Internal, ReasonResourceError
+		msg = fmt.Sprintf("unable to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
+	}
+	return newError(code, msg,
+		errorInfo(reason, map[string]string{"verb": verb, "resourceType": resource, "resourceName": identifier}),
+		&errdetails.ResourceInfo{ResourceType: resource, ResourceName: identifier, Description: msg},
+	)
+}
+
+// Errorf returns a grpc status error with an ErrorInfo detail with the
+// given reason.
+func Errorf(code codes.Code, reason, format string, a ...interface{}) error {
+	return newError(code, fmt.Sprintf(format, a...), errorInfo(reason, nil))
+}
+
+// ResourceErrorf returns a grpc status error with ErrorInfo and ResourceInfo
+// details about the given resource.
+func ResourceErrorf(code codes.Code, reason, resourceType, resourceName, format string, a ...interface{}) error {
+	msg := fmt.Sprintf(format, a...)
+	return newError(code, msg,
+		errorInfo(reason, map[string]string{"resourceType": resourceType, "resourceName": resourceName}),
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: resourceName, Description: msg},
+	)
+}
+
+// ForbiddenResource is a Kubernetes resource on which the user is not allowed
+// to perform the given verbs, in the namespace or cluster-wide if it is empty.
+type ForbiddenResource struct {
+	APIGroup  string
+	Resource  string
+	Namespace string
+	Verbs     []string
+}
+
+// ForbiddenErrorf returns a PermissionDenied grpc status error with an
+// ErrorInfo detail and a ResourceInfo detail for each forbidden resource.
+func ForbiddenErrorf(resources []ForbiddenResource, format string, a ...interface{}) error {
+	msg := fmt.Sprintf(format, a...)
+	details := []protoiface.MessageV1{errorInfo(ReasonResourceForbidden, nil)}
+	for _, r := range resources {
+		resourceType := r.Resource
+		if r.APIGroup != "" {
+			resourceType = fmt.Sprintf("%s.%s", r.Resource, r.APIGroup)
+		}
+		scope := "cluster-wide"
+		if r.Namespace != "" {
+			scope = fmt.Sprintf("in the namespace %q", r.Namespace)
+		}
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			Description:  fmt.Sprintf("Forbidden to %s %s %s", strings.Join(r.Verbs, ", "), resourceType, scope),
+		})
+	}
+	return newError(codes.PermissionDenied, msg, details...)
+}
+
+// FieldErrorf returns an InvalidArgument grpc status error with ErrorInfo and
+// BadRequest details about the given request field.
+func FieldErrorf(field, format string, a ...interface{}) error {
+	msg := fmt.Sprintf(format, a...)
+	return newError(codes.InvalidArgument, msg,
+		errorInfo(ReasonInvalidField, map[string]string{"field": field}),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: msg}}},
+	)
+}
+
+// Wrapf returns a grpc status error with the given message and the code and
+// details of err, so that the details returned by a plugin are not lost when
+// the error is wrapped by the core APIs.
+func Wrapf(err error, format string, a ...interface{}) error {
+	st := status.Convert(err)
+	details := []protoiface.MessageV1{}
+	for _, d := range st.Details() {
+		if m, ok := d.(protoiface.MessageV1); ok {
+			details = append(details, m)
+		}
+	}
+	return newError(st.Code(), fmt.Sprintf(format, a...), details...)
+}
+
+// Reason returns the reason of the ErrorInfo detail of the grpc status error,
+// or an empty string if there is none.
+func Reason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func errorInfo(reason string, metadata map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain, Metadata: metadata}
+}
+
+// newError returns a grpc status error with the given details, falling back
+// to an error without details if they cannot be attached.
+func newError(code codes.Code, msg string, details ...protoiface.MessageV1) error {
+	st := status.New(code, msg)
+	if len(details) == 0 {
+		return st.Err()
+	}
+	stWithDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
 	}
-	return status.Errorf(codes.Internal, "unable to %s the %s '%s' due to '%v'", verb, resource, identifier, err)
+	return stWithDetails.Err()
 }
@@ -4,11 +4,16 @@
 package statuserror
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestErrorByStatus(t *testing.T) {
@@ -46,3 +51,111 @@ func TestErrorByStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestFromK8sErrorDetails(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			"not found",
+			errors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "my-secret"),
+			codes.NotFound,
+			ReasonResourceNotFound,
+		},
+		{
+			"forbidden",
+			errors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "my-secret", fmt.Errorf("boom!")),
+			codes.PermissionDenied,
+			ReasonResourceForbidden,
+		},
+		{
+			"already exists",
+			errors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, "my-secret"),
+			codes.AlreadyExists,
+			ReasonResourceAlreadyExists,
+		},
+		{
+			"other errors",
+			fmt.Errorf("boom!"),
+			codes.Internal,
+			ReasonResourceError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := FromK8sError("get", "Secret", "my-secret", tt.err)
+
+			if got, want := status.Code(err), tt.expectedCode; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+			if got, want := Reason(err), tt.expectedReason; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			expectedDetails := []interface{}{
+				&errdetails.ErrorInfo{
+					Reason:   tt.expectedReason,
+					Domain:   ErrorDomain,
+					Metadata: map[string]string{"verb": "get", "resourceType": "Secret", "resourceName": "my-secret"},
+				},
+				&errdetails.ResourceInfo{
+					ResourceType: "Secret",
+					ResourceName: "my-secret",
+					Description:  status.Convert(err).Message(),
+				},
+			}
+			if got, want := status.Convert(err).Details(), expectedDetails; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestWrapf(t *testing.T) {
+	err := FieldErrorf("installed_package_ref.plugin", "missing plugin")
+
+	wrapped := Wrapf(err, "Unable to get the package: %v", err)
+
+	if got, want := wrapped.Error(), "rpc error: code = InvalidArgument desc = Unable to get the package: rpc error: code = InvalidArgument desc = missing plugin"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := status.Convert(wrapped).Details(), status.Convert(err).Details(); !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+	}
+	if got, want := Reason(wrapped), ReasonInvalidField; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := Reason(Wrapf(fmt.Errorf("boom!"), "wrapped")), ""; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestForbiddenErrorf(t *testing.T) {
+	err := ForbiddenErrorf([]ForbiddenResource{
+		{APIGroup: "apps", Resource: "deployments", Namespace: "default", Verbs: []string{"create", "delete"}},
+		{Resource: "namespaces", Verbs: []string{"list"}},
+	}, "Unable to create the release")
+
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+	expectedDetails := []interface{}{
+		&errdetails.ErrorInfo{
+			Reason: ReasonResourceForbidden,
+			Domain: ErrorDomain,
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: "deployments.apps",
+			Description:  `Forbidden to create, delete deployments.apps in the namespace "default"`,
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: "namespaces",
+			Description:  "Forbidden to list namespaces cluster-wide",
+		},
+	}
+	if got, want := status.Convert(err).Details(), expectedDetails; !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package summaries sorts available package summaries and counts them by
+// category, repository and plugin, so that the plugins computing them in
+// memory and the core APIs merging them agree on the results.
+package summaries
+
+import (
+	"sort"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+// Less reports whether the summary a sorts before the summary b with the
+// given sort option. Ties are broken by the package name.
+func Less(a, b *corev1.AvailablePackageSummary, sortBy corev1.FilterOptions_SortBy) bool {
+	switch sortBy {
+	case corev1.FilterOptions_SORT_BY_LAST_UPDATED:
+		aUpdated, bUpdated := a.GetLatestVersionUpdated(), b.GetLatestVersionUpdated()
+		// Packages without a release date are sorted last.
+		if (aUpdated == nil) != (bUpdated == nil) {
+			return aUpdated != nil
+		}
+		if aUpdated != nil && !aUpdated.AsTime().Equal(bUpdated.AsTime()) {
+			return aUpdated.AsTime().After(bUpdated.AsTime())
+		}
+	case corev1.FilterOptions_SORT_BY_REPOSITORY:
+		if a.GetRepositoryName() != b.GetRepositoryName() {
+			return a.GetRepositoryName() < b.GetRepositoryName()
+		}
+	}
+	return a.GetName() < b.GetName()
+}
+
+// Sort sorts the summaries with the given sort option.
+func Sort(summaries []*corev1.AvailablePackageSummary, sortBy corev1.FilterOptions_SortBy) {
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return Less(summaries[i], summaries[j], sortBy)
+	})
+}
+
+// Facets returns the number of summaries for each category and repository,
+// together with the total for the given plugin.
+func Facets(summaries []*corev1.AvailablePackageSummary, plugin string) *corev1.AvailablePackageFacets {
+	categories := map[string]int32{}
+	repositories := map[string]int32{}
+	for _, summary := range summaries {
+		for _, category := range summary.GetCategories() {
+			if category != "" {
+				categories[category]++
+			}
+		}
+		if repository := summary.GetRepositoryName(); repository != "" {
+			repositories[repository]++
+		}
+	}
+	return &corev1.AvailablePackageFacets{
+		Categories:   FacetCounts(categories),
+		Repositories: FacetCounts(repositories),
+		Plugins:      FacetCounts(map[string]int32{plugin: int32(len(summaries))}),
+	}
+}
+
+// MergeFacets returns the facets with the counts of each value summed, or
+// nil if none of the facets is set.
+func MergeFacets(facets ...*corev1.AvailablePackageFacets) *corev1.AvailablePackageFacets {
+	categories := map[string]int32{}
+	repositories := map[string]int32{}
+	plugins := map[string]int32{}
+	found := false
+	for _, f := range facets {
+		if f == nil {
+			continue
+		}
+		found = true
+		addCounts(categories, f.GetCategories())
+		addCounts(repositories, f.GetRepositories())
+		addCounts(plugins, f.GetPlugins())
+	}
+	if !found {
+		return nil
+	}
+	return &corev1.AvailablePackageFacets{
+		Categories:   FacetCounts(categories),
+		Repositories: FacetCounts(repositories),
+		Plugins:      FacetCounts(plugins),
+	}
+}
+
+func addCounts(counts map[string]int32, facetCounts []*corev1.FacetCount) {
+	for _, c := range facetCounts {
+		counts[c.GetValue()] += c.GetCount()
+	}
+}
+
+// FacetCounts returns the counts of each value sorted by decreasing count,
+// then by value.
+func FacetCounts(counts map[string]int32) []*corev1.FacetCount {
+	result := []*corev1.FacetCount{}
+	for value, count := range counts {
+		result = append(result, &corev1.FacetCount{Value: value, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
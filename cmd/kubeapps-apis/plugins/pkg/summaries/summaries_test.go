@@ -0,0 +1,133 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package summaries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func summary(name, repository string, updated *timestamppb.Timestamp, categories ...string) *corev1.AvailablePackageSummary {
+	return &corev1.AvailablePackageSummary{
+		Name:                 name,
+		RepositoryName:       repository,
+		LatestVersionUpdated: updated,
+		Categories:           categories,
+	}
+}
+
+func names(summaries []*corev1.AvailablePackageSummary) []string {
+	result := []string{}
+	for _, s := range summaries {
+		result = append(result, s.Name)
+	}
+	return result
+}
+
+func TestSort(t *testing.T) {
+	older := timestamppb.New(time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC))
+	newer := timestamppb.New(time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name          string
+		sortBy        corev1.FilterOptions_SortBy
+		expectedNames []string
+	}{
+		{
+			name:          "it sorts by name by default",
+			sortBy:        corev1.FilterOptions_SORT_BY_UNSPECIFIED,
+			expectedNames: []string{"apache", "mariadb", "nginx", "wordpress"},
+		},
+		{
+			name:          "it sorts by name",
+			sortBy:        corev1.FilterOptions_SORT_BY_NAME,
+			expectedNames: []string{"apache", "mariadb", "nginx", "wordpress"},
+		},
+		{
+			name:          "it sorts the most recently updated first, then by name",
+			sortBy:        corev1.FilterOptions_SORT_BY_LAST_UPDATED,
+			expectedNames: []string{"mariadb", "wordpress", "nginx", "apache"},
+		},
+		{
+			name:          "it sorts by repository, then by name",
+			sortBy:        corev1.FilterOptions_SORT_BY_REPOSITORY,
+			expectedNames: []string{"mariadb", "apache", "nginx", "wordpress"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			summaries := []*corev1.AvailablePackageSummary{
+				summary("wordpress", "bitnami", newer),
+				summary("nginx", "bitnami", older),
+				summary("apache", "bitnami", nil),
+				summary("mariadb", "", newer),
+			}
+
+			Sort(summaries, tc.sortBy)
+
+			if got, want := names(summaries), tc.expectedNames; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestFacets(t *testing.T) {
+	summaries := []*corev1.AvailablePackageSummary{
+		summary("wordpress", "bitnami", nil, "CMS"),
+		summary("nginx", "bitnami", nil, "Infrastructure"),
+		summary("apache", "other", nil, "Infrastructure"),
+		summary("mariadb", "", nil, ""),
+	}
+	opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageFacets{}, corev1.FacetCount{})
+
+	facets := Facets(summaries, "helm.packages")
+
+	expected := &corev1.AvailablePackageFacets{
+		Categories: []*corev1.FacetCount{
+			{Value: "Infrastructure", Count: 2},
+			{Value: "CMS", Count: 1},
+		},
+		Repositories: []*corev1.FacetCount{
+			{Value: "bitnami", Count: 2},
+			{Value: "other", Count: 1},
+		},
+		Plugins: []*corev1.FacetCount{
+			{Value: "helm.packages", Count: 4},
+		},
+	}
+	if got, want := facets, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	merged := MergeFacets(facets, nil, Facets(summaries[:1], "kapp_controller.packages"))
+
+	expected = &corev1.AvailablePackageFacets{
+		Categories: []*corev1.FacetCount{
+			{Value: "CMS", Count: 2},
+			{Value: "Infrastructure", Count: 2},
+		},
+		Repositories: []*corev1.FacetCount{
+			{Value: "bitnami", Count: 3},
+			{Value: "other", Count: 1},
+		},
+		Plugins: []*corev1.FacetCount{
+			{Value: "helm.packages", Count: 4},
+			{Value: "kapp_controller.packages", Count: 1},
+		},
+	}
+	if got, want := merged, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	if got := MergeFacets(nil, nil); got != nil {
+		t.Errorf("got: %+v, want: nil", got)
+	}
+}
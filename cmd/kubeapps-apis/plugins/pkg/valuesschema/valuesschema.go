@@ -0,0 +1,115 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package valuesschema validates the values submitted when installing or
+// updating a package against the values schema of the package.
+package valuesschema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"sigs.k8s.io/yaml"
+)
+
+// valuesField is the request field reported in the field violations.
+const valuesField = "values"
+
+// Validate validates the values YAML, merged over the default values of the
+// package, against the schema, which is either a JSON schema or an OpenAPI
+// v3 schema in JSON or YAML. Nothing is validated when the schema is empty.
+//
+// The returned error is an InvalidArgument status with an ErrorInfo detail
+// with the VALUES_INVALID reason and a BadRequest detail listing the path of
+// each invalid field, such as "values.service.port".
+func Validate(values string, defaults map[string]interface{}, schema []byte) error {
+	if len(strings.TrimSpace(string(schema))) == 0 {
+		return nil
+	}
+
+	userValues := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(values), &userValues); err != nil {
+		return invalidValuesError([]*errdetails.BadRequest_FieldViolation{
+			{Field: valuesField, Description: fmt.Sprintf("unable to parse the values as YAML: %v", err)},
+		})
+	}
+	if userValues == nil {
+		userValues = map[string]interface{}{}
+	}
+	mergedValues := chartutil.CoalesceTables(userValues, copyValues(defaults))
+
+	schemaJSON, err := yaml.YAMLToJSON(schema)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to parse the values schema: %v", err)
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schemaJSON), gojsonschema.NewGoLoader(mergedValues))
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to validate the values against the values schema: %v", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	for _, resultErr := range result.Errors() {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldPath(resultErr),
+			Description: resultErr.Description(),
+		})
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Field < violations[j].Field
+	})
+	return invalidValuesError(violations)
+}
+
+// fieldPath returns the path of the invalid field of the result error,
+// including the missing property for required errors.
+func fieldPath(resultErr gojsonschema.ResultError) string {
+	path := valuesField
+	if field := resultErr.Field(); field != gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+		path = path + "." + field
+	}
+	if resultErr.Type() == "required" {
+		if property, ok := resultErr.Details()["property"].(string); ok {
+			path = path + "." + property
+		}
+	}
+	return path
+}
+
+func invalidValuesError(violations []*errdetails.BadRequest_FieldViolation) error {
+	descriptions := []string{}
+	for _, v := range violations {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", v.Field, v.Description))
+	}
+	st := status.Newf(codes.InvalidArgument, "The values do not match the values schema: %s", strings.Join(descriptions, "; "))
+	stWithDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: statuserror.ReasonValuesInvalid, Domain: statuserror.ErrorDomain},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+// copyValues returns a deep copy of the values, as they are modified when
+// coalesced.
+func copyValues(values map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if m, ok := v.(map[string]interface{}); ok {
+			v = copyValues(m)
+		}
+		copied[k] = v
+	}
+	return copied
+}
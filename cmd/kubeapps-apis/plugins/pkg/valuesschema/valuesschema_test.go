@@ -0,0 +1,138 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package valuesschema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const jsonSchema = `{
+	"$schema": "http://json-schema.org/schema#",
+	"type": "object",
+	"required": ["service"],
+	"properties": {
+		"replicaCount": {"type": "integer", "minimum": 1},
+		"service": {
+			"type": "object",
+			"required": ["port"],
+			"properties": {
+				"port": {"type": "integer"},
+				"type": {"type": "string", "enum": ["ClusterIP", "NodePort"]}
+			}
+		}
+	}
+}`
+
+// openAPISchema is a kapp-controller values schema, in YAML.
+const openAPISchema = `
+properties:
+  replicaCount:
+    type: integer
+    default: 1
+  hostname:
+    type: string
+required:
+- hostname
+`
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name               string
+		values             string
+		defaults           map[string]interface{}
+		schema             string
+		expectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:   "it does not validate without a schema",
+			values: "replicaCount: foo",
+		},
+		{
+			name:   "it accepts valid values",
+			values: "replicaCount: 2\nservice:\n  port: 80\n",
+			schema: jsonSchema,
+		},
+		{
+			name:     "it validates the values merged over the defaults",
+			values:   "replicaCount: 2",
+			defaults: map[string]interface{}{"service": map[string]interface{}{"port": 80}},
+			schema:   jsonSchema,
+		},
+		{
+			name:     "it returns the path of each invalid field",
+			values:   "replicaCount: 0\nservice:\n  type: LoadBalancer\n",
+			defaults: map[string]interface{}{"service": map[string]interface{}{"type": "ClusterIP"}},
+			schema:   jsonSchema,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "values.replicaCount", Description: "Must be greater than or equal to 1"},
+				{Field: "values.service.port", Description: "port is required"},
+				{Field: "values.service.type", Description: `service.type must be one of the following: "ClusterIP", "NodePort"`},
+			},
+		},
+		{
+			name:   "it validates against an OpenAPI v3 schema in YAML",
+			values: "replicaCount: two",
+			schema: openAPISchema,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "values.hostname", Description: "hostname is required"},
+				{Field: "values.replicaCount", Description: "Invalid type. Expected: integer, given: string"},
+			},
+		},
+		{
+			name:   "it returns a violation for values which are not YAML",
+			values: "replicaCount: [",
+			schema: jsonSchema,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "values", Description: "unable to parse the values as YAML: error converting YAML to JSON: yaml: line 1: did not find expected node content"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.values, tc.defaults, []byte(tc.schema))
+
+			if tc.expectedViolations == nil {
+				if err != nil {
+					t.Fatalf("got: %+v, want: nil", err)
+				}
+				return
+			}
+			if got, want := status.Code(err), codes.InvalidArgument; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := statuserror.Reason(err), statuserror.ReasonValuesInvalid; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range status.Convert(err).Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					violations = append(violations, badRequest.GetFieldViolations()...)
+				}
+			}
+			opts := cmpopts.IgnoreUnexported(errdetails.BadRequest_FieldViolation{})
+			if got, want := violations, tc.expectedViolations; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestValidateDoesNotModifyDefaults(t *testing.T) {
+	defaults := map[string]interface{}{"service": map[string]interface{}{"port": 80}}
+	if err := Validate("service:\n  port: 8080\n", defaults, []byte(jsonSchema)); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := defaults, map[string]interface{}{"service": map[string]interface{}{"port": 80}}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
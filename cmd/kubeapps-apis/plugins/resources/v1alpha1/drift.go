@@ -0,0 +1,483 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	goerrs "errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	log "k8s.io/klog/v2"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+)
+
+// serverPopulatedMetadata are the metadata fields set by the API server or
+// by controllers rather than rendered, which are ignored when comparing.
+var serverPopulatedMetadata = []string{
+	"creationTimestamp",
+	"deletionGracePeriodSeconds",
+	"deletionTimestamp",
+	"finalizers",
+	"generation",
+	"managedFields",
+	"namespace",
+	"ownerReferences",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+// deploymentMetadataKeys are the labels and annotations, or their prefixes,
+// added to the resources by the tools deploying them rather than rendered,
+// which are ignored when they are not rendered.
+var deploymentMetadataKeys = []string{
+	"app.kubernetes.io/managed-by",
+	"deployment.kubernetes.io/revision",
+	"helm.toolkit.fluxcd.io/",
+	"kapp.k14s.io/",
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"meta.helm.sh/",
+}
+
+var simpleFieldName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// GetResourcesDrift returns the drift of the resources of an installed
+// package from their rendered manifest.
+func (s *Server) GetResourcesDrift(ctx context.Context, r *v1alpha1.GetResourcesDriftRequest) (*v1alpha1.GetResourcesDriftResponse, error) {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources GetResourcesDrift (cluster: %q, namespace=%q)", cluster, namespace)
+
+	outgoingCtx, err := copyAuthorizationMetadataForOutgoing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return nil, err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(outgoingCtx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: r.GetInstalledPackageRef(),
+		IncludeManifest:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	expectedObjects, err := objectsFromManifest(refsResponse.GetManifest())
+	if err != nil {
+		return nil, err
+	}
+
+	_, dynamicClient, err := s.clientGetter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1alpha1.GetResourcesDriftResponse{
+		FieldsCompared: refsResponse.GetManifest() != "",
+		ResourceDrifts: []*v1alpha1.ResourceDrift{},
+	}
+	for _, ref := range refsResponse.GetResourceRefs() {
+		drift := &v1alpha1.ResourceDrift{
+			ResourceRef: ref,
+			DriftType:   v1alpha1.ResourceDrift_DRIFT_TYPE_NONE,
+		}
+		live, err := s.getResource(ctx, dynamicClient, ref)
+		if errors.IsNotFound(err) {
+			drift.DriftType = v1alpha1.ResourceDrift_DRIFT_TYPE_MISSING
+		} else if err != nil {
+			return nil, err
+		} else if expected := findExpectedObject(expectedObjects, ref); expected != nil {
+			drift.FieldDrifts, err = fieldDrifts(expected, live.Object)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to compare the resource %+v: %v", ref, err)
+			}
+			if len(drift.FieldDrifts) > 0 {
+				drift.DriftType = v1alpha1.ResourceDrift_DRIFT_TYPE_CHANGED
+			}
+		}
+		if drift.DriftType != v1alpha1.ResourceDrift_DRIFT_TYPE_NONE {
+			response.Drifted = true
+		}
+		response.ResourceDrifts = append(response.ResourceDrifts, drift)
+	}
+	return response, nil
+}
+
+// resourceInterface returns the dynamic client interface for the resource
+// referenced, depending on its scope.
+func (s *Server) resourceInterface(dynamicClient dynamic.Interface, ref *pkgsGRPCv1alpha1.ResourceRef) (dynamic.ResourceInterface, error) {
+	groupVersion, err := schema.ParseGroupVersion(ref.GetApiVersion())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to parse group version from %q: %s", ref.GetApiVersion(), err.Error())
+	}
+	gvk := groupVersion.WithKind(ref.GetKind())
+	gvr, scopeName, err := s.kindToResource(s.restMapper, gvk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to map group-kind %v to resource: %s", gvk.GroupKind(), err.Error())
+	}
+	if scopeName == meta.RESTScopeNameNamespace {
+		return dynamicClient.Resource(gvr).Namespace(ref.GetNamespace()), nil
+	}
+	return dynamicClient.Resource(gvr), nil
+}
+
+// getResource returns the resource referenced. Errors other than the
+// resource not being found are converted to status errors.
+func (s *Server) getResource(ctx context.Context, dynamicClient dynamic.Interface, ref *pkgsGRPCv1alpha1.ResourceRef) (*unstructured.Unstructured, error) {
+	resourceInterface, err := s.resourceInterface(dynamicClient, ref)
+	if err != nil {
+		return nil, err
+	}
+	resource, err := resourceInterface.Get(ctx, ref.GetName(), metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, statuserror.FromK8sError("get", ref.GetKind(), ref.GetName(), err)
+	}
+	return resource, err
+}
+
+// objectsFromManifest returns the objects of a yaml manifest, with the items
+// of lists flattened.
+func objectsFromManifest(manifest string) ([]map[string]interface{}, error) {
+	decoder := yaml.NewYAMLToJSONDecoder(strings.NewReader(manifest))
+	objects := []map[string]interface{}{}
+	for {
+		doc := map[string]interface{}{}
+		err := decoder.Decode(&doc)
+		if err != nil {
+			if goerrs.Is(err, io.EOF) {
+				break
+			}
+			return nil, status.Errorf(codes.Internal, "unable to decode yaml manifest: %v", err)
+		}
+		kind, _ := doc["kind"].(string)
+		if kind == "" {
+			continue
+		}
+		if items, ok := doc["items"].([]interface{}); ok && strings.HasSuffix(kind, "List") {
+			for _, item := range items {
+				if itemObject, ok := item.(map[string]interface{}); ok {
+					objects = append(objects, itemObject)
+				}
+			}
+			continue
+		}
+		objects = append(objects, doc)
+	}
+	return objects, nil
+}
+
+// findExpectedObject returns the rendered object of the resource referenced.
+// As rendered objects may not specify their namespace, those without
+// namespace match the referenced resource in any namespace.
+func findExpectedObject(objects []map[string]interface{}, ref *pkgsGRPCv1alpha1.ResourceRef) map[string]interface{} {
+	var withoutNamespace map[string]interface{}
+	for _, o := range objects {
+		u := unstructured.Unstructured{Object: o}
+		if u.GetAPIVersion() != ref.GetApiVersion() || u.GetKind() != ref.GetKind() || u.GetName() != ref.GetName() {
+			continue
+		}
+		if u.GetNamespace() == ref.GetNamespace() {
+			return o
+		}
+		if u.GetNamespace() == "" && withoutNamespace == nil {
+			withoutNamespace = o
+		}
+	}
+	return withoutNamespace
+}
+
+// fieldDrifts returns the drift of the fields of the live object from the
+// expected one, ignoring the fields populated by the API server. Fields of
+// the live object which are not expected are only reported when a client
+// set them, according to the managed fields, so that the defaults set by
+// the API server are ignored too.
+func fieldDrifts(expected, live map[string]interface{}) ([]*v1alpha1.FieldDrift, error) {
+	normalizedExpected, err := normalizeJSON(expected)
+	if err != nil {
+		return nil, err
+	}
+	normalizedLive, err := normalizeJSON(live)
+	if err != nil {
+		return nil, err
+	}
+	expected, _ = normalizedExpected.(map[string]interface{})
+	live, _ = normalizedLive.(map[string]interface{})
+
+	managedFields, _, _ := unstructured.NestedSlice(live, "metadata", "managedFields")
+	d := &fieldDiffer{
+		owned:  ownedFieldPaths(managedFields, live),
+		secret: live["kind"] == "Secret" && live["apiVersion"] == "v1",
+	}
+	if d.secret {
+		moveStringDataToData(expected)
+	}
+	ignoreServerPopulatedFields(expected, live)
+
+	d.diff("", expected, live)
+	sort.SliceStable(d.drifts, func(i, j int) bool {
+		return d.drifts[i].Path < d.drifts[j].Path
+	})
+	return d.drifts, nil
+}
+
+// normalizeJSON returns a copy of the value as decoded from JSON, so that
+// the numbers of the rendered and live objects have the same type.
+func normalizeJSON(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(b, &normalized)
+	return normalized, err
+}
+
+// moveStringDataToData encodes the rendered string data of a Secret as its
+// data, as the API server does.
+func moveStringDataToData(secret map[string]interface{}) {
+	stringData, ok := secret["stringData"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	data, ok := secret["data"].(map[string]interface{})
+	if !ok {
+		data = map[string]interface{}{}
+		secret["data"] = data
+	}
+	for k, v := range stringData {
+		if s, ok := v.(string); ok {
+			data[k] = base64.StdEncoding.EncodeToString([]byte(s))
+		}
+	}
+	delete(secret, "stringData")
+}
+
+func ignoreServerPopulatedFields(expected, live map[string]interface{}) {
+	for _, o := range []map[string]interface{}{expected, live} {
+		delete(o, "status")
+		for _, field := range serverPopulatedMetadata {
+			unstructured.RemoveNestedField(o, "metadata", field)
+		}
+	}
+	for _, field := range []string{"labels", "annotations"} {
+		liveMetadata, _, _ := unstructured.NestedStringMap(live, "metadata", field)
+		expectedMetadata, _, _ := unstructured.NestedStringMap(expected, "metadata", field)
+		if liveMetadata == nil {
+			continue
+		}
+		for k := range liveMetadata {
+			if _, ok := expectedMetadata[k]; !ok && isDeploymentMetadataKey(k) {
+				unstructured.RemoveNestedField(live, "metadata", field, k)
+			}
+		}
+		if m, _, _ := unstructured.NestedMap(live, "metadata", field); len(m) == 0 && expectedMetadata == nil {
+			unstructured.RemoveNestedField(live, "metadata", field)
+		}
+	}
+}
+
+func isDeploymentMetadataKey(key string) bool {
+	for _, k := range deploymentMetadataKeys {
+		if key == k || (strings.HasSuffix(k, "/") && strings.HasPrefix(key, k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ownedFieldPaths returns the paths of the fields of the live object set by
+// clients, according to its managed fields.
+func ownedFieldPaths(managedFields []interface{}, live map[string]interface{}) map[string]bool {
+	owned := map[string]bool{}
+	for _, mf := range managedFields {
+		entry, ok := mf.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if fields, ok := entry["fieldsV1"].(map[string]interface{}); ok {
+			addOwnedFieldPaths(fields, live, "", owned)
+		}
+	}
+	return owned
+}
+
+// addOwnedFieldPaths walks the fields in the FieldsV1 format together with
+// the live value, to translate the keys of the items of lists to indexes.
+func addOwnedFieldPaths(fields map[string]interface{}, live interface{}, path string, owned map[string]bool) {
+	for key, value := range fields {
+		children, _ := value.(map[string]interface{})
+		switch {
+		case strings.HasPrefix(key, "f:"):
+			name := strings.TrimPrefix(key, "f:")
+			liveMap, _ := live.(map[string]interface{})
+			p := fieldPath(path, name)
+			owned[p] = true
+			addOwnedFieldPaths(children, liveMap[name], p, owned)
+		case strings.HasPrefix(key, "k:"), strings.HasPrefix(key, "v:"), strings.HasPrefix(key, "i:"):
+			liveList, _ := live.([]interface{})
+			i := listItemIndex(key, liveList)
+			if i < 0 {
+				continue
+			}
+			p := indexPath(path, i)
+			owned[p] = true
+			addOwnedFieldPaths(children, liveList[i], p, owned)
+		}
+	}
+}
+
+// listItemIndex returns the index of the item of the list identified by a
+// key of the FieldsV1 format, by its key fields, value or index.
+func listItemIndex(key string, list []interface{}) int {
+	switch {
+	case strings.HasPrefix(key, "i:"):
+		i, err := strconv.Atoi(strings.TrimPrefix(key, "i:"))
+		if err != nil || i < 0 || i >= len(list) {
+			return -1
+		}
+		return i
+	case strings.HasPrefix(key, "v:"):
+		var value interface{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, "v:")), &value); err != nil {
+			return -1
+		}
+		for i, item := range list {
+			if reflect.DeepEqual(item, value) {
+				return i
+			}
+		}
+	case strings.HasPrefix(key, "k:"):
+		keyFields := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, "k:")), &keyFields); err != nil {
+			return -1
+		}
+		for i, item := range list {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matches := true
+			for k, v := range keyFields {
+				if !reflect.DeepEqual(itemMap[k], v) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func fieldPath(path, name string) string {
+	if !simpleFieldName.MatchString(name) {
+		return fmt.Sprintf("%s[%s]", path, strconv.Quote(name))
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// fieldDiffer accumulates the drifts of the fields of an object.
+type fieldDiffer struct {
+	owned map[string]bool
+	// secret is whether the object is a Secret, whose data is not returned.
+	secret bool
+	drifts []*v1alpha1.FieldDrift
+}
+
+func (d *fieldDiffer) diff(path string, expected, live interface{}) {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		if liveMap, ok := live.(map[string]interface{}); ok {
+			for k, v := range expectedValue {
+				p := fieldPath(path, k)
+				if liveValue, ok := liveMap[k]; ok {
+					d.diff(p, v, liveValue)
+				} else {
+					d.add(p, v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_DELETED, v, nil)
+				}
+			}
+			for k, v := range liveMap {
+				p := fieldPath(path, k)
+				if _, ok := expectedValue[k]; !ok && d.owned[p] {
+					d.add(p, v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_EXTRA, nil, v)
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if liveList, ok := live.([]interface{}); ok {
+			for i, v := range expectedValue {
+				p := indexPath(path, i)
+				if i < len(liveList) {
+					d.diff(p, v, liveList[i])
+				} else {
+					d.add(p, v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_DELETED, v, nil)
+				}
+			}
+			for i := len(expectedValue); i < len(liveList); i++ {
+				p := indexPath(path, i)
+				if d.owned[p] {
+					d.add(p, v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_EXTRA, nil, liveList[i])
+				}
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(expected, live) {
+		d.add(path, v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_CHANGED, expected, live)
+	}
+}
+
+func (d *fieldDiffer) add(path string, driftType v1alpha1.FieldDrift_FieldDriftType, expected, live interface{}) {
+	drift := &v1alpha1.FieldDrift{
+		Path:           path,
+		FieldDriftType: driftType,
+	}
+	if !d.secret || !(path == "data" || strings.HasPrefix(path, "data.") || strings.HasPrefix(path, "data[")) {
+		drift.ExpectedValue = jsonValue(expected)
+		drift.LiveValue = jsonValue(live)
+	}
+	d.drifts = append(d.drifts, drift)
+}
+
+func jsonValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,444 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+)
+
+func objectFromYAML(t *testing.T, s string) map[string]interface{} {
+	object := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(s), &object); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return object
+}
+
+func TestFieldDrifts(t *testing.T) {
+	deployment := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    app: web
+spec:
+  replicas: 2
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+        - name: web
+          image: nginx:1.21
+          env:
+            - name: A
+              value: "1"
+          resources:
+            limits:
+              cpu: 100m
+`
+
+	testCases := []struct {
+		name           string
+		expected       string
+		live           string
+		expectedDrifts []*v1alpha1.FieldDrift
+	}{
+		{
+			name:     "it ignores the fields populated by the server",
+			expected: deployment,
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  uid: 1234
+  resourceVersion: "42"
+  generation: 2
+  creationTimestamp: "2022-06-01T10:00:00Z"
+  labels:
+    app: web
+    app.kubernetes.io/managed-by: Helm
+  annotations:
+    meta.helm.sh/release-name: web
+    meta.helm.sh/release-namespace: default
+    deployment.kubernetes.io/revision: "2"
+  managedFields:
+    - manager: helm
+      operation: Update
+      fieldsType: FieldsV1
+      fieldsV1:
+        f:spec:
+          f:replicas: {}
+spec:
+  replicas: 2
+  progressDeadlineSeconds: 600
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      dnsPolicy: ClusterFirst
+      containers:
+        - name: web
+          image: nginx:1.21
+          imagePullPolicy: IfNotPresent
+          env:
+            - name: A
+              value: "1"
+          resources:
+            limits:
+              cpu: 100m
+status:
+  replicas: 2
+`,
+			expectedDrifts: nil,
+		},
+		{
+			name:     "it returns the changed, extra and deleted fields",
+			expected: deployment,
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  labels:
+    app: web
+  managedFields:
+    - manager: helm
+      operation: Update
+      fieldsType: FieldsV1
+      fieldsV1:
+        f:spec:
+          f:replicas: {}
+          f:template:
+            f:spec:
+              f:containers:
+                k:{"name":"web"}:
+                  .: {}
+                  f:image: {}
+                  f:name: {}
+                  f:env:
+                    k:{"name":"A"}:
+                      .: {}
+                      f:name: {}
+                      f:value: {}
+    - manager: kubectl-edit
+      operation: Update
+      fieldsType: FieldsV1
+      fieldsV1:
+        f:spec:
+          f:template:
+            f:metadata:
+              f:annotations:
+                f:kubectl.kubernetes.io/restartedAt: {}
+            f:spec:
+              f:containers:
+                k:{"name":"web"}:
+                  f:env:
+                    k:{"name":"B"}:
+                      .: {}
+                      f:name: {}
+                      f:value: {}
+spec:
+  replicas: 5
+  progressDeadlineSeconds: 600
+  template:
+    metadata:
+      labels:
+        app: web
+      annotations:
+        kubectl.kubernetes.io/restartedAt: "2022-06-01T10:00:00Z"
+    spec:
+      containers:
+        - name: web
+          image: nginx:1.22
+          imagePullPolicy: IfNotPresent
+          env:
+            - name: A
+              value: "1"
+            - name: B
+              value: "2"
+`,
+			expectedDrifts: []*v1alpha1.FieldDrift{
+				{
+					Path:           "spec.replicas",
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_CHANGED,
+					ExpectedValue:  "2",
+					LiveValue:      "5",
+				},
+				{
+					Path:           "spec.template.metadata.annotations",
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_EXTRA,
+					LiveValue:      `{"kubectl.kubernetes.io/restartedAt":"2022-06-01T10:00:00Z"}`,
+				},
+				{
+					Path:           "spec.template.spec.containers[0].env[1]",
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_EXTRA,
+					LiveValue:      `{"name":"B","value":"2"}`,
+				},
+				{
+					Path:           "spec.template.spec.containers[0].image",
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_CHANGED,
+					ExpectedValue:  `"nginx:1.21"`,
+					LiveValue:      `"nginx:1.22"`,
+				},
+				{
+					Path:           "spec.template.spec.containers[0].resources",
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_DELETED,
+					ExpectedValue:  `{"limits":{"cpu":"100m"}}`,
+				},
+			},
+		},
+		{
+			name: "it quotes the names of fields which are not identifiers",
+			expected: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  app.properties: a=1
+`,
+			live: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  app.properties: a=2
+`,
+			expectedDrifts: []*v1alpha1.FieldDrift{
+				{
+					Path:           `data["app.properties"]`,
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_CHANGED,
+					ExpectedValue:  `"a=1"`,
+					LiveValue:      `"a=2"`,
+				},
+			},
+		},
+		{
+			name: "it compares the string data of secrets without returning their values",
+			expected: `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+data:
+  username: YWRtaW4=
+stringData:
+  password: foo
+`,
+			live: `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+type: Opaque
+data:
+  username: YWRtaW4=
+  password: YmFy
+`,
+			expectedDrifts: []*v1alpha1.FieldDrift{
+				{
+					Path:           "data.password",
+					FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_CHANGED,
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(v1alpha1.FieldDrift{})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			drifts, err := fieldDrifts(objectFromYAML(t, tc.expected), objectFromYAML(t, tc.live))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := drifts, tc.expectedDrifts; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
+func TestGetResourcesDrift(t *testing.T) {
+	manifest := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: some-deployment
+spec:
+  replicas: 2
+---
+apiVersion: core/v1
+kind: ConfigMap
+metadata:
+  name: some-configmap
+data:
+  foo: bar
+`
+	deploymentRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "some-deployment",
+		Namespace:  "default",
+	}
+	configMapRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "core/v1",
+		Kind:       "ConfigMap",
+		Name:       "some-configmap",
+		Namespace:  "default",
+	}
+	replicas := func(r int32) *int32 { return &r }
+
+	testCases := []struct {
+		name              string
+		manifest          string
+		clusterObjects    []runtime.Object
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedResponse  *v1alpha1.GetResourcesDriftResponse
+	}{
+		{
+			name:              "it returns permission denied for a request without auth",
+			manifest:          manifest,
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name:     "it returns the changed and missing resources",
+			manifest: manifest,
+			clusterObjects: []runtime.Object{
+				&apps.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Deployment",
+						APIVersion: "apps/v1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-deployment",
+						Namespace: "default",
+					},
+					Spec: apps.DeploymentSpec{
+						Replicas: replicas(3),
+					},
+				},
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponse: &v1alpha1.GetResourcesDriftResponse{
+				Drifted:        true,
+				FieldsCompared: true,
+				ResourceDrifts: []*v1alpha1.ResourceDrift{
+					{
+						ResourceRef: deploymentRef,
+						DriftType:   v1alpha1.ResourceDrift_DRIFT_TYPE_CHANGED,
+						FieldDrifts: []*v1alpha1.FieldDrift{
+							{
+								Path:           "spec.replicas",
+								FieldDriftType: v1alpha1.FieldDrift_FIELD_DRIFT_TYPE_CHANGED,
+								ExpectedValue:  "2",
+								LiveValue:      "3",
+							},
+						},
+					},
+					{
+						ResourceRef: configMapRef,
+						DriftType:   v1alpha1.ResourceDrift_DRIFT_TYPE_MISSING,
+					},
+				},
+			},
+		},
+		{
+			name: "it only reports missing resources without a manifest",
+			clusterObjects: []runtime.Object{
+				&apps.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Deployment",
+						APIVersion: "apps/v1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-deployment",
+						Namespace: "default",
+					},
+					Spec: apps.DeploymentSpec{
+						Replicas: replicas(3),
+					},
+				},
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponse: &v1alpha1.GetResourcesDriftResponse{
+				Drifted: true,
+				ResourceDrifts: []*v1alpha1.ResourceDrift{
+					{
+						ResourceRef: deploymentRef,
+						DriftType:   v1alpha1.ResourceDrift_DRIFT_TYPE_NONE,
+					},
+					{
+						ResourceRef: configMapRef,
+						DriftType:   v1alpha1.ResourceDrift_DRIFT_TYPE_MISSING,
+					},
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetResourcesDriftResponse{},
+		v1alpha1.ResourceDrift{},
+		v1alpha1.FieldDrift{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refs := []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef, configMapRef}
+			client, _, cleanup := getResourcesClientWithRefs(t, refs, tc.manifest, tc.clusterObjects...)
+			defer cleanup()
+
+			ctx := context.Background()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			response, err := client.GetResourcesDrift(ctx, &v1alpha1.GetResourcesDriftRequest{
+				InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+					Context: &pkgsGRPCv1alpha1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "some-package",
+					Plugin:     fakePkgsPlugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoredUnexported) {
+				responseJSON, _ := json.Marshal(response)
+				t.Errorf("mismatch (-want +got):\n%s\n%s", cmp.Diff(want, got, ignoredUnexported), responseJSON)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	log "k8s.io/klog/v2"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+)
+
+// GetResourcesEvents returns, and optionally watches, the events of the
+// resources of an installed package.
+func (s *Server) GetResourcesEvents(r *v1alpha1.GetResourcesEventsRequest, stream v1alpha1.ResourcesService_GetResourcesEventsServer) error {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources GetResourcesEvents (cluster: %q, namespace=%q)", cluster, namespace)
+
+	ctx, err := copyAuthorizationMetadataForOutgoing(stream.Context())
+	if err != nil {
+		return err
+	}
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(ctx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: r.GetInstalledPackageRef(),
+	})
+	if err != nil {
+		return err
+	}
+	refs := refsResponse.GetResourceRefs()
+
+	typedClient, dynamicClient, err := s.clientGetter(stream.Context(), cluster)
+	if err != nil {
+		return err
+	}
+	involved, err := s.involvedObjects(stream.Context(), typedClient, dynamicClient, refs)
+	if err != nil {
+		return err
+	}
+
+	// The events of every involved object are listed, or watched, once per
+	// namespace and then filtered, rather than once per involved object. The
+	// namespaces in which the user cannot read the events, such as the
+	// default namespace of the events of cluster-scoped resources, are
+	// skipped, unless the user cannot read the events of any namespace.
+	namespaces := eventsNamespaces(refs)
+
+	if !r.GetWatch() {
+		var responses []*v1alpha1.GetResourcesEventsResponse
+		forbidden := 0
+		for _, ns := range namespaces {
+			events, err := typedClient.CoreV1().Events(ns).List(stream.Context(), metav1.ListOptions{})
+			if errors.IsForbidden(err) && forbidden+1 < len(namespaces) {
+				forbidden++
+				log.Infof("+resources unable to list the events in namespace %q: %v", ns, err)
+				continue
+			} else if err != nil {
+				return statuserror.FromK8sError("list", "Events", "", err)
+			}
+			for i := range events.Items {
+				if resourceRef, ok := involved[eventObjectKey(&events.Items[i])]; ok {
+					responses = append(responses, eventResponse(resourceRef, &events.Items[i]))
+				}
+			}
+		}
+		sort.SliceStable(responses, func(i, j int) bool {
+			return responses[i].GetLastTimestamp().AsTime().Before(responses[j].GetLastTimestamp().AsTime())
+		})
+		for _, response := range responses {
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var watchers []*ResourceWatcher
+	forbidden := 0
+	for _, ns := range namespaces {
+		watcher, err := typedClient.CoreV1().Events(ns).Watch(stream.Context(), metav1.ListOptions{})
+		if errors.IsForbidden(err) && forbidden+1 < len(namespaces) {
+			forbidden++
+			log.Infof("+resources unable to watch the events in namespace %q: %v", ns, err)
+			continue
+		} else if err != nil {
+			log.Errorf("unable to watch events in namespace %q: %v", ns, err)
+			for _, w := range watchers {
+				w.Stop()
+			}
+			return statuserror.FromK8sError("watch", "Events", "", err)
+		}
+		watchers = append(watchers, &ResourceWatcher{Watcher: watcher})
+	}
+	if watchers == nil {
+		return nil
+	}
+
+	resourceWatcher := mergeWatchers(watchers)
+	// unresolved are the pods and ReplicaSets already known not to belong to
+	// the resources of the installed package.
+	unresolved := map[string]bool{}
+	for e := range resourceWatcher.ResultChan() {
+		event, ok := e.Object.(*core.Event)
+		if !ok || e.Type == watch.Deleted {
+			continue
+		}
+		key := eventObjectKey(event)
+		resourceRef, ok := involved[key]
+		if !ok && isOwnedWorkloadKind(event.InvolvedObject.Kind) && !unresolved[key] {
+			// A pod or ReplicaSet created since the involved objects were
+			// resolved may belong to a resource of the installed package.
+			if resolved, err := s.involvedObjects(stream.Context(), typedClient, dynamicClient, refs); err != nil {
+				log.Errorf("unable to resolve the objects involved in the events of %v: %v", refs, err)
+			} else {
+				involved = resolved
+			}
+			if resourceRef, ok = involved[key]; !ok {
+				unresolved[key] = true
+			}
+		}
+		if !ok {
+			continue
+		}
+		if err := stream.Send(eventResponse(resourceRef, event)); err != nil {
+			resourceWatcher.Stop()
+			// Drain the remaining events so that the merging goroutines
+			// can finish.
+			go func() {
+				for range resourceWatcher.ResultChan() {
+				}
+			}()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// involvedObjects returns the objects whose events are returned for the
+// resources of an installed package, mapped by key to the resource ref they
+// belong to: the resources themselves, the pods of the workloads and the
+// ReplicaSets of the Deployments. Only the workloads are fetched, and those
+// or the pods and ReplicaSets which the user is not allowed to read are
+// skipped.
+func (s *Server) involvedObjects(ctx context.Context, typedClient kubernetes.Interface, dynamicClient dynamic.Interface, refs []*pkgsGRPCv1alpha1.ResourceRef) (map[string]*pkgsGRPCv1alpha1.ResourceRef, error) {
+	objects := map[string]*pkgsGRPCv1alpha1.ResourceRef{}
+	add := func(resourceRef *pkgsGRPCv1alpha1.ResourceRef, kind, name, namespace string) {
+		if key := involvedObjectKey(kind, namespace, name); objects[key] == nil {
+			objects[key] = resourceRef
+		}
+	}
+
+	for _, ref := range refs {
+		add(ref, ref.GetKind(), ref.GetName(), ref.GetNamespace())
+		if !isWorkloadKind(ref.GetKind()) {
+			continue
+		}
+
+		resource, err := s.getResource(ctx, dynamicClient, ref)
+		if errors.IsNotFound(err) {
+			// The events of a deleted resource may still explain why it
+			// was deleted.
+			continue
+		} else if status.Code(err) == codes.PermissionDenied {
+			log.Infof("+resources unable to get the %s %s/%s: %v", ref.GetKind(), ref.GetNamespace(), ref.GetName(), err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if resource.GetKind() == "Deployment" {
+			replicaSets, err := deploymentReplicaSets(ctx, typedClient, resource)
+			if errors.IsForbidden(err) {
+				log.Infof("+resources unable to list the ReplicaSets of Deployment %s/%s: %v", ref.GetNamespace(), ref.GetName(), err)
+			} else if err != nil {
+				return nil, statuserror.FromK8sError("list", "ReplicaSets", "", err)
+			}
+			for _, rs := range replicaSets {
+				add(ref, "ReplicaSet", rs.Name, rs.Namespace)
+			}
+		}
+
+		pods, err := workloadPods(ctx, typedClient, resource)
+		if errors.IsForbidden(err) {
+			log.Infof("+resources unable to list the pods of %s %s/%s: %v", ref.GetKind(), ref.GetNamespace(), ref.GetName(), err)
+			continue
+		} else if err != nil {
+			return nil, statuserror.FromK8sError("list", "Pods", "", err)
+		}
+		for _, pod := range pods {
+			add(ref, "Pod", pod.Name, pod.Namespace)
+		}
+	}
+	return objects, nil
+}
+
+// isOwnedWorkloadKind returns whether objects of a kind are created by the
+// workloads of an installed package rather than being part of it.
+func isOwnedWorkloadKind(kind string) bool {
+	return kind == "Pod" || kind == "ReplicaSet"
+}
+
+// deploymentReplicaSets returns the ReplicaSets controlled by a Deployment.
+func deploymentReplicaSets(ctx context.Context, typedClient kubernetes.Interface, deployment *unstructured.Unstructured) ([]metav1.ObjectMeta, error) {
+	selector := workloadSelector(deployment)
+	if selector == nil {
+		return nil, nil
+	}
+	replicaSets, err := typedClient.AppsV1().ReplicaSets(deployment.GetNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var owned []metav1.ObjectMeta
+	for _, rs := range replicaSets.Items {
+		if metav1.IsControlledBy(&rs, deployment) {
+			owned = append(owned, rs.ObjectMeta)
+		}
+	}
+	return owned, nil
+}
+
+// eventsNamespaces returns the namespaces of the events of the resources,
+// the events of cluster-scoped resources being in the default namespace.
+func eventsNamespaces(refs []*pkgsGRPCv1alpha1.ResourceRef) []string {
+	seen := map[string]bool{}
+	var namespaces []string
+	for _, ref := range refs {
+		ns := ref.GetNamespace()
+		if ns == "" {
+			ns = metav1.NamespaceDefault
+		}
+		if !seen[ns] {
+			seen[ns] = true
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// eventObjectKey returns the key of the object which an event is about.
+func eventObjectKey(event *core.Event) string {
+	return involvedObjectKey(event.InvolvedObject.Kind, event.InvolvedObject.Namespace, event.InvolvedObject.Name)
+}
+
+func involvedObjectKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// eventResponse converts an event, which may use either the original or the
+// series fields for its count and timestamps.
+func eventResponse(resourceRef *pkgsGRPCv1alpha1.ResourceRef, event *core.Event) *v1alpha1.GetResourcesEventsResponse {
+	count := event.Count
+	first, last := event.FirstTimestamp.Time, event.LastTimestamp.Time
+	if event.Series != nil {
+		count = event.Series.Count
+		last = event.Series.LastObservedTime.Time
+	}
+	if first.IsZero() {
+		first = event.EventTime.Time
+	}
+	if last.IsZero() {
+		last = first
+	}
+	if count == 0 {
+		count = 1
+	}
+	source := event.Source.Component
+	if source == "" {
+		source = event.ReportingController
+	}
+
+	return &v1alpha1.GetResourcesEventsResponse{
+		ResourceRef: resourceRef,
+		InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+			ApiVersion: event.InvolvedObject.APIVersion,
+			Kind:       event.InvolvedObject.Kind,
+			Name:       event.InvolvedObject.Name,
+			Namespace:  event.InvolvedObject.Namespace,
+		},
+		Type:           event.Type,
+		Reason:         event.Reason,
+		Message:        event.Message,
+		Count:          count,
+		FirstTimestamp: timestampOrNil(first),
+		LastTimestamp:  timestampOrNil(last),
+		Source:         source,
+	}
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
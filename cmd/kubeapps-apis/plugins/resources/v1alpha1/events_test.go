@@ -0,0 +1,602 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	clientGoTesting "k8s.io/client-go/testing"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+)
+
+func TestGetResourcesEvents(t *testing.T) {
+	isController := true
+	deployment := &apps.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+			UID:       "deployment-uid",
+		},
+		Spec: apps.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+	}
+	replicaSet := &apps.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReplicaSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "web",
+					UID:        "deployment-uid",
+					Controller: &isController,
+				},
+			},
+		},
+	}
+	pod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc-1",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+	}
+	t1 := metav1.NewTime(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC))
+	t2 := metav1.NewTime(t1.Add(time.Minute))
+	t3 := metav1.NewTime(t1.Add(2 * time.Minute))
+	newEvent := func(name, kind, objectName, eventType, reason string, count int32, timestamp metav1.Time) *core.Event {
+		return &core.Event{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Event",
+				APIVersion: "core/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			InvolvedObject: core.ObjectReference{
+				Kind:      kind,
+				Name:      objectName,
+				Namespace: "default",
+			},
+			Type:           eventType,
+			Reason:         reason,
+			Message:        reason + " message",
+			Count:          count,
+			FirstTimestamp: t1,
+			LastTimestamp:  timestamp,
+			Source:         core.EventSource{Component: "some-controller"},
+		}
+	}
+
+	deploymentRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "web",
+		Namespace:  "default",
+	}
+
+	testCases := []struct {
+		name              string
+		clusterObjects    []runtime.Object
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedResponses []*v1alpha1.GetResourcesEventsResponse
+	}{
+		{
+			name:              "it returns permission denied for a request without auth",
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name: "it returns the events of the resources, their ReplicaSets and pods ordered by time",
+			clusterObjects: []runtime.Object{
+				deployment,
+				replicaSet,
+				pod,
+				newEvent("e1", "Deployment", "web", "Normal", "ScalingReplicaSet", 1, t1),
+				newEvent("e2", "Pod", "web-abc-1", "Warning", "FailedScheduling", 3, t3),
+				newEvent("e3", "ReplicaSet", "web-abc", "Normal", "SuccessfulCreate", 1, t2),
+				newEvent("e4", "Pod", "other", "Warning", "BackOff", 1, t2),
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponses: []*v1alpha1.GetResourcesEventsResponse{
+				{
+					ResourceRef: deploymentRef,
+					InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+						Kind:      "Deployment",
+						Name:      "web",
+						Namespace: "default",
+					},
+					Type:           "Normal",
+					Reason:         "ScalingReplicaSet",
+					Message:        "ScalingReplicaSet message",
+					Count:          1,
+					FirstTimestamp: timestamppb.New(t1.Time),
+					LastTimestamp:  timestamppb.New(t1.Time),
+					Source:         "some-controller",
+				},
+				{
+					ResourceRef: deploymentRef,
+					InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+						Kind:      "ReplicaSet",
+						Name:      "web-abc",
+						Namespace: "default",
+					},
+					Type:           "Normal",
+					Reason:         "SuccessfulCreate",
+					Message:        "SuccessfulCreate message",
+					Count:          1,
+					FirstTimestamp: timestamppb.New(t1.Time),
+					LastTimestamp:  timestamppb.New(t2.Time),
+					Source:         "some-controller",
+				},
+				{
+					ResourceRef: deploymentRef,
+					InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+						Kind:      "Pod",
+						Name:      "web-abc-1",
+						Namespace: "default",
+					},
+					Type:           "Warning",
+					Reason:         "FailedScheduling",
+					Message:        "FailedScheduling message",
+					Count:          3,
+					FirstTimestamp: timestamppb.New(t1.Time),
+					LastTimestamp:  timestamppb.New(t3.Time),
+					Source:         "some-controller",
+				},
+			},
+		},
+		{
+			name: "it returns the events of a deleted resource",
+			clusterObjects: []runtime.Object{
+				newEvent("e1", "Deployment", "web", "Normal", "ScalingReplicaSet", 1, t1),
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponses: []*v1alpha1.GetResourcesEventsResponse{
+				{
+					ResourceRef: deploymentRef,
+					InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+						Kind:      "Deployment",
+						Name:      "web",
+						Namespace: "default",
+					},
+					Type:           "Normal",
+					Reason:         "ScalingReplicaSet",
+					Message:        "ScalingReplicaSet message",
+					Count:          1,
+					FirstTimestamp: timestamppb.New(t1.Time),
+					LastTimestamp:  timestamppb.New(t1.Time),
+					Source:         "some-controller",
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetResourcesEventsResponse{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+		timestamppb.Timestamp{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refs := []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef}
+			client, _, cleanup := getResourcesClientWithRefs(t, refs, "", tc.clusterObjects...)
+			defer cleanup()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			responseStream, err := client.GetResourcesEvents(ctx, &v1alpha1.GetResourcesEventsRequest{
+				InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+					Context: &pkgsGRPCv1alpha1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "some-package",
+					Plugin:     fakePkgsPlugin,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			var responses []*v1alpha1.GetResourcesEventsResponse
+			for {
+				response, err := responseStream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+					t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				}
+				if err != nil {
+					return
+				}
+				responses = append(responses, response)
+			}
+
+			if got, want := responses, tc.expectedResponses; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
+func TestGetResourcesEventsWatch(t *testing.T) {
+	deployment := &apps.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+			UID:       "deployment-uid",
+		},
+		Spec: apps.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+	}
+	serviceRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "v1",
+		Kind:       "Service",
+		Name:       "web",
+		Namespace:  "default",
+	}
+	deploymentRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "web",
+		Namespace:  "default",
+	}
+	t1 := metav1.NewTime(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC))
+	newEvent := func(name, kind, objectName, reason string) *core.Event {
+		return &core.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			InvolvedObject: core.ObjectReference{
+				Kind:      kind,
+				Name:      objectName,
+				Namespace: "default",
+			},
+			Type:           "Normal",
+			Reason:         reason,
+			Count:          1,
+			FirstTimestamp: t1,
+			LastTimestamp:  t1,
+		}
+	}
+
+	refs := []*pkgsGRPCv1alpha1.ResourceRef{serviceRef, deploymentRef}
+	client, _, typedClient, cleanup := getResourcesClientWithFakeClients(t, refs, "", deployment)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+
+	responseStream, err := client.GetResourcesEvents(ctx, &v1alpha1.GetResourcesEventsRequest{
+		InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+			Context: &pkgsGRPCv1alpha1.Context{
+				Cluster:   "default",
+				Namespace: "default",
+			},
+			Identifier: "some-package",
+			Plugin:     fakePkgsPlugin,
+		},
+		Watch: true,
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// Wait for the events to be watched before creating any.
+	watchedEvents := func() int {
+		count := 0
+		for _, action := range typedClient.Actions() {
+			if action.GetVerb() == "watch" && action.GetResource().Resource == "events" {
+				count++
+			}
+		}
+		return count
+	}
+	for watchedEvents() == 0 {
+		if ctx.Err() != nil {
+			t.Fatalf("the events were not watched: %+v", ctx.Err())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// A pod created for the deployment once the watch started is only known
+	// after resolving the objects of the resources again.
+	if _, err := typedClient.CoreV1().Pods("default").Create(ctx, &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc-1",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, event := range []*core.Event{
+		newEvent("e1", "Pod", "other", "BackOff"),
+		newEvent("e2", "Pod", "web-abc-1", "Scheduled"),
+		newEvent("e3", "Service", "web", "Updated"),
+	} {
+		if _, err := typedClient.CoreV1().Events("default").Create(ctx, event, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+
+	var responses []*v1alpha1.GetResourcesEventsResponse
+	for len(responses) < 2 {
+		response, err := responseStream.Recv()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		responses = append(responses, response)
+	}
+
+	expectedResponses := []*v1alpha1.GetResourcesEventsResponse{
+		{
+			ResourceRef: deploymentRef,
+			InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+				Kind:      "Pod",
+				Name:      "web-abc-1",
+				Namespace: "default",
+			},
+			Type:           "Normal",
+			Reason:         "Scheduled",
+			Count:          1,
+			FirstTimestamp: timestamppb.New(t1.Time),
+			LastTimestamp:  timestamppb.New(t1.Time),
+		},
+		{
+			ResourceRef: serviceRef,
+			InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+				Kind:      "Service",
+				Name:      "web",
+				Namespace: "default",
+			},
+			Type:           "Normal",
+			Reason:         "Updated",
+			Count:          1,
+			FirstTimestamp: timestamppb.New(t1.Time),
+			LastTimestamp:  timestamppb.New(t1.Time),
+		},
+	}
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetResourcesEventsResponse{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+		timestamppb.Timestamp{},
+	)
+	if got, want := responses, expectedResponses; !cmp.Equal(want, got, ignoredUnexported) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+	}
+
+	// The events of both resources are watched once for their namespace.
+	if got, want := watchedEvents(), 1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
+
+func TestGetResourcesEventsSkipsForbiddenNamespaces(t *testing.T) {
+	clusterRoleRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "rbac.authorization.k8s.io/v1",
+		Kind:       "ClusterRole",
+		Name:       "web",
+	}
+	configMapRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       "web",
+		Namespace:  "team-a",
+	}
+	t1 := metav1.NewTime(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC))
+	newEvent := func(name, namespace, kind, objectName string) *core.Event {
+		return &core.Event{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Event",
+				APIVersion: "core/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			InvolvedObject: core.ObjectReference{
+				Kind:      kind,
+				Name:      objectName,
+				Namespace: namespace,
+			},
+			Type:           "Normal",
+			Reason:         "Updated",
+			Count:          1,
+			FirstTimestamp: t1,
+			LastTimestamp:  t1,
+		}
+	}
+	expectedResponses := []*v1alpha1.GetResourcesEventsResponse{
+		{
+			ResourceRef: configMapRef,
+			InvolvedObjectRef: &pkgsGRPCv1alpha1.ResourceRef{
+				Kind:      "ConfigMap",
+				Name:      "web",
+				Namespace: "team-a",
+			},
+			Type:           "Normal",
+			Reason:         "Updated",
+			Count:          1,
+			FirstTimestamp: timestamppb.New(t1.Time),
+			LastTimestamp:  timestamppb.New(t1.Time),
+		},
+	}
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetResourcesEventsResponse{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+		timestamppb.Timestamp{},
+	)
+
+	// The events of the cluster-scoped resources are in the default
+	// namespace, where the user cannot read the events.
+	refs := []*pkgsGRPCv1alpha1.ResourceRef{clusterRoleRef, configMapRef}
+	client, dynamicClient, typedClient, cleanup := getResourcesClientWithFakeClients(t, refs, "", newEvent("e1", "team-a", "ConfigMap", "web"))
+	defer cleanup()
+	forbidden := k8serrors.NewForbidden(schema.GroupResource{Resource: "events"}, "", nil)
+	typedClient.PrependReactor("list", "events", func(action clientGoTesting.Action) (bool, runtime.Object, error) {
+		return action.GetNamespace() == metav1.NamespaceDefault, nil, forbidden
+	})
+	typedClient.PrependWatchReactor("events", func(action clientGoTesting.Action) (bool, watch.Interface, error) {
+		return action.GetNamespace() == metav1.NamespaceDefault, nil, forbidden
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+	request := &v1alpha1.GetResourcesEventsRequest{
+		InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+			Context: &pkgsGRPCv1alpha1.Context{
+				Cluster:   "default",
+				Namespace: "team-a",
+			},
+			Identifier: "some-package",
+			Plugin:     fakePkgsPlugin,
+		},
+	}
+
+	responseStream, err := client.GetResourcesEvents(ctx, request)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var responses []*v1alpha1.GetResourcesEventsResponse
+	for {
+		response, err := responseStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		responses = append(responses, response)
+	}
+	if got, want := responses, expectedResponses; !cmp.Equal(want, got, ignoredUnexported) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+	}
+
+	request.Watch = true
+	responseStream, err = client.GetResourcesEvents(ctx, request)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	watched := func() bool {
+		for _, action := range typedClient.Actions() {
+			if action.GetVerb() == "watch" && action.GetNamespace() == "team-a" {
+				return true
+			}
+		}
+		return false
+	}
+	for !watched() {
+		if ctx.Err() != nil {
+			t.Fatalf("the events were not watched: %+v", ctx.Err())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := typedClient.CoreV1().Events("team-a").Create(ctx, newEvent("e2", "team-a", "ConfigMap", "web"), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	response, err := responseStream.Recv()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := []*v1alpha1.GetResourcesEventsResponse{response}, expectedResponses; !cmp.Equal(want, got, ignoredUnexported) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+	}
+
+	// Only the workloads are fetched to resolve the objects involved.
+	if got := dynamicClient.Actions(); len(got) != 0 {
+		t.Errorf("unexpected actions: %+v", got)
+	}
+}
+
+func TestGetResourcesEventsFailsWhenNoNamespaceIsReadable(t *testing.T) {
+	refs := []*pkgsGRPCv1alpha1.ResourceRef{
+		{
+			ApiVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "web",
+			Namespace:  "team-a",
+		},
+	}
+	client, _, typedClient, cleanup := getResourcesClientWithFakeClients(t, refs, "")
+	defer cleanup()
+	typedClient.PrependReactor("list", "events", func(action clientGoTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "events"}, "", nil)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+	responseStream, err := client.GetResourcesEvents(ctx, &v1alpha1.GetResourcesEventsRequest{
+		InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+			Context: &pkgsGRPCv1alpha1.Context{
+				Cluster:   "default",
+				Namespace: "team-a",
+			},
+			Identifier: "some-package",
+			Plugin:     fakePkgsPlugin,
+		},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	_, err = responseStream.Recv()
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
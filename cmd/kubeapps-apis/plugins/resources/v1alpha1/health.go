@@ -0,0 +1,595 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	log "k8s.io/klog/v2"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+)
+
+const (
+	healthCurrent    = v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT
+	healthInProgress = v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS
+	healthFailed     = v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED
+)
+
+// builtinKindHealth are the functions evaluating the health of the built-in
+// kinds which report their status without conditions.
+var builtinKindHealth = map[schema.GroupKind]func(map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string){
+	{Group: "apps", Kind: "DaemonSet"}:             daemonSetHealth,
+	{Group: "apps", Kind: "Deployment"}:            deploymentHealth,
+	{Group: "apps", Kind: "ReplicaSet"}:            replicaSetHealth,
+	{Group: "apps", Kind: "StatefulSet"}:           statefulSetHealth,
+	{Group: "batch", Kind: "Job"}:                  jobHealth,
+	{Group: "", Kind: "Endpoints"}:                 endpointsHealth,
+	{Group: "", Kind: "PersistentVolumeClaim"}:     pvcHealth,
+	{Group: "", Kind: "Pod"}:                       podHealth,
+	{Group: "", Kind: "Service"}:                   serviceHealth,
+	{Group: "policy", Kind: "PodDisruptionBudget"}: pdbHealth,
+}
+
+// failedContainerReasons are the reasons for which a waiting container will
+// not start without an intervention.
+var failedContainerReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"CreateContainerConfigError": true,
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+}
+
+// GetResourcesHealth returns the health of the resources of an installed
+// package, together with a summary for the installed package as a whole.
+func (s *Server) GetResourcesHealth(ctx context.Context, r *v1alpha1.GetResourcesHealthRequest) (*v1alpha1.GetResourcesHealthResponse, error) {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources GetResourcesHealth (cluster: %q, namespace=%q)", cluster, namespace)
+
+	outgoingCtx, err := copyAuthorizationMetadataForOutgoing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return nil, err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(outgoingCtx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: r.GetInstalledPackageRef(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	typedClient, dynamicClient, err := s.clientGetter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1alpha1.GetResourcesHealthResponse{
+		ResourceHealths: []*v1alpha1.ResourceRefHealth{},
+	}
+	for _, ref := range refsResponse.GetResourceRefs() {
+		resource, err := s.getResource(ctx, dynamicClient, ref)
+		var health *v1alpha1.ResourceHealth
+		if errors.IsNotFound(err) {
+			health = &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_NOT_FOUND,
+				Message: "Resource not found",
+			}
+		} else if err != nil {
+			return nil, err
+		} else {
+			health = resourceHealth(resource)
+			if health.GetStatus() == healthInProgress {
+				health, err = workloadPodsHealth(ctx, typedClient, resource, health)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		response.ResourceHealths = append(response.ResourceHealths, &v1alpha1.ResourceRefHealth{
+			ResourceRef: ref,
+			Health:      health,
+		})
+	}
+	response.Health = aggregateHealth(response.ResourceHealths)
+	return response, nil
+}
+
+// aggregateHealth rolls the health of many resources into a single one,
+// mirroring the kstatus aggregation when the desired status is current.
+func aggregateHealth(healths []*v1alpha1.ResourceRefHealth) *v1alpha1.ResourceHealth {
+	var failed, notCurrent, unknown []string
+	for _, h := range healths {
+		ref := h.GetResourceRef()
+		description := fmt.Sprintf("%s %s: %s", ref.GetKind(), ref.GetName(), h.GetHealth().GetMessage())
+		switch h.GetHealth().GetStatus() {
+		case healthCurrent:
+		case healthFailed:
+			failed = append(failed, description)
+		case v1alpha1.ResourceHealth_HEALTH_STATUS_UNSPECIFIED:
+			unknown = append(unknown, description)
+		default:
+			notCurrent = append(notCurrent, description)
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		return &v1alpha1.ResourceHealth{
+			Status:  healthFailed,
+			Message: fmt.Sprintf("%d of %d resources failed: %s", len(failed), len(healths), strings.Join(failed, "; ")),
+		}
+	case len(unknown) > 0:
+		return &v1alpha1.ResourceHealth{
+			Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_UNSPECIFIED,
+			Message: fmt.Sprintf("%d of %d resources have an unknown health: %s", len(unknown), len(healths), strings.Join(unknown, "; ")),
+		}
+	case len(notCurrent) > 0:
+		return &v1alpha1.ResourceHealth{
+			Status:  healthInProgress,
+			Message: fmt.Sprintf("%d of %d resources are not current: %s", len(notCurrent), len(healths), strings.Join(notCurrent, "; ")),
+		}
+	}
+	return &v1alpha1.ResourceHealth{
+		Status:  healthCurrent,
+		Message: fmt.Sprintf("All %d resources are current", len(healths)),
+	}
+}
+
+// workloadPodsHealth returns the health of a workload which is not yet
+// current after looking at its pods: a workload rolling out never becomes
+// current if its containers cannot start, so it is failed instead.
+func workloadPodsHealth(ctx context.Context, typedClient kubernetes.Interface, resource *unstructured.Unstructured, health *v1alpha1.ResourceHealth) (*v1alpha1.ResourceHealth, error) {
+	pods, err := workloadPods(ctx, typedClient, resource)
+	if errors.IsForbidden(err) {
+		// The user may be able to read the workload but not its pods, in
+		// which case the health is the one of the workload alone.
+		log.Infof("+resources unable to list the pods of %s %s/%s: %v", resource.GetKind(), resource.GetNamespace(), resource.GetName(), err)
+		return health, nil
+	} else if err != nil {
+		return nil, statuserror.FromK8sError("list", "Pods", "", err)
+	}
+	for _, pod := range pods {
+		if message := podFailureMessage(pod); message != "" {
+			return &v1alpha1.ResourceHealth{
+				Status:  healthFailed,
+				Message: fmt.Sprintf("%s. %s", health.GetMessage(), message),
+			}, nil
+		}
+	}
+	return health, nil
+}
+
+// workloadPods returns the pods selected by a workload. Resources which are
+// not workloads, or without a selector, have no pods.
+func workloadPods(ctx context.Context, typedClient kubernetes.Interface, resource *unstructured.Unstructured) ([]core.Pod, error) {
+	if !isWorkloadKind(resource.GetKind()) {
+		return nil, nil
+	}
+	selector := workloadSelector(resource)
+	if selector == nil {
+		return nil, nil
+	}
+
+	pods, err := typedClient.CoreV1().Pods(resource.GetNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// isWorkloadKind returns whether objects of a kind select pods.
+func isWorkloadKind(kind string) bool {
+	switch kind {
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		return true
+	}
+	return false
+}
+
+// workloadSelector returns the label selector of a workload, or nil if it
+// has none.
+func workloadSelector(resource *unstructured.Unstructured) labels.Selector {
+	selectorMap, found, err := unstructured.NestedMap(resource.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil || selector.Empty() {
+		return nil
+	}
+	return selector
+}
+
+// podFailureMessage returns why a pod cannot run, if any container is
+// waiting for a reason which requires an intervention.
+func podFailureMessage(pod core.Pod) string {
+	statuses := append(append([]core.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, containerStatus := range statuses {
+		if waiting := containerStatus.State.Waiting; waiting != nil && failedContainerReasons[waiting.Reason] {
+			return fmt.Sprintf("Pod %q: container %q is in %s", pod.Name, containerStatus.Name, waiting.Reason)
+		}
+	}
+	return ""
+}
+
+// eventHealth returns the health of a resource as sent by a watch event.
+func eventHealth(e ResourceEvent) *v1alpha1.ResourceHealth {
+	if e.Type == watch.Deleted {
+		return &v1alpha1.ResourceHealth{
+			Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_NOT_FOUND,
+			Message: "Resource deleted",
+		}
+	}
+	if resource, ok := e.Object.(*unstructured.Unstructured); ok {
+		return resourceHealth(resource)
+	}
+	return nil
+}
+
+// resourceHealth evaluates the health of a resource from its status, with
+// rules equivalent to the ones of kstatus for the built-in kinds and the
+// standard Ready, Reconciling and Stalled conditions for the other kinds.
+func resourceHealth(resource *unstructured.Unstructured) *v1alpha1.ResourceHealth {
+	if resource.GetDeletionTimestamp() != nil {
+		return &v1alpha1.ResourceHealth{
+			Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_TERMINATING,
+			Message: "Resource scheduled for deletion",
+		}
+	}
+
+	obj := resource.Object
+	generation, _ := nestedInt(obj, "metadata", "generation")
+	if observedGeneration, found := nestedInt(obj, "status", "observedGeneration"); found && observedGeneration < generation {
+		return &v1alpha1.ResourceHealth{
+			Status:  healthInProgress,
+			Message: fmt.Sprintf("%s generation is %d, but latest observed generation is %d", resource.GetKind(), generation, observedGeneration),
+		}
+	}
+
+	status, message := v1alpha1.ResourceHealth_HEALTH_STATUS_UNSPECIFIED, ""
+	if kindHealth, ok := builtinKindHealth[resource.GroupVersionKind().GroupKind()]; ok {
+		status, message = kindHealth(obj)
+	}
+	if status == v1alpha1.ResourceHealth_HEALTH_STATUS_UNSPECIFIED {
+		status, message = conditionsHealth(obj)
+	}
+	return &v1alpha1.ResourceHealth{
+		Status:  status,
+		Message: message,
+	}
+}
+
+func deploymentHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	progressing, available := false, false
+	for _, c := range conditions(obj) {
+		switch c.Type {
+		case "Progressing":
+			if c.Reason == "ProgressDeadlineExceeded" {
+				return healthFailed, "Progress deadline exceeded"
+			}
+			if c.Status == "True" && c.Reason == "NewReplicaSetAvailable" {
+				progressing = true
+			}
+		case "Available":
+			if c.Status == "True" {
+				available = true
+			}
+		}
+	}
+
+	specReplicas := replicasOrDefault(obj)
+	statusReplicas, _ := nestedInt(obj, "status", "replicas")
+	updatedReplicas, _ := nestedInt(obj, "status", "updatedReplicas")
+	readyReplicas, _ := nestedInt(obj, "status", "readyReplicas")
+	availableReplicas, _ := nestedInt(obj, "status", "availableReplicas")
+
+	switch {
+	case specReplicas > statusReplicas:
+		return healthInProgress, fmt.Sprintf("Replicas: %d/%d", statusReplicas, specReplicas)
+	case specReplicas > updatedReplicas:
+		return healthInProgress, fmt.Sprintf("Updated: %d/%d", updatedReplicas, specReplicas)
+	case statusReplicas > specReplicas:
+		return healthInProgress, fmt.Sprintf("Pending termination: %d", statusReplicas-specReplicas)
+	case updatedReplicas > availableReplicas:
+		return healthInProgress, fmt.Sprintf("Available: %d/%d", availableReplicas, updatedReplicas)
+	case specReplicas > readyReplicas:
+		return healthInProgress, fmt.Sprintf("Ready: %d/%d", readyReplicas, specReplicas)
+	case !progressing:
+		return healthInProgress, "ReplicaSet not Available"
+	case !available:
+		return healthInProgress, "Deployment not Available"
+	}
+	return healthCurrent, fmt.Sprintf("Deployment is available. Replicas: %d", statusReplicas)
+}
+
+func statefulSetHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	strategy, _, _ := unstructured.NestedString(obj, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return healthCurrent, "StatefulSet is using the OnDelete update strategy"
+	}
+
+	specReplicas := replicasOrDefault(obj)
+	partition, _ := nestedInt(obj, "spec", "updateStrategy", "rollingUpdate", "partition")
+	statusReplicas, _ := nestedInt(obj, "status", "replicas")
+	readyReplicas, _ := nestedInt(obj, "status", "readyReplicas")
+	currentReplicas, _ := nestedInt(obj, "status", "currentReplicas")
+	updatedReplicas, _ := nestedInt(obj, "status", "updatedReplicas")
+	currentRevision, _, _ := unstructured.NestedString(obj, "status", "currentRevision")
+	updateRevision, _, _ := unstructured.NestedString(obj, "status", "updateRevision")
+
+	switch {
+	case specReplicas > statusReplicas:
+		return healthInProgress, fmt.Sprintf("Replicas: %d/%d", statusReplicas, specReplicas)
+	case specReplicas > readyReplicas:
+		return healthInProgress, fmt.Sprintf("Ready: %d/%d", readyReplicas, specReplicas)
+	case partition > 0:
+		if expected := specReplicas - partition; updatedReplicas < expected {
+			return healthInProgress, fmt.Sprintf("Partitioned rollout in progress: updated %d/%d", updatedReplicas, expected)
+		}
+		return healthCurrent, fmt.Sprintf("Partitioned rollout complete. updated: %d", updatedReplicas)
+	case specReplicas > currentReplicas:
+		return healthInProgress, fmt.Sprintf("Current: %d/%d", currentReplicas, specReplicas)
+	case currentRevision != updateRevision:
+		return healthInProgress, "Waiting for updated revision to match current"
+	}
+	return healthCurrent, fmt.Sprintf("All replicas scheduled as expected. Replicas: %d", statusReplicas)
+}
+
+func daemonSetHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	desired, found := nestedInt(obj, "status", "desiredNumberScheduled")
+	if !found {
+		return healthInProgress, "Missing .status.desiredNumberScheduled"
+	}
+	scheduled, _ := nestedInt(obj, "status", "currentNumberScheduled")
+	updated, _ := nestedInt(obj, "status", "updatedNumberScheduled")
+	available, _ := nestedInt(obj, "status", "numberAvailable")
+	ready, _ := nestedInt(obj, "status", "numberReady")
+
+	switch {
+	case desired > scheduled:
+		return healthInProgress, fmt.Sprintf("Current: %d/%d", scheduled, desired)
+	case desired > updated:
+		return healthInProgress, fmt.Sprintf("Updated: %d/%d", updated, desired)
+	case desired > available:
+		return healthInProgress, fmt.Sprintf("Available: %d/%d", available, desired)
+	case desired > ready:
+		return healthInProgress, fmt.Sprintf("Ready: %d/%d", ready, desired)
+	}
+	return healthCurrent, fmt.Sprintf("All replicas scheduled as expected. Replicas: %d", desired)
+}
+
+func replicaSetHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	for _, c := range conditions(obj) {
+		if c.Type == "ReplicaFailure" && c.Status == "True" {
+			return healthFailed, fmt.Sprintf("Replica Failure condition. Reason: %s, Message: %s", c.Reason, c.Message)
+		}
+	}
+
+	specReplicas := replicasOrDefault(obj)
+	statusReplicas, _ := nestedInt(obj, "status", "replicas")
+	readyReplicas, _ := nestedInt(obj, "status", "readyReplicas")
+	availableReplicas, _ := nestedInt(obj, "status", "availableReplicas")
+
+	switch {
+	case specReplicas > statusReplicas:
+		return healthInProgress, fmt.Sprintf("Replicas: %d/%d", statusReplicas, specReplicas)
+	case specReplicas > readyReplicas:
+		return healthInProgress, fmt.Sprintf("Ready: %d/%d", readyReplicas, specReplicas)
+	case specReplicas > availableReplicas:
+		return healthInProgress, fmt.Sprintf("Available: %d/%d", availableReplicas, specReplicas)
+	case statusReplicas > specReplicas:
+		return healthInProgress, fmt.Sprintf("Pending termination: %d", statusReplicas-specReplicas)
+	}
+	return healthCurrent, fmt.Sprintf("ReplicaSet is available. Replicas: %d", statusReplicas)
+}
+
+func jobHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	completions, found := nestedInt(obj, "spec", "completions")
+	if !found {
+		completions = 1
+	}
+	succeeded, _ := nestedInt(obj, "status", "succeeded")
+	active, _ := nestedInt(obj, "status", "active")
+	failed, _ := nestedInt(obj, "status", "failed")
+
+	for _, c := range conditions(obj) {
+		if c.Status != "True" {
+			continue
+		}
+		switch c.Type {
+		case "Complete":
+			return healthCurrent, fmt.Sprintf("Job Completed. succeeded: %d/%d", succeeded, completions)
+		case "Failed":
+			return healthFailed, fmt.Sprintf("Job Failed. failed: %d/%d", failed, completions)
+		}
+	}
+	if _, found, _ := unstructured.NestedString(obj, "status", "startTime"); !found {
+		return healthInProgress, "Job not started"
+	}
+	return healthInProgress, fmt.Sprintf("Job in progress. success:%d, active: %d, failed: %d", succeeded, active, failed)
+}
+
+func podHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	pod := core.Pod{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &pod); err != nil {
+		return v1alpha1.ResourceHealth_HEALTH_STATUS_UNSPECIFIED, fmt.Sprintf("Unable to read the pod status: %v", err)
+	}
+
+	switch pod.Status.Phase {
+	case core.PodSucceeded:
+		return healthCurrent, "Pod has completed successfully"
+	case core.PodFailed:
+		return healthFailed, "Pod has completed, but not successfully"
+	}
+	if message := podFailureMessage(pod); message != "" {
+		return healthFailed, message
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == core.PodReady && c.Status == core.ConditionTrue {
+			return healthCurrent, "Pod is Ready"
+		}
+		if c.Type == core.PodScheduled && c.Status == core.ConditionFalse && c.Reason == core.PodReasonUnschedulable {
+			return healthInProgress, "Pod could not be scheduled"
+		}
+	}
+	if pod.Status.Phase == core.PodRunning {
+		return healthInProgress, "Pod is running but is not Ready"
+	}
+	return healthInProgress, fmt.Sprintf("Pod is in the %s phase", pod.Status.Phase)
+}
+
+func pvcHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	phase, _, _ := unstructured.NestedString(obj, "status", "phase")
+	if phase != string(core.ClaimBound) {
+		return healthInProgress, fmt.Sprintf("PVC is not Bound. phase: %s", phase)
+	}
+	return healthCurrent, "PVC is Bound"
+}
+
+func serviceHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	serviceType, _, _ := unstructured.NestedString(obj, "spec", "type")
+	if serviceType == string(core.ServiceTypeLoadBalancer) {
+		ingress, _, _ := unstructured.NestedSlice(obj, "status", "loadBalancer", "ingress")
+		if len(ingress) == 0 {
+			return healthInProgress, "LoadBalancer ingress not assigned"
+		}
+	}
+	return healthCurrent, "Service is ready"
+}
+
+func endpointsHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	subsets, _, _ := unstructured.NestedSlice(obj, "subsets")
+	ready, notReady := 0, 0
+	for _, subset := range subsets {
+		subsetMap, ok := subset.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		addresses, _, _ := unstructured.NestedSlice(subsetMap, "addresses")
+		notReadyAddresses, _, _ := unstructured.NestedSlice(subsetMap, "notReadyAddresses")
+		ready += len(addresses)
+		notReady += len(notReadyAddresses)
+	}
+	if ready == 0 {
+		return healthInProgress, fmt.Sprintf("No ready addresses. not ready: %d", notReady)
+	}
+	return healthCurrent, fmt.Sprintf("Ready addresses: %d, not ready: %d", ready, notReady)
+}
+
+func pdbHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	currentHealthy, _ := nestedInt(obj, "status", "currentHealthy")
+	desiredHealthy, _ := nestedInt(obj, "status", "desiredHealthy")
+	if currentHealthy < desiredHealthy {
+		return healthInProgress, fmt.Sprintf("Budget not met. healthy replicas: %d/%d", currentHealthy, desiredHealthy)
+	}
+	return healthCurrent, fmt.Sprintf("Budget is met. healthy replicas: %d/%d", currentHealthy, desiredHealthy)
+}
+
+// conditionsHealth evaluates the health of other kinds of resources from
+// their Stalled, Reconciling and Ready conditions, as set by most
+// controllers. A resource without any of these conditions is current.
+func conditionsHealth(obj map[string]interface{}) (v1alpha1.ResourceHealth_HealthStatus, string) {
+	var ready *condition
+	for _, c := range conditions(obj) {
+		c := c
+		switch {
+		case c.Type == "Stalled" && c.Status == "True":
+			return healthFailed, c.Message
+		case c.Type == "Reconciling" && c.Status == "True":
+			return healthInProgress, c.Message
+		case c.Type == "Ready":
+			ready = &c
+		}
+	}
+	if ready == nil {
+		return healthCurrent, "Resource is current"
+	}
+	if ready.Status != "True" {
+		return healthInProgress, ready.Message
+	}
+	return healthCurrent, ready.Message
+}
+
+// condition is the subset of the fields of status conditions used to
+// evaluate the health of resources.
+type condition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+func conditions(obj map[string]interface{}) []condition {
+	items, _, _ := unstructured.NestedSlice(obj, "status", "conditions")
+	result := []condition{}
+	for _, item := range items {
+		c, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fields := map[string]string{}
+		for _, key := range []string{"type", "status", "reason", "message"} {
+			fields[key], _ = c[key].(string)
+		}
+		result = append(result, condition{
+			Type:    fields["type"],
+			Status:  fields["status"],
+			Reason:  fields["reason"],
+			Message: fields["message"],
+		})
+	}
+	return result
+}
+
+// nestedInt returns an integer field, whether it was decoded as an integer
+// or as a float.
+func nestedInt(obj map[string]interface{}, fields ...string) (int64, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found {
+		return 0, false
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// replicasOrDefault returns the replicas of a workload, which default to 1.
+func replicasOrDefault(obj map[string]interface{}) int64 {
+	if replicas, found := nestedInt(obj, "spec", "replicas"); found {
+		return replicas
+	}
+	return 1
+}
@@ -0,0 +1,439 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+)
+
+func TestResourceHealth(t *testing.T) {
+	testCases := []struct {
+		name           string
+		resource       string
+		expectedHealth *v1alpha1.ResourceHealth
+	}{
+		{
+			name: "a deployment with all its replicas available is current",
+			resource: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  replicas: 3
+  updatedReplicas: 3
+  readyReplicas: 3
+  availableReplicas: 3
+  conditions:
+    - type: Available
+      status: "True"
+    - type: Progressing
+      status: "True"
+      reason: NewReplicaSetAvailable
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+				Message: "Deployment is available. Replicas: 3",
+			},
+		},
+		{
+			name: "a deployment rolling out is in progress",
+			resource: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 3
+status:
+  replicas: 3
+  updatedReplicas: 3
+  readyReplicas: 1
+  availableReplicas: 3
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+				Message: "Ready: 1/3",
+			},
+		},
+		{
+			name: "a deployment exceeding its progress deadline is failed",
+			resource: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+status:
+  conditions:
+    - type: Progressing
+      status: "False"
+      reason: ProgressDeadlineExceeded
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED,
+				Message: "Progress deadline exceeded",
+			},
+		},
+		{
+			name: "a resource whose latest generation was not observed is in progress",
+			resource: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  generation: 3
+status:
+  observedGeneration: 2
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+				Message: "StatefulSet generation is 3, but latest observed generation is 2",
+			},
+		},
+		{
+			name: "a resource being deleted is terminating",
+			resource: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  deletionTimestamp: "2022-06-01T10:00:00Z"
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_TERMINATING,
+				Message: "Resource scheduled for deletion",
+			},
+		},
+		{
+			name: "a daemonset not available on every node is in progress",
+			resource: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+status:
+  desiredNumberScheduled: 3
+  currentNumberScheduled: 3
+  updatedNumberScheduled: 3
+  numberAvailable: 2
+  numberReady: 2
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+				Message: "Available: 2/3",
+			},
+		},
+		{
+			name: "a failed job is failed",
+			resource: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  failed: 1
+  conditions:
+    - type: Failed
+      status: "True"
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED,
+				Message: "Job Failed. failed: 1/1",
+			},
+		},
+		{
+			name: "a crash-looping pod is failed",
+			resource: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-1
+status:
+  phase: Running
+  containerStatuses:
+    - name: web
+      state:
+        waiting:
+          reason: CrashLoopBackOff
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED,
+				Message: `Pod "web-1": container "web" is in CrashLoopBackOff`,
+			},
+		},
+		{
+			name: "a pending persistent volume claim is in progress",
+			resource: `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: data
+status:
+  phase: Pending
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+				Message: "PVC is not Bound. phase: Pending",
+			},
+		},
+		{
+			name: "a load balancer service without ingress is in progress",
+			resource: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: LoadBalancer
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+				Message: "LoadBalancer ingress not assigned",
+			},
+		},
+		{
+			name: "a custom resource which is not ready is in progress",
+			resource: `
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: HelmRepository
+metadata:
+  name: bitnami
+status:
+  conditions:
+    - type: Ready
+      status: "False"
+      message: "fetching index"
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+				Message: "fetching index",
+			},
+		},
+		{
+			name: "a stalled custom resource is failed",
+			resource: `
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: HelmRepository
+metadata:
+  name: bitnami
+status:
+  conditions:
+    - type: Ready
+      status: "False"
+      message: "invalid url"
+    - type: Stalled
+      status: "True"
+      message: "invalid url"
+`,
+			expectedHealth: &v1alpha1.ResourceHealth{
+				Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED,
+				Message: "invalid url",
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(v1alpha1.ResourceHealth{})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &unstructured.Unstructured{Object: objectFromYAML(t, tc.resource)}
+			if got, want := resourceHealth(resource), tc.expectedHealth; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
+func TestGetResourcesHealth(t *testing.T) {
+	deploymentRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "web",
+		Namespace:  "default",
+	}
+	configMapRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "core/v1",
+		Kind:       "ConfigMap",
+		Name:       "config",
+		Namespace:  "default",
+	}
+	replicas := int32(1)
+	deployment := &apps.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: apps.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+		Status: apps.DeploymentStatus{
+			Replicas:        1,
+			UpdatedReplicas: 1,
+		},
+	}
+	configMap := &core.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "default",
+		},
+	}
+	crashLoopingPod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-1",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Status: core.PodStatus{
+			Phase: core.PodRunning,
+			ContainerStatuses: []core.ContainerStatus{
+				{
+					Name: "web",
+					State: core.ContainerState{
+						Waiting: &core.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		clusterObjects    []runtime.Object
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedResponse  *v1alpha1.GetResourcesHealthResponse
+	}{
+		{
+			name:              "it returns permission denied for a request without auth",
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name:              "it reports a workload with crash-looping pods as failed",
+			clusterObjects:    []runtime.Object{deployment, crashLoopingPod, configMap},
+			expectedErrorCode: codes.OK,
+			expectedResponse: &v1alpha1.GetResourcesHealthResponse{
+				Health: &v1alpha1.ResourceHealth{
+					Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED,
+					Message: `1 of 2 resources failed: Deployment web: Available: 0/1. Pod "web-1": container "web" is in CrashLoopBackOff`,
+				},
+				ResourceHealths: []*v1alpha1.ResourceRefHealth{
+					{
+						ResourceRef: deploymentRef,
+						Health: &v1alpha1.ResourceHealth{
+							Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_FAILED,
+							Message: `Available: 0/1. Pod "web-1": container "web" is in CrashLoopBackOff`,
+						},
+					},
+					{
+						ResourceRef: configMapRef,
+						Health: &v1alpha1.ResourceHealth{
+							Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+							Message: "Resource is current",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:              "it reports missing resources as not current",
+			clusterObjects:    []runtime.Object{deployment},
+			expectedErrorCode: codes.OK,
+			expectedResponse: &v1alpha1.GetResourcesHealthResponse{
+				Health: &v1alpha1.ResourceHealth{
+					Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+					Message: "2 of 2 resources are not current: Deployment web: Available: 0/1; ConfigMap config: Resource not found",
+				},
+				ResourceHealths: []*v1alpha1.ResourceRefHealth{
+					{
+						ResourceRef: deploymentRef,
+						Health: &v1alpha1.ResourceHealth{
+							Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+							Message: "Available: 0/1",
+						},
+					},
+					{
+						ResourceRef: configMapRef,
+						Health: &v1alpha1.ResourceHealth{
+							Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_NOT_FOUND,
+							Message: "Resource not found",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetResourcesHealthResponse{},
+		v1alpha1.ResourceRefHealth{},
+		v1alpha1.ResourceHealth{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refs := []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef, configMapRef}
+			client, _, cleanup := getResourcesClientWithRefs(t, refs, "", tc.clusterObjects...)
+			defer cleanup()
+
+			ctx := context.Background()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			response, err := client.GetResourcesHealth(ctx, &v1alpha1.GetResourcesHealthRequest{
+				InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+					Context: &pkgsGRPCv1alpha1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "some-package",
+					Plugin:     fakePkgsPlugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	log "k8s.io/klog/v2"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+)
+
+// maxLogLineSize is the size of the longest log line streamed, longer lines
+// failing the stream rather than being split.
+const maxLogLineSize = 1024 * 1024
+
+// containerLogs identifies the logs of a single container of a pod.
+type containerLogs struct {
+	pod       core.Pod
+	container string
+}
+
+// GetPodLogs streams the logs of the containers of the pods owned by the
+// resources of an installed package.
+func (s *Server) GetPodLogs(r *v1alpha1.GetPodLogsRequest, stream v1alpha1.ResourcesService_GetPodLogsServer) error {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources GetPodLogs (cluster: %q, namespace=%q)", cluster, namespace)
+
+	if r.GetTailLines() < 0 {
+		return status.Errorf(codes.InvalidArgument, "tail lines must not be negative, got %d", r.GetTailLines())
+	}
+
+	outgoingCtx, err := copyAuthorizationMetadataForOutgoing(stream.Context())
+	if err != nil {
+		return err
+	}
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(outgoingCtx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: r.GetInstalledPackageRef(),
+	})
+	if err != nil {
+		return err
+	}
+
+	typedClient, dynamicClient, err := s.clientGetter(stream.Context(), cluster)
+	if err != nil {
+		return err
+	}
+	pods, err := s.packagePods(stream.Context(), typedClient, dynamicClient, refsResponse.GetResourceRefs())
+	if err != nil {
+		return err
+	}
+
+	if r.GetPodName() != "" {
+		var requestedPods []core.Pod
+		for _, pod := range pods {
+			if pod.Name == r.GetPodName() {
+				requestedPods = append(requestedPods, pod)
+			}
+		}
+		if len(requestedPods) == 0 {
+			return status.Errorf(codes.InvalidArgument, "requested pod %q does not belong to installed package %+v", r.GetPodName(), r.GetInstalledPackageRef())
+		}
+		pods = requestedPods
+	}
+
+	var logs []containerLogs
+	for _, pod := range pods {
+		for _, container := range append(append([]core.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...) {
+			if r.GetContainer() != "" && container.Name != r.GetContainer() {
+				continue
+			}
+			if !containerStarted(pod, container.Name) {
+				continue
+			}
+			logs = append(logs, containerLogs{pod: pod, container: container.Name})
+		}
+	}
+	if len(logs) == 0 && r.GetContainer() != "" {
+		return status.Errorf(codes.InvalidArgument, "requested container %q was not found in the started pods of installed package %+v", r.GetContainer(), r.GetInstalledPackageRef())
+	}
+
+	options := core.PodLogOptions{
+		Follow: r.GetFollow(),
+	}
+	if r.GetSinceTime() != nil {
+		sinceTime := metav1.NewTime(r.GetSinceTime().AsTime())
+		options.SinceTime = &sinceTime
+	}
+	if r.GetTailLines() > 0 {
+		tailLines := r.GetTailLines()
+		options.TailLines = &tailLines
+	}
+
+	// Without following, the logs of each container are sent one after the
+	// other. When following, the logs of all the containers are streamed
+	// concurrently, as none of them ends.
+	if !r.GetFollow() {
+		for _, l := range logs {
+			if err := streamContainerLogs(stream.Context(), typedClient, l, options, stream.Send); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// A grpc stream does not support sending messages concurrently.
+	var sendMutex sync.Mutex
+	send := func(response *v1alpha1.GetPodLogsResponse) error {
+		sendMutex.Lock()
+		defer sendMutex.Unlock()
+		return stream.Send(response)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(logs))
+	for _, l := range logs {
+		wg.Add(1)
+		go func(l containerLogs) {
+			defer wg.Done()
+			if err := streamContainerLogs(ctx, typedClient, l, options, send); err != nil {
+				errs <- err
+				cancel()
+			}
+		}(l)
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// packagePods returns the pods of the resources of an installed package,
+// either referenced directly or selected by the workloads referenced. Only
+// the pods and workloads are fetched, and those which the user is not
+// allowed to read are skipped, as for their health.
+func (s *Server) packagePods(ctx context.Context, typedClient kubernetes.Interface, dynamicClient dynamic.Interface, refs []*pkgsGRPCv1alpha1.ResourceRef) ([]core.Pod, error) {
+	var pods []core.Pod
+	seen := map[string]bool{}
+	addPod := func(pod core.Pod) {
+		key := pod.Namespace + "/" + pod.Name
+		if !seen[key] {
+			seen[key] = true
+			pods = append(pods, pod)
+		}
+	}
+
+	for _, ref := range refs {
+		if ref.GetKind() == "Pod" {
+			pod, err := typedClient.CoreV1().Pods(ref.GetNamespace()).Get(ctx, ref.GetName(), metav1.GetOptions{})
+			if errors.IsNotFound(err) {
+				continue
+			} else if errors.IsForbidden(err) {
+				log.Infof("+resources unable to get the Pod %s/%s: %v", ref.GetNamespace(), ref.GetName(), err)
+				continue
+			} else if err != nil {
+				return nil, statuserror.FromK8sError("get", "Pod", ref.GetName(), err)
+			}
+			addPod(*pod)
+			continue
+		}
+		if !isWorkloadKind(ref.GetKind()) {
+			continue
+		}
+
+		resource, err := s.getResource(ctx, dynamicClient, ref)
+		if errors.IsNotFound(err) {
+			continue
+		} else if status.Code(err) == codes.PermissionDenied {
+			log.Infof("+resources unable to get the %s %s/%s: %v", ref.GetKind(), ref.GetNamespace(), ref.GetName(), err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		selectedPods, err := workloadPods(ctx, typedClient, resource)
+		if errors.IsForbidden(err) {
+			log.Infof("+resources unable to list the pods of %s %s/%s: %v", ref.GetKind(), ref.GetNamespace(), ref.GetName(), err)
+			continue
+		} else if err != nil {
+			return nil, statuserror.FromK8sError("list", "Pods", "", err)
+		}
+		for _, pod := range selectedPods {
+			addPod(pod)
+		}
+	}
+	return pods, nil
+}
+
+// containerStarted returns whether a container of a pod has started at
+// least once, the logs of containers which never started not being
+// available.
+func containerStarted(pod core.Pod, container string) bool {
+	statuses := append(append([]core.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, containerStatus := range statuses {
+		if containerStatus.Name != container {
+			continue
+		}
+		return containerStatus.State.Running != nil ||
+			containerStatus.State.Terminated != nil ||
+			containerStatus.LastTerminationState.Terminated != nil
+	}
+	return false
+}
+
+// streamContainerLogs sends the logs of a container, line by line.
+func streamContainerLogs(ctx context.Context, typedClient kubernetes.Interface, l containerLogs, options core.PodLogOptions, send func(*v1alpha1.GetPodLogsResponse) error) error {
+	options.Container = l.container
+	logStream, err := typedClient.CoreV1().Pods(l.pod.Namespace).GetLogs(l.pod.Name, &options).Stream(ctx)
+	if err != nil {
+		return statuserror.FromK8sError("get", "logs of Pod", l.pod.Name, err)
+	}
+	defer logStream.Close()
+
+	scanner := bufio.NewScanner(logStream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		err := send(&v1alpha1.GetPodLogsResponse{
+			PodName:   l.pod.Name,
+			Namespace: l.pod.Namespace,
+			Container: l.container,
+			Line:      scanner.Text(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// The stream is closed when the request is cancelled, which is not an
+	// error.
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return status.Errorf(codes.Internal, "unable to read the logs of container %q of pod %q: %v", l.container, l.pod.Name, err)
+	}
+	return nil
+}
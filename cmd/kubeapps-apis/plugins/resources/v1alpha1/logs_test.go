@@ -0,0 +1,390 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientGoTesting "k8s.io/client-go/testing"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+)
+
+func TestGetPodLogs(t *testing.T) {
+	deployment := &apps.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: apps.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+	}
+	runningPod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-1",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: core.PodSpec{
+			Containers: []core.Container{{Name: "web"}, {Name: "sidecar"}},
+		},
+		Status: core.PodStatus{
+			ContainerStatuses: []core.ContainerStatus{
+				{
+					Name:  "web",
+					State: core.ContainerState{Running: &core.ContainerStateRunning{}},
+				},
+				{
+					Name:  "sidecar",
+					State: core.ContainerState{Running: &core.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+	pendingPod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-2",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: core.PodSpec{
+			Containers: []core.Container{{Name: "web"}},
+		},
+		Status: core.PodStatus{
+			ContainerStatuses: []core.ContainerStatus{
+				{
+					Name:  "web",
+					State: core.ContainerState{Waiting: &core.ContainerStateWaiting{Reason: "ContainerCreating"}},
+				},
+			},
+		},
+	}
+	otherPod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other",
+			Namespace: "default",
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		request           *v1alpha1.GetPodLogsRequest
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedResponses []*v1alpha1.GetPodLogsResponse
+	}{
+		{
+			name:              "it returns permission denied for a request without auth",
+			request:           &v1alpha1.GetPodLogsRequest{},
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name: "it returns the logs of all the started containers of the package pods",
+			request: &v1alpha1.GetPodLogsRequest{
+				TailLines: 10,
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponses: []*v1alpha1.GetPodLogsResponse{
+				{
+					PodName:   "web-1",
+					Namespace: "default",
+					Container: "web",
+					Line:      "fake logs",
+				},
+				{
+					PodName:   "web-1",
+					Namespace: "default",
+					Container: "sidecar",
+					Line:      "fake logs",
+				},
+			},
+		},
+		{
+			name: "it returns the logs of the requested container",
+			request: &v1alpha1.GetPodLogsRequest{
+				PodName:   "web-1",
+				Container: "sidecar",
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponses: []*v1alpha1.GetPodLogsResponse{
+				{
+					PodName:   "web-1",
+					Namespace: "default",
+					Container: "sidecar",
+					Line:      "fake logs",
+				},
+			},
+		},
+		{
+			name: "it follows the logs of all the containers concurrently",
+			request: &v1alpha1.GetPodLogsRequest{
+				Follow: true,
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponses: []*v1alpha1.GetPodLogsResponse{
+				{
+					PodName:   "web-1",
+					Namespace: "default",
+					Container: "sidecar",
+					Line:      "fake logs",
+				},
+				{
+					PodName:   "web-1",
+					Namespace: "default",
+					Container: "web",
+					Line:      "fake logs",
+				},
+			},
+		},
+		{
+			name: "it returns an invalid argument error for a pod of another package",
+			request: &v1alpha1.GetPodLogsRequest{
+				PodName: "other",
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "it returns an invalid argument error for an unknown container",
+			request: &v1alpha1.GetPodLogsRequest{
+				Container: "unknown",
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "it returns an invalid argument error for negative tail lines",
+			request: &v1alpha1.GetPodLogsRequest{
+				TailLines: -1,
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(v1alpha1.GetPodLogsResponse{})
+	sortResponses := cmpopts.SortSlices(func(a, b *v1alpha1.GetPodLogsResponse) bool {
+		return a.Container < b.Container
+	})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refs := resourceRefsForObjects(t, deployment)
+			clusterObjects := []runtime.Object{deployment, runningPod, pendingPod, otherPod}
+			client, _, cleanup := getResourcesClientWithRefs(t, refs, "", clusterObjects...)
+			defer cleanup()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			tc.request.InstalledPackageRef = &pkgsGRPCv1alpha1.InstalledPackageReference{
+				Context: &pkgsGRPCv1alpha1.Context{
+					Cluster:   "default",
+					Namespace: "default",
+				},
+				Identifier: "some-package",
+				Plugin:     fakePkgsPlugin,
+			}
+			responseStream, err := client.GetPodLogs(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			var responses []*v1alpha1.GetPodLogsResponse
+			for {
+				response, err := responseStream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+					t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				}
+				if err != nil {
+					return
+				}
+				responses = append(responses, response)
+			}
+
+			opts := []cmp.Option{ignoredUnexported}
+			if tc.request.GetFollow() {
+				opts = append(opts, sortResponses)
+			}
+			if got, want := responses, tc.expectedResponses; !cmp.Equal(want, got, opts...) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts...))
+			}
+		})
+	}
+}
+
+func TestGetPodLogsSkipsOtherKindsAndForbiddenResources(t *testing.T) {
+	deployment := &apps.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: apps.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+	}
+	statefulSet := &apps.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "default",
+		},
+		Spec: apps.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "db"},
+			},
+		},
+	}
+	configMap := &core.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-config",
+			Namespace: "default",
+		},
+	}
+	privatePod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "private",
+			Namespace: "default",
+		},
+	}
+	runningPod := &core.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "core/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-1",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: core.PodSpec{
+			Containers: []core.Container{{Name: "web"}},
+		},
+		Status: core.PodStatus{
+			ContainerStatuses: []core.ContainerStatus{
+				{
+					Name:  "web",
+					State: core.ContainerState{Running: &core.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+
+	refs := resourceRefsForObjects(t, deployment, statefulSet, configMap, privatePod)
+	clusterObjects := []runtime.Object{deployment, statefulSet, configMap, privatePod, runningPod}
+	client, dynamicClient, typedClient, cleanup := getResourcesClientWithFakeClients(t, refs, "", clusterObjects...)
+	defer cleanup()
+	forbidden := func(resource, name string) error {
+		return k8serrors.NewForbidden(schema.GroupResource{Resource: resource}, name, nil)
+	}
+	dynamicClient.PrependReactor("get", "statefulsets", func(action clientGoTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, forbidden("statefulsets", "db")
+	})
+	typedClient.PrependReactor("get", "pods", func(action clientGoTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, forbidden("pods", "private")
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+	responseStream, err := client.GetPodLogs(ctx, &v1alpha1.GetPodLogsRequest{
+		InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+			Context: &pkgsGRPCv1alpha1.Context{
+				Cluster:   "default",
+				Namespace: "default",
+			},
+			Identifier: "some-package",
+			Plugin:     fakePkgsPlugin,
+		},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	var responses []*v1alpha1.GetPodLogsResponse
+	for {
+		response, err := responseStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		responses = append(responses, response)
+	}
+
+	expectedResponses := []*v1alpha1.GetPodLogsResponse{
+		{
+			PodName:   "web-1",
+			Namespace: "default",
+			Container: "web",
+			Line:      "fake logs",
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(v1alpha1.GetPodLogsResponse{})
+	if got, want := responses, expectedResponses; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	for _, action := range dynamicClient.Actions() {
+		if action.GetResource().Resource == "configmaps" {
+			t.Errorf("unexpected %s of the configmaps", action.GetVerb())
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/watch"
@@ -200,7 +201,7 @@ func (s *Server) GetResources(r *v1alpha1.GetResourcesRequest, stream v1alpha1.R
 		}
 
 		if !r.GetWatch() {
-			var resource interface{}
+			var resource *unstructured.Unstructured
 			if scopeName == meta.RESTScopeNameNamespace {
 				resource, err = dynamicClient.Resource(gvr).Namespace(ref.Namespace).Get(stream.Context(), ref.GetName(), metav1.GetOptions{})
 			} else {
@@ -209,7 +210,7 @@ func (s *Server) GetResources(r *v1alpha1.GetResourcesRequest, stream v1alpha1.R
 			if err != nil {
 				return status.Errorf(codes.Internal, "unable to get resource referenced by %+v: %s", ref, err.Error())
 			}
-			err = sendResourceData(ref, resource, stream)
+			err = sendResourceData(ref, resource, resourceHealth(resource), stream)
 			if err != nil {
 				return err
 			}
@@ -245,7 +246,7 @@ func (s *Server) GetResources(r *v1alpha1.GetResourcesRequest, stream v1alpha1.R
 	// data as it arrives.
 	resourceWatcher := mergeWatchers(watchers)
 	for e := range resourceWatcher.ResultChan() {
-		sendResourceData(e.ResourceRef, e.Object, stream)
+		sendResourceData(e.ResourceRef, e.Object, eventHealth(e), stream)
 	}
 
 	return nil
@@ -281,7 +282,7 @@ func (s *Server) GetServiceAccountNames(ctx context.Context, r *v1alpha1.GetServ
 
 // sendResourceData just DRYs up this functionality shared between requests to
 // watch or get resources.
-func sendResourceData(ref *pkgsGRPCv1alpha1.ResourceRef, obj interface{}, s v1alpha1.ResourcesService_GetResourcesServer) error {
+func sendResourceData(ref *pkgsGRPCv1alpha1.ResourceRef, obj interface{}, health *v1alpha1.ResourceHealth, s v1alpha1.ResourcesService_GetResourcesServer) error {
 	resourceBytes, err := json.Marshal(obj)
 	if err != nil {
 		return status.Errorf(codes.Internal, "unable to marshal json for resource: %s", err.Error())
@@ -292,6 +293,7 @@ func sendResourceData(ref *pkgsGRPCv1alpha1.ResourceRef, obj interface{}, s v1al
 	s.Send(&v1alpha1.GetResourcesResponse{
 		ResourceRef: ref,
 		Manifest:    string(resourceBytes),
+		Health:      health,
 	})
 
 	return nil
@@ -65,6 +65,20 @@ func resourceRefsForObjects(t *testing.T, objects ...runtime.Object) []*pkgsGRPC
 // slow network port etc.). More at
 // https://stackoverflow.com/a/52080545
 func getResourcesClient(t *testing.T, objects ...runtime.Object) (v1alpha1.ResourcesServiceClient, *dynfake.FakeDynamicClient, func()) {
+	return getResourcesClientWithRefs(t, resourceRefsForObjects(t, objects...), "", objects...)
+}
+
+// getResourcesClientWithRefs is like getResourcesClient, but the test core
+// packages service returns the given resource refs and manifest.
+func getResourcesClientWithRefs(t *testing.T, refs []*pkgsGRPCv1alpha1.ResourceRef, manifest string, objects ...runtime.Object) (v1alpha1.ResourcesServiceClient, *dynfake.FakeDynamicClient, func()) {
+	client, fakeDynamicClient, _, cleanup := getResourcesClientWithFakeClients(t, refs, manifest, objects...)
+	return client, fakeDynamicClient, cleanup
+}
+
+// getResourcesClientWithFakeClients is like getResourcesClientWithRefs, but
+// also returns the fake typed client, such as to update the objects watched
+// by the server.
+func getResourcesClientWithFakeClients(t *testing.T, refs []*pkgsGRPCv1alpha1.ResourceRef, manifest string, objects ...runtime.Object) (v1alpha1.ResourcesServiceClient, *dynfake.FakeDynamicClient, *typfake.Clientset, func()) {
 	lis := bufconn.Listen(bufSize)
 	s := grpc.NewServer()
 	bufDialer := func(context.Context, string) (net.Conn, error) {
@@ -76,10 +90,11 @@ func getResourcesClient(t *testing.T, objects ...runtime.Object) (v1alpha1.Resou
 		t.Fatalf("Failed to dial bufnet: %v", err)
 	}
 
-	// Create and register a fake packages plugin returning resourcerefs for objects.
+	// Create and register a fake packages plugin returning the resourcerefs.
 	fakePkgsPluginServer := &plugin_test.TestPackagingPluginServer{
 		Plugin:       fakePkgsPlugin,
-		ResourceRefs: resourceRefsForObjects(t, objects...),
+		ResourceRefs: refs,
+		Manifest:     manifest,
 	}
 	pkgsGRPCv1alpha1.RegisterPackagesServiceServer(s, fakePkgsPluginServer)
 
@@ -89,12 +104,13 @@ func getResourcesClient(t *testing.T, objects ...runtime.Object) (v1alpha1.Resou
 		scheme,
 		objects...,
 	)
+	fakeTypedClient := typfake.NewSimpleClientset(objects...)
 	// Create the resources service server.
 	v1alpha1.RegisterResourcesServiceServer(s, &Server{
-		// Use a client getter that returns a dynamic client prepped with the
+		// Use a client getter that returns clients prepped with the
 		// specified objects.
 		clientGetter: func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error) {
-			return nil, fakeDynamicClient, nil
+			return fakeTypedClient, fakeDynamicClient, nil
 		},
 		// Use a corePackagesClientGetter that returns a client connected to our
 		// running test service.
@@ -118,7 +134,7 @@ func getResourcesClient(t *testing.T, objects ...runtime.Object) (v1alpha1.Resou
 		}
 	}()
 
-	return v1alpha1.NewResourcesServiceClient(conn), fakeDynamicClient, func() {
+	return v1alpha1.NewResourcesServiceClient(conn), fakeDynamicClient, fakeTypedClient, func() {
 		conn.Close()
 		lis.Close()
 	}
@@ -191,6 +207,10 @@ func TestGetResources(t *testing.T) {
 						Name:       "some-deployment",
 						Namespace:  "default",
 					},
+					Health: &v1alpha1.ResourceHealth{
+						Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+						Message: "Replicas: 0/1",
+					},
 				},
 				{
 					ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{
@@ -199,6 +219,10 @@ func TestGetResources(t *testing.T) {
 						Name:       "some-service",
 						Namespace:  "default",
 					},
+					Health: &v1alpha1.ResourceHealth{
+						Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+						Message: "Resource is current",
+					},
 				},
 			},
 		},
@@ -253,6 +277,10 @@ func TestGetResources(t *testing.T) {
 						Name:       "some-service",
 						Namespace:  "default",
 					},
+					Health: &v1alpha1.ResourceHealth{
+						Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+						Message: "Resource is current",
+					},
 				},
 			},
 		},
@@ -345,6 +373,10 @@ func TestGetResources(t *testing.T) {
 						Name:       "some-service",
 						Namespace:  "other-namespace",
 					},
+					Health: &v1alpha1.ResourceHealth{
+						Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+						Message: "Resource is current",
+					},
 				},
 			},
 		},
@@ -386,6 +418,10 @@ func TestGetResources(t *testing.T) {
 						Kind:       "ClusterRole",
 						Name:       "some-cluster-role",
 					},
+					Health: &v1alpha1.ResourceHealth{
+						Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+						Message: "Resource is current",
+					},
 				},
 			},
 		},
@@ -399,6 +435,7 @@ func TestGetResources(t *testing.T) {
 
 	ignoredUnexported := cmpopts.IgnoreUnexported(
 		v1alpha1.GetResourcesResponse{},
+		v1alpha1.ResourceHealth{},
 		pkgsGRPCv1alpha1.ResourceRef{},
 	)
 	ignoreManifest := cmpopts.IgnoreFields(v1alpha1.GetResourcesResponse{}, "Manifest")
@@ -0,0 +1,233 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	log "k8s.io/klog/v2"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+)
+
+var (
+	podKind                   = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	persistentVolumeClaimKind = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
+	endpointsKind             = schema.GroupVersionKind{Version: "v1", Kind: "Endpoints"}
+)
+
+// dependentKinds are the kinds of the resources controlled by each kind of
+// resource, as found through their owner references.
+var dependentKinds = map[schema.GroupKind][]schema.GroupVersionKind{
+	{Group: "apps", Kind: "DaemonSet"}:   {podKind},
+	{Group: "apps", Kind: "Deployment"}:  {{Group: "apps", Version: "v1", Kind: "ReplicaSet"}},
+	{Group: "apps", Kind: "ReplicaSet"}:  {podKind},
+	{Group: "apps", Kind: "StatefulSet"}: {podKind},
+	{Group: "batch", Kind: "CronJob"}:    {{Group: "batch", Version: "v1", Kind: "Job"}},
+	{Group: "batch", Kind: "Job"}:        {podKind},
+}
+
+// GetResourcesTopology returns the resources of an installed package as
+// trees of their dependents.
+func (s *Server) GetResourcesTopology(ctx context.Context, r *v1alpha1.GetResourcesTopologyRequest) (*v1alpha1.GetResourcesTopologyResponse, error) {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources GetResourcesTopology (cluster: %q, namespace=%q)", cluster, namespace)
+
+	outgoingCtx, err := copyAuthorizationMetadataForOutgoing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return nil, err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(outgoingCtx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: r.GetInstalledPackageRef(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, dynamicClient, err := s.clientGetter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1alpha1.GetResourcesTopologyResponse{
+		ResourceNodes: []*v1alpha1.ResourceNode{},
+	}
+	for _, ref := range refsResponse.GetResourceRefs() {
+		resource, err := s.getResource(ctx, dynamicClient, ref)
+		if errors.IsNotFound(err) {
+			response.ResourceNodes = append(response.ResourceNodes, &v1alpha1.ResourceNode{
+				ResourceRef: ref,
+				Health: &v1alpha1.ResourceHealth{
+					Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_NOT_FOUND,
+					Message: "Resource not found",
+				},
+				Children: []*v1alpha1.ResourceNode{},
+			})
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		node, err := s.resourceNode(ctx, dynamicClient, ref, resource)
+		if err != nil {
+			return nil, err
+		}
+		response.ResourceNodes = append(response.ResourceNodes, node)
+	}
+	return response, nil
+}
+
+// resourceNode returns the node of a resource, with its dependents as
+// children.
+func (s *Server) resourceNode(ctx context.Context, dynamicClient dynamic.Interface, ref *pkgsGRPCv1alpha1.ResourceRef, resource *unstructured.Unstructured) (*v1alpha1.ResourceNode, error) {
+	var dependents []*unstructured.Unstructured
+	groupKind := resource.GroupVersionKind().GroupKind()
+	for _, gvk := range dependentKinds[groupKind] {
+		controlled, err := s.controlledResources(ctx, dynamicClient, resource, gvk)
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, controlled...)
+	}
+
+	switch groupKind {
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		claims, err := s.statefulSetClaims(ctx, dynamicClient, resource)
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, claims...)
+	case schema.GroupKind{Kind: "Service"}:
+		endpoints, err := s.getResource(ctx, dynamicClient, resourceRefForObject(resource.GetName(), resource.GetNamespace(), endpointsKind))
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			endpoints.SetGroupVersionKind(endpointsKind)
+			dependents = append(dependents, endpoints)
+		}
+	}
+
+	node := &v1alpha1.ResourceNode{
+		ResourceRef: ref,
+		Health:      resourceHealth(resource),
+		Children:    []*v1alpha1.ResourceNode{},
+	}
+	for _, dependent := range dependents {
+		child, err := s.resourceNode(ctx, dynamicClient, resourceRefForObject(dependent.GetName(), dependent.GetNamespace(), dependent.GroupVersionKind()), dependent)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node, nil
+}
+
+// controlledResources returns the resources of the given kind controlled by
+// a resource, ordered by name.
+func (s *Server) controlledResources(ctx context.Context, dynamicClient dynamic.Interface, owner *unstructured.Unstructured, gvk schema.GroupVersionKind) ([]*unstructured.Unstructured, error) {
+	listOptions := metav1.ListOptions{}
+	if selector := workloadSelector(owner); selector != nil {
+		listOptions.LabelSelector = selector.String()
+	}
+	list, err := s.listResources(ctx, dynamicClient, owner.GetNamespace(), gvk, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var controlled []*unstructured.Unstructured
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], owner) {
+			controlled = append(controlled, &list.Items[i])
+		}
+	}
+	return controlled, nil
+}
+
+// statefulSetClaims returns the PersistentVolumeClaims created from the
+// volume claim templates of a StatefulSet, which are named
+// <template>-<statefulset>-<ordinal> and are not always owned by it.
+func (s *Server) statefulSetClaims(ctx context.Context, dynamicClient dynamic.Interface, statefulSet *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	templates, _, _ := unstructured.NestedSlice(statefulSet.Object, "spec", "volumeClaimTemplates")
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	var prefixes []string
+	for _, template := range templates {
+		templateMap, ok := template.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(templateMap, "metadata", "name"); name != "" {
+			prefixes = append(prefixes, name+"-"+statefulSet.GetName()+"-")
+		}
+	}
+
+	list, err := s.listResources(ctx, dynamicClient, statefulSet.GetNamespace(), persistentVolumeClaimKind, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var claims []*unstructured.Unstructured
+	for i := range list.Items {
+		name := list.Items[i].GetName()
+		for _, prefix := range prefixes {
+			if _, err := strconv.Atoi(strings.TrimPrefix(name, prefix)); strings.HasPrefix(name, prefix) && err == nil {
+				claims = append(claims, &list.Items[i])
+				break
+			}
+		}
+	}
+	return claims, nil
+}
+
+// listResources lists the resources of a kind in a namespace, ordered by
+// name and with their kind set.
+func (s *Server) listResources(ctx context.Context, dynamicClient dynamic.Interface, namespace string, gvk schema.GroupVersionKind, listOptions metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	gvr, scopeName, err := s.kindToResource(s.restMapper, gvk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to map group-kind %v to resource: %s", gvk.GroupKind(), err.Error())
+	}
+	var list *unstructured.UnstructuredList
+	if scopeName == meta.RESTScopeNameNamespace {
+		list, err = dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, listOptions)
+	} else {
+		list, err = dynamicClient.Resource(gvr).List(ctx, listOptions)
+	}
+	if err != nil {
+		return nil, statuserror.FromK8sError("list", gvk.Kind, "", err)
+	}
+	for i := range list.Items {
+		list.Items[i].SetGroupVersionKind(gvk)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+	return list, nil
+}
+
+func resourceRefForObject(name, namespace string, gvk schema.GroupVersionKind) *pkgsGRPCv1alpha1.ResourceRef {
+	return &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       name,
+		Namespace:  namespace,
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+)
+
+func TestGetResourcesTopology(t *testing.T) {
+	clusterObjects := []string{`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  uid: deployment-uid
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: web
+status:
+  replicas: 1
+  updatedReplicas: 1
+  readyReplicas: 1
+  availableReplicas: 1
+  conditions:
+    - type: Available
+      status: "True"
+    - type: Progressing
+      status: "True"
+      reason: NewReplicaSetAvailable
+`, `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-abc
+  namespace: default
+  uid: replicaset-uid
+  labels:
+    app: web
+  ownerReferences:
+    - apiVersion: apps/v1
+      kind: Deployment
+      name: web
+      uid: deployment-uid
+      controller: true
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: web
+status:
+  replicas: 1
+  readyReplicas: 1
+  availableReplicas: 1
+`, `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-other
+  namespace: default
+  labels:
+    app: web
+  ownerReferences:
+    - apiVersion: apps/v1
+      kind: Deployment
+      name: other
+      uid: other-uid
+      controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-abc-1
+  namespace: default
+  labels:
+    app: web
+  ownerReferences:
+    - apiVersion: apps/v1
+      kind: ReplicaSet
+      name: web-abc
+      uid: replicaset-uid
+      controller: true
+status:
+  phase: Running
+  conditions:
+    - type: Ready
+      status: "True"
+`, `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  namespace: default
+  uid: statefulset-uid
+spec:
+  selector:
+    matchLabels:
+      app: db
+  updateStrategy:
+    type: OnDelete
+  volumeClaimTemplates:
+    - metadata:
+        name: data
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: db-0
+  namespace: default
+  labels:
+    app: db
+  ownerReferences:
+    - apiVersion: apps/v1
+      kind: StatefulSet
+      name: db
+      uid: statefulset-uid
+      controller: true
+status:
+  phase: Pending
+`, `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: data-db-0
+  namespace: default
+status:
+  phase: Bound
+`, `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: data-dbx-0
+  namespace: default
+status:
+  phase: Bound
+`, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: default
+`, `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: web
+  namespace: default
+subsets:
+  - addresses:
+      - ip: 10.0.0.1
+`}
+
+	refs := []*pkgsGRPCv1alpha1.ResourceRef{
+		{ApiVersion: "apps/v1", Kind: "Deployment", Name: "web", Namespace: "default"},
+		{ApiVersion: "apps/v1", Kind: "StatefulSet", Name: "db", Namespace: "default"},
+		{ApiVersion: "v1", Kind: "Service", Name: "web", Namespace: "default"},
+		{ApiVersion: "v1", Kind: "ConfigMap", Name: "config", Namespace: "default"},
+	}
+
+	current := func(message string) *v1alpha1.ResourceHealth {
+		return &v1alpha1.ResourceHealth{
+			Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+			Message: message,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedResponse  *v1alpha1.GetResourcesTopologyResponse
+	}{
+		{
+			name:              "it returns permission denied for a request without auth",
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name:              "it returns the dependents of the resources",
+			expectedErrorCode: codes.OK,
+			expectedResponse: &v1alpha1.GetResourcesTopologyResponse{
+				ResourceNodes: []*v1alpha1.ResourceNode{
+					{
+						ResourceRef: refs[0],
+						Health:      current("Deployment is available. Replicas: 1"),
+						Children: []*v1alpha1.ResourceNode{
+							{
+								ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{ApiVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", Namespace: "default"},
+								Health:      current("ReplicaSet is available. Replicas: 1"),
+								Children: []*v1alpha1.ResourceNode{
+									{
+										ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{ApiVersion: "v1", Kind: "Pod", Name: "web-abc-1", Namespace: "default"},
+										Health:      current("Pod is Ready"),
+										Children:    []*v1alpha1.ResourceNode{},
+									},
+								},
+							},
+						},
+					},
+					{
+						ResourceRef: refs[1],
+						Health:      current("StatefulSet is using the OnDelete update strategy"),
+						Children: []*v1alpha1.ResourceNode{
+							{
+								ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{ApiVersion: "v1", Kind: "Pod", Name: "db-0", Namespace: "default"},
+								Health: &v1alpha1.ResourceHealth{
+									Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+									Message: "Pod is in the Pending phase",
+								},
+								Children: []*v1alpha1.ResourceNode{},
+							},
+							{
+								ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{ApiVersion: "v1", Kind: "PersistentVolumeClaim", Name: "data-db-0", Namespace: "default"},
+								Health:      current("PVC is Bound"),
+								Children:    []*v1alpha1.ResourceNode{},
+							},
+						},
+					},
+					{
+						ResourceRef: refs[2],
+						Health:      current("Service is ready"),
+						Children: []*v1alpha1.ResourceNode{
+							{
+								ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{ApiVersion: "v1", Kind: "Endpoints", Name: "web", Namespace: "default"},
+								Health:      current("Ready addresses: 1, not ready: 0"),
+								Children:    []*v1alpha1.ResourceNode{},
+							},
+						},
+					},
+					{
+						ResourceRef: refs[3],
+						Health: &v1alpha1.ResourceHealth{
+							Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_NOT_FOUND,
+							Message: "Resource not found",
+						},
+						Children: []*v1alpha1.ResourceNode{},
+					},
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetResourcesTopologyResponse{},
+		v1alpha1.ResourceNode{},
+		v1alpha1.ResourceHealth{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+	)
+	// Empty lists of children are received as nil.
+	equateEmpty := cmpopts.EquateEmpty()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := []runtime.Object{}
+			for _, o := range clusterObjects {
+				objects = append(objects, &unstructured.Unstructured{Object: objectFromYAML(t, o)})
+			}
+			client, _, cleanup := getResourcesClientWithRefs(t, refs, "", objects...)
+			defer cleanup()
+
+			ctx := context.Background()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			response, err := client.GetResourcesTopology(ctx, &v1alpha1.GetResourcesTopologyRequest{
+				InstalledPackageRef: &pkgsGRPCv1alpha1.InstalledPackageReference{
+					Context: &pkgsGRPCv1alpha1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "some-package",
+					Plugin:     fakePkgsPlugin,
+				},
+			})
+
+			if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoredUnexported, equateEmpty) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, equateEmpty))
+			}
+		})
+	}
+}
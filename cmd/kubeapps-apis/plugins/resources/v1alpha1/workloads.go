@@ -0,0 +1,195 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog/v2"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+)
+
+// restartedAtAnnotation is the pod template annotation set to trigger a
+// rollout restart, the same as the one set by "kubectl rollout restart".
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+var (
+	scalableKinds = map[schema.GroupKind]bool{
+		{Group: "apps", Kind: "Deployment"}:  true,
+		{Group: "apps", Kind: "StatefulSet"}: true,
+	}
+	restartableKinds = map[schema.GroupKind]bool{
+		{Group: "apps", Kind: "DaemonSet"}:   true,
+		{Group: "apps", Kind: "Deployment"}:  true,
+		{Group: "apps", Kind: "StatefulSet"}: true,
+	}
+)
+
+// ScaleResource sets the replicas of a Deployment or StatefulSet of an
+// installed package, using the scale subresource.
+func (s *Server) ScaleResource(ctx context.Context, r *v1alpha1.ScaleResourceRequest) (*v1alpha1.ScaleResourceResponse, error) {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources ScaleResource (cluster: %q, namespace=%q, resource=%+v, replicas=%d)", cluster, namespace, r.GetResourceRef(), r.GetReplicas())
+
+	if r.GetReplicas() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "replicas must not be negative, got %d", r.GetReplicas())
+	}
+	if err := s.checkWorkloadRef(ctx, r.GetInstalledPackageRef(), r.GetResourceRef(), scalableKinds); err != nil {
+		return nil, err
+	}
+
+	_, dynamicClient, err := s.clientGetter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	resourceInterface, err := s.resourceInterface(dynamicClient, r.GetResourceRef())
+	if err != nil {
+		return nil, err
+	}
+	patch := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, r.GetReplicas()))
+	_, err = resourceInterface.Patch(ctx, r.GetResourceRef().GetName(), types.MergePatchType, patch, metav1.PatchOptions{}, "scale")
+	if err != nil {
+		return nil, statuserror.FromK8sError("update", r.GetResourceRef().GetKind(), r.GetResourceRef().GetName(), err)
+	}
+
+	// The scale subresource only returns the replicas, so the workload is
+	// read again for its observed state.
+	resource, err := resourceInterface.Get(ctx, r.GetResourceRef().GetName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", r.GetResourceRef().GetKind(), r.GetResourceRef().GetName(), err)
+	}
+
+	return &v1alpha1.ScaleResourceResponse{
+		State: workloadState(r.GetResourceRef(), resource),
+	}, nil
+}
+
+// RestartResource triggers a rollout restart of a Deployment, StatefulSet
+// or DaemonSet of an installed package by updating an annotation of its pod
+// template, so that its pods are replaced following its update strategy.
+func (s *Server) RestartResource(ctx context.Context, r *v1alpha1.RestartResourceRequest) (*v1alpha1.RestartResourceResponse, error) {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.Infof("+resources RestartResource (cluster: %q, namespace=%q, resource=%+v)", cluster, namespace, r.GetResourceRef())
+
+	if err := s.checkWorkloadRef(ctx, r.GetInstalledPackageRef(), r.GetResourceRef(), restartableKinds); err != nil {
+		return nil, err
+	}
+
+	_, dynamicClient, err := s.clientGetter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	resourceInterface, err := s.resourceInterface(dynamicClient, r.GetResourceRef())
+	if err != nil {
+		return nil, err
+	}
+
+	restartedAt := time.Now().UTC().Truncate(time.Second)
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						restartedAtAnnotation: restartedAt.Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to marshal the restart patch: %v", err)
+	}
+	resource, err := resourceInterface.Patch(ctx, r.GetResourceRef().GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, statuserror.FromK8sError("update", r.GetResourceRef().GetKind(), r.GetResourceRef().GetName(), err)
+	}
+
+	return &v1alpha1.RestartResourceResponse{
+		State:       workloadState(r.GetResourceRef(), resource),
+		RestartedAt: timestamppb.New(restartedAt),
+	}, nil
+}
+
+// checkWorkloadRef ensures that the referenced resource is of one of the
+// allowed kinds and belongs to the installed package, so that only the
+// workloads of the installed package can be updated.
+func (s *Server) checkWorkloadRef(ctx context.Context, installedPackageRef *pkgsGRPCv1alpha1.InstalledPackageReference, ref *pkgsGRPCv1alpha1.ResourceRef, allowedKinds map[schema.GroupKind]bool) error {
+	if ref == nil {
+		return status.Errorf(codes.InvalidArgument, "no resource ref specified in request")
+	}
+	groupVersion, err := schema.ParseGroupVersion(ref.GetApiVersion())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "unable to parse group version from %q: %s", ref.GetApiVersion(), err.Error())
+	}
+	if groupKind := groupVersion.WithKind(ref.GetKind()).GroupKind(); !allowedKinds[groupKind] {
+		return status.Errorf(codes.InvalidArgument, "the operation is not supported for resources of kind %v", groupKind)
+	}
+
+	outgoingCtx, err := copyAuthorizationMetadataForOutgoing(ctx)
+	if err != nil {
+		return err
+	}
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(outgoingCtx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: installedPackageRef,
+	})
+	if err != nil {
+		return err
+	}
+	for _, pkgRef := range refsResponse.GetResourceRefs() {
+		if resourceRefsEqual(pkgRef, ref) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "requested resource %+v does not belong to installed package %+v", ref, installedPackageRef)
+}
+
+// workloadState returns the observed state of a workload.
+func workloadState(ref *pkgsGRPCv1alpha1.ResourceRef, resource *unstructured.Unstructured) *v1alpha1.WorkloadState {
+	obj := resource.Object
+	generation, _ := nestedInt(obj, "metadata", "generation")
+	observedGeneration, _ := nestedInt(obj, "status", "observedGeneration")
+
+	var desired, replicas, ready, updated int64
+	if resource.GetKind() == "DaemonSet" {
+		desired, _ = nestedInt(obj, "status", "desiredNumberScheduled")
+		replicas, _ = nestedInt(obj, "status", "currentNumberScheduled")
+		ready, _ = nestedInt(obj, "status", "numberReady")
+		updated, _ = nestedInt(obj, "status", "updatedNumberScheduled")
+	} else {
+		desired = replicasOrDefault(obj)
+		replicas, _ = nestedInt(obj, "status", "replicas")
+		ready, _ = nestedInt(obj, "status", "readyReplicas")
+		updated, _ = nestedInt(obj, "status", "updatedReplicas")
+	}
+
+	return &v1alpha1.WorkloadState{
+		ResourceRef:        ref,
+		DesiredReplicas:    int32(desired),
+		Replicas:           int32(replicas),
+		ReadyReplicas:      int32(ready),
+		UpdatedReplicas:    int32(updated),
+		Generation:         generation,
+		ObservedGeneration: observedGeneration,
+		Health:             resourceHealth(resource),
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+)
+
+func workloadsTestObjects() []runtime.Object {
+	replicas := int32(1)
+	return []runtime.Object{
+		&apps.Deployment{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Deployment",
+				APIVersion: "apps/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: "default",
+			},
+			Spec: apps.DeploymentSpec{
+				Replicas: &replicas,
+			},
+			Status: apps.DeploymentStatus{
+				Replicas:          1,
+				UpdatedReplicas:   1,
+				ReadyReplicas:     1,
+				AvailableReplicas: 1,
+				Conditions: []apps.DeploymentCondition{
+					{Type: apps.DeploymentAvailable, Status: core.ConditionTrue},
+					{Type: apps.DeploymentProgressing, Status: core.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+				},
+			},
+		},
+		&core.Service{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Service",
+				APIVersion: "core/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: "default",
+			},
+		},
+	}
+}
+
+var workloadsTestPackageRef = &pkgsGRPCv1alpha1.InstalledPackageReference{
+	Context: &pkgsGRPCv1alpha1.Context{
+		Cluster:   "default",
+		Namespace: "default",
+	},
+	Identifier: "some-package",
+	Plugin:     fakePkgsPlugin,
+}
+
+var webDeploymentRef = &pkgsGRPCv1alpha1.ResourceRef{
+	ApiVersion: "apps/v1",
+	Kind:       "Deployment",
+	Name:       "web",
+	Namespace:  "default",
+}
+
+func TestScaleResource(t *testing.T) {
+	testCases := []struct {
+		name              string
+		request           *v1alpha1.ScaleResourceRequest
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedResponse  *v1alpha1.ScaleResourceResponse
+	}{
+		{
+			name: "it returns permission denied for a request without auth",
+			request: &v1alpha1.ScaleResourceRequest{
+				ResourceRef: webDeploymentRef,
+				Replicas:    3,
+			},
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name: "it scales a deployment of the installed package",
+			request: &v1alpha1.ScaleResourceRequest{
+				ResourceRef: webDeploymentRef,
+				Replicas:    3,
+			},
+			expectedErrorCode: codes.OK,
+			expectedResponse: &v1alpha1.ScaleResourceResponse{
+				State: &v1alpha1.WorkloadState{
+					ResourceRef:     webDeploymentRef,
+					DesiredReplicas: 3,
+					Replicas:        1,
+					ReadyReplicas:   1,
+					UpdatedReplicas: 1,
+					Health: &v1alpha1.ResourceHealth{
+						Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_IN_PROGRESS,
+						Message: "Replicas: 1/3",
+					},
+				},
+			},
+		},
+		{
+			name: "it returns an invalid argument error for a resource of another package",
+			request: &v1alpha1.ScaleResourceRequest{
+				ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{
+					ApiVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "other",
+					Namespace:  "default",
+				},
+				Replicas: 3,
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "it returns an invalid argument error for a resource which cannot be scaled",
+			request: &v1alpha1.ScaleResourceRequest{
+				ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{
+					ApiVersion: "core/v1",
+					Kind:       "Service",
+					Name:       "web",
+					Namespace:  "default",
+				},
+				Replicas: 3,
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "it returns an invalid argument error for negative replicas",
+			request: &v1alpha1.ScaleResourceRequest{
+				ResourceRef: webDeploymentRef,
+				Replicas:    -1,
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.ScaleResourceResponse{},
+		v1alpha1.WorkloadState{},
+		v1alpha1.ResourceHealth{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := workloadsTestObjects()
+			client, _, cleanup := getResourcesClient(t, objects...)
+			defer cleanup()
+
+			ctx := context.Background()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			tc.request.InstalledPackageRef = workloadsTestPackageRef
+			response, err := client.ScaleResource(ctx, tc.request)
+
+			if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
+func TestRestartResource(t *testing.T) {
+	testCases := []struct {
+		name              string
+		request           *v1alpha1.RestartResourceRequest
+		withoutAuthz      bool
+		expectedErrorCode codes.Code
+		expectedState     *v1alpha1.WorkloadState
+	}{
+		{
+			name: "it returns permission denied for a request without auth",
+			request: &v1alpha1.RestartResourceRequest{
+				ResourceRef: webDeploymentRef,
+			},
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name: "it restarts a deployment of the installed package",
+			request: &v1alpha1.RestartResourceRequest{
+				ResourceRef: webDeploymentRef,
+			},
+			expectedErrorCode: codes.OK,
+			expectedState: &v1alpha1.WorkloadState{
+				ResourceRef:     webDeploymentRef,
+				DesiredReplicas: 1,
+				Replicas:        1,
+				ReadyReplicas:   1,
+				UpdatedReplicas: 1,
+				Health: &v1alpha1.ResourceHealth{
+					Status:  v1alpha1.ResourceHealth_HEALTH_STATUS_CURRENT,
+					Message: "Deployment is available. Replicas: 1",
+				},
+			},
+		},
+		{
+			name: "it returns an invalid argument error for a resource which cannot be restarted",
+			request: &v1alpha1.RestartResourceRequest{
+				ResourceRef: &pkgsGRPCv1alpha1.ResourceRef{
+					ApiVersion: "core/v1",
+					Kind:       "Service",
+					Name:       "web",
+					Namespace:  "default",
+				},
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.WorkloadState{},
+		v1alpha1.ResourceHealth{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := workloadsTestObjects()
+			client, dynamicClient, cleanup := getResourcesClient(t, objects...)
+			defer cleanup()
+
+			ctx := context.Background()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			tc.request.InstalledPackageRef = workloadsTestPackageRef
+			response, err := client.RestartResource(ctx, tc.request)
+
+			if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+			if got, want := response.GetState(), tc.expectedState; !cmp.Equal(want, got, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+
+			deployment, err := dynamicClient.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("default").Get(context.Background(), "web", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			restartedAt, _, _ := unstructured.NestedString(deployment.Object, "spec", "template", "metadata", "annotations", restartedAtAnnotation)
+			if got, want := restartedAt, response.GetRestartedAt().AsTime().Format(time.RFC3339); got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
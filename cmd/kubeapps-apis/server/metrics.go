@@ -0,0 +1,199 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	metricsPath = "/metrics"
+
+	// pluginServicePrefix is the prefix of the gRPC services registered by
+	// plugins, such as kubeappsapis.plugins.helm.packages.v1alpha1.
+	pluginServicePrefix = "kubeappsapis.plugins."
+
+	// unknownPlugin is the plugin label of the requests referencing a plugin
+	// which is not configured.
+	unknownPlugin = "unknown"
+)
+
+var (
+	grpcRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kubeapps_apis",
+		Name:      "grpc_requests_total",
+		Help:      "Total number of gRPC requests handled, by method, plugin and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "plugin", "grpc_code"})
+
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "kubeapps_apis",
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Duration of the gRPC requests handled, by method, plugin and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "plugin", "grpc_code"})
+)
+
+func init() {
+	prometheus.MustRegister(grpcRequestsTotal, grpcRequestDuration)
+}
+
+// requestMetrics records the count and duration of the gRPC requests. The
+// plugin label is restricted to the configured plugins, so that requests
+// referencing arbitrary plugin names cannot create unbounded series.
+type requestMetrics struct {
+	mu      sync.RWMutex
+	plugins map[string]bool
+}
+
+// setConfiguredPlugins sets the plugins used as the plugin label, which are
+// only known once the plugins are registered with the gRPC server.
+func (m *requestMetrics) setConfiguredPlugins(configured []*plugins.Plugin) {
+	names := map[string]bool{}
+	for _, p := range configured {
+		names[p.GetName()] = true
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.plugins = names
+}
+
+// pluginLabel returns the plugin handling the request if it is configured,
+// "unknown" otherwise, or empty for requests aggregated across plugins.
+func (m *requestMetrics) pluginLabel(fullMethod string, req interface{}) string {
+	plugin := pluginForRequest(fullMethod, req)
+	if plugin == "" {
+		return ""
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !m.plugins[plugin] {
+		return unknownPlugin
+	}
+	return plugin
+}
+
+// UnaryInterceptor is a gRPC UnaryServerInterceptor recording the count and
+// latency of each request.
+func (m *requestMetrics) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	observeRequest(info.FullMethod, "unary", m.pluginLabel(info.FullMethod, req), err, time.Since(start))
+	return res, err
+}
+
+// StreamInterceptor is a gRPC StreamServerInterceptor recording the count and
+// duration of each stream. The plugin of a stream is taken from its first
+// received message.
+func (m *requestMetrics) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	stream := &metricsServerStream{ServerStream: ss, metrics: m, fullMethod: info.FullMethod}
+	err := handler(srv, stream)
+	observeRequest(info.FullMethod, streamType(info), stream.plugin, err, time.Since(start))
+	return err
+}
+
+// metricsServerStream records the plugin of the first message received on a
+// stream.
+type metricsServerStream struct {
+	grpc.ServerStream
+	metrics    *requestMetrics
+	fullMethod string
+	plugin     string
+	received   bool
+}
+
+func (s *metricsServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		s.plugin = s.metrics.pluginLabel(s.fullMethod, m)
+	}
+	return err
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return "bidi_stream"
+	case info.IsClientStream:
+		return "client_stream"
+	default:
+		return "server_stream"
+	}
+}
+
+func observeRequest(fullMethod, grpcType, plugin string, err error, duration time.Duration) {
+	service, method := splitMethodName(fullMethod)
+	code := status.Code(err).String()
+	grpcRequestsTotal.WithLabelValues(service, method, grpcType, plugin, code).Inc()
+	grpcRequestDuration.WithLabelValues(service, method, grpcType, plugin, code).Observe(duration.Seconds())
+}
+
+// splitMethodName splits the full gRPC method name, such as
+// /kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries,
+// into its service and method.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// pluginForRequest returns the name of the plugin handling the request. It is
+// the plugin of the service for plugin-specific services, or otherwise the
+// plugin referenced by the request for the core services which route requests
+// to a single plugin. It is empty for requests aggregated across plugins.
+func pluginForRequest(fullMethod string, req interface{}) string {
+	service, _ := splitMethodName(fullMethod)
+	if strings.HasPrefix(service, pluginServicePrefix) {
+		// Drop the version and service name, for example
+		// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService
+		// is served by the helm.packages plugin.
+		parts := strings.Split(strings.TrimPrefix(service, pluginServicePrefix), ".")
+		if len(parts) > 2 {
+			return strings.Join(parts[:len(parts)-2], ".")
+		}
+	}
+	if msg, ok := req.(proto.Message); ok {
+		if plugin := findPlugin(msg.ProtoReflect(), 2); plugin != nil {
+			return plugin.GetName()
+		}
+	}
+	return ""
+}
+
+var pluginMessageName = (&plugins.Plugin{}).ProtoReflect().Descriptor().FullName()
+
+// findPlugin returns the first plugin found in the message fields, such as
+// the plugin of an installed package reference, up to the given depth.
+func findPlugin(msg protoreflect.Message, depth int) *plugins.Plugin {
+	var found *plugins.Plugin
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		if fd.Message().FullName() == pluginMessageName {
+			if p, ok := v.Message().Interface().(*plugins.Plugin); ok {
+				found = p
+				return false
+			}
+		}
+		if depth > 0 {
+			found = findPlugin(v.Message(), depth-1)
+		}
+		return found == nil
+	})
+	return found
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPluginForRequest(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fullMethod string
+		request    interface{}
+		expected   string
+	}{
+		{
+			name:       "it uses the plugin of a plugin-specific service",
+			fullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage",
+			expected:   "helm.packages",
+		},
+		{
+			name:       "it uses the plugin of a plugin-specific service with an underscore",
+			fullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/GetPackageRepositories",
+			expected:   "kapp_controller.packages",
+		},
+		{
+			name:       "it uses the plugin referenced by a core request",
+			fullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+			request: &packages.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Plugin: &plugins.Plugin{Name: "fluxv2.packages", Version: "v1alpha1"},
+				},
+			},
+			expected: "fluxv2.packages",
+		},
+		{
+			name:       "it uses the plugin nested in a core request",
+			fullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage",
+			request: &packages.CreateInstalledPackageRequest{
+				AvailablePackageRef: &packages.AvailablePackageReference{
+					Plugin: &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				},
+			},
+			expected: "helm.packages",
+		},
+		{
+			name:       "it is empty for core requests aggregated across plugins",
+			fullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetAvailablePackageSummaries",
+			request:    &packages.GetAvailablePackageSummariesRequest{},
+			expected:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := pluginForRequest(tc.fullMethod, tc.request), tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestMetricsUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+	}
+	request := &packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: &packages.InstalledPackageReference{
+			Plugin: &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+		},
+	}
+	counter := grpcRequestsTotal.WithLabelValues("kubeappsapis.core.packages.v1alpha1.PackagesService", "GetInstalledPackageDetail", "unary", "helm.packages", codes.NotFound.String())
+	before := testutil.ToFloat64(counter)
+
+	metrics := &requestMetrics{}
+	metrics.setConfiguredPlugins([]*plugins.Plugin{
+		{Name: "helm.packages", Version: "v1alpha1"},
+	})
+
+	_, err := metrics.UnaryInterceptor(context.Background(), request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Fatalf("got: %+v, want: %+v", got, want)
+	}
+
+	if got, want := testutil.ToFloat64(counter), before+1; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestPluginLabel(t *testing.T) {
+	metrics := &requestMetrics{}
+	metrics.setConfiguredPlugins([]*plugins.Plugin{
+		{Name: "helm.packages", Version: "v1alpha1"},
+	})
+
+	testCases := []struct {
+		name     string
+		request  interface{}
+		expected string
+	}{
+		{
+			name: "it uses a configured plugin",
+			request: &packages.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Plugin: &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+				},
+			},
+			expected: "helm.packages",
+		},
+		{
+			name: "it uses unknown for a plugin which is not configured",
+			request: &packages.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Plugin: &plugins.Plugin{Name: "made-up-1234.packages", Version: "v1alpha1"},
+				},
+			},
+			expected: "unknown",
+		},
+		{
+			name:     "it is empty for requests without a plugin",
+			request:  &packages.GetInstalledPackageDetailRequest{},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fullMethod := "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail"
+			if got, want := metrics.pluginLabel(fullMethod, tc.request), tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
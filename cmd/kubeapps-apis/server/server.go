@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -16,15 +17,24 @@ import (
 	"github.com/soheilhy/cmux"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/audit"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/notifications"
 	packagesv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/packages/v1alpha1"
 	pluginsv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/plugins/v1alpha1"
 	packagesGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	pluginsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	// Registers the google.rpc error detail types, so that the details of
+	// the errors are marshalled in the JSON responses of the gateway.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/client-go/kubernetes"
 	klogv2 "k8s.io/klog/v2"
 )
 
@@ -70,33 +80,70 @@ func Serve(serveOpts core.ServeOptions) error {
 	// Create the grpc server and register the reflection server (for now, useful for discovery
 	// using grpcurl) or similar.
 
-	grpcSrv := grpc.NewServer(grpc.ChainUnaryInterceptor(LogRequest))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	shutdownTracing, err := setupTracing(ctx, serveOpts)
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			klogv2.Errorf("failed to shut down tracing: %v", err)
+		}
+	}()
+
+	// The tracing interceptors come first so that the span of each request
+	// includes the time spent in the other interceptors.
+	metrics := &requestMetrics{}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{otelgrpc.UnaryServerInterceptor(), LogRequest, metrics.UnaryInterceptor}
+	auditor, err := newAuditor(serveOpts)
+	if err != nil {
+		return fmt.Errorf("failed to set up the audit log: %v", err)
+	} else if auditor != nil {
+		defer auditor.Close()
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryServerInterceptor)
+	}
+
+	grpcSrv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), metrics.StreamInterceptor),
+	)
 	reflection.Register(grpcSrv)
 
 	// Create the http server, register our core service followed by any plugins.
 	listenAddr := fmt.Sprintf(":%d", serveOpts.Port)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 	gw, err := gatewayMux()
 	if err != nil {
 		return fmt.Errorf("failed to create gateway: %v", err)
 	}
 	gwArgs := core.GatewayHandlerArgs{
-		Ctx:         ctx,
-		Mux:         gw,
-		Addr:        listenAddr,
-		DialOptions: []grpc.DialOption{grpc.WithInsecure()},
+		Ctx:  ctx,
+		Mux:  gw,
+		Addr: listenAddr,
+		DialOptions: []grpc.DialOption{
+			grpc.WithInsecure(),
+			// Propagate the trace context of the HTTP request to the gRPC server.
+			grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+			grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+		},
 	}
 
 	// Create the core.plugins.v1alpha1 server which handles registration of
 	// plugins, and register it for both grpc and http.
-	pluginsServer, err := pluginsv1alpha1.NewPluginsServer(serveOpts, grpcSrv, gwArgs)
+	pluginsServer, err := pluginsv1alpha1.NewPluginsServer(serveOpts, grpcSrv, gwArgs, auditor)
 	if err != nil {
 		return fmt.Errorf("failed to initialize plugins server: %v", err)
 	}
+	defer pluginsServer.Close()
+	configuredPlugins, err := pluginsServer.GetConfiguredPlugins(ctx, &pluginsGRPCv1alpha1.GetConfiguredPluginsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get the configured plugins: %v", err)
+	}
+	metrics.setConfiguredPlugins(configuredPlugins.GetPlugins())
 	if err = registerPluginsServiceServer(grpcSrv, pluginsServer, gwArgs); err != nil {
 		return err
-	} else if err = registerPackagesServiceServer(grpcSrv, pluginsServer, gwArgs); err != nil {
+	} else if err = registerPackagesServiceServer(grpcSrv, pluginsServer, gwArgs, serveOpts, auditor); err != nil {
 		return err
 	} else if err = registerRepositoriesServiceServer(grpcSrv, pluginsServer, gwArgs); err != nil {
 		return err
@@ -122,12 +169,17 @@ func Serve(serveOpts core.ServeOptions) error {
 		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool { return true }),
 	)
 
+	// The gateway handler extracts the trace context from the HTTP headers.
+	// The grpc-web requests are served by the grpc server, which extracts it
+	// from the same headers received as metadata.
+	gwHandler := otelhttp.NewHandler(gwArgs.Mux, "grpc-gateway")
+
 	httpSrv := &http.Server{
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if webrpcProxy.IsGrpcWebRequest(r) || webrpcProxy.IsAcceptableGrpcCorsRequest(r) || webrpcProxy.IsGrpcWebSocketRequest(r) {
 				webrpcProxy.ServeHTTP(w, r)
 			} else {
-				gwArgs.Mux.ServeHTTP(w, r)
+				gwHandler.ServeHTTP(w, r)
 			}
 		}),
 	}
@@ -151,6 +203,24 @@ func Serve(serveOpts core.ServeOptions) error {
 		}
 	}()
 
+	// The metrics are served on a separate port, which is not exposed
+	// publicly, since they are not authenticated.
+	if serveOpts.MetricsPort != 0 {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle(metricsPath, promhttp.Handler())
+		metricsSrv := &http.Server{
+			Addr:    fmt.Sprintf(":%d", serveOpts.MetricsPort),
+			Handler: metricsMux,
+		}
+		go func() {
+			err := metricsSrv.ListenAndServe()
+			if err != nil {
+				klogv2.Fatalf("failed to serve metrics: %v", err)
+			}
+		}()
+		klogv2.Infof("Serving metrics on :%d%s", serveOpts.MetricsPort, metricsPath)
+	}
+
 	if serveOpts.UnsafeLocalDevKubeconfig {
 		klogv2.Warning("Using the local Kubeconfig file instead of the actual in-cluster's config. This is not recommended except for development purposes.")
 	}
@@ -163,6 +233,37 @@ func Serve(serveOpts core.ServeOptions) error {
 	return nil
 }
 
+// newAuditor returns the auditor writing the audit events to the configured
+// sinks, or nil if none is configured.
+func newAuditor(serveOpts core.ServeOptions) (*audit.Auditor, error) {
+	sinks := []audit.Sink{}
+	if serveOpts.AuditLogPath != "" {
+		sink, err := audit.NewFileSink(serveOpts.AuditLogPath)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if serveOpts.AuditWebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(serveOpts.AuditWebhookURL, &http.Client{Timeout: 10 * time.Second}))
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+
+	// The users are resolved with the service account of kubeapps-apis, as
+	// creating a TokenReview requires permissions which users may not have.
+	config, err := pluginsv1alpha1.InClusterConfig(serveOpts)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the client to review tokens: %w", err)
+	}
+	return audit.NewAuditor(audit.NewTokenReviewUserResolver(client), sinks...), nil
+}
+
 func registerPluginsServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1alpha1.PluginsServer, gwArgs core.GatewayHandlerArgs) error {
 	pluginsGRPCv1alpha1.RegisterPluginsServiceServer(grpcSrv, pluginsServer)
 	err := pluginsGRPCv1alpha1.RegisterPluginsServiceHandlerFromEndpoint(gwArgs.Ctx, gwArgs.Mux, gwArgs.Addr, gwArgs.DialOptions)
@@ -172,7 +273,7 @@ func registerPluginsServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1
 	return nil
 }
 
-func registerPackagesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1alpha1.PluginsServer, gwArgs core.GatewayHandlerArgs) error {
+func registerPackagesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1alpha1.PluginsServer, gwArgs core.GatewayHandlerArgs, serveOpts core.ServeOptions, auditor *audit.Auditor) error {
 	// Ask the plugins server for plugins with GRPC servers that fulfil the core
 	// packaging v1alpha1 API, then pass to the constructor below.
 	// The argument for the reflect.TypeOf is based on what grpc-go
@@ -190,9 +291,59 @@ func registerPackagesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv
 	if err != nil {
 		return fmt.Errorf("failed to register core.packages handler for gateway: %v", err)
 	}
+
+	// The core.packages bundles server is implemented on top of the
+	// core.packages server, so that it routes to the plugin of each package.
+	bundlesServer := packagesv1alpha1.NewBundlesServer(packagesServer, auditor)
+	packagesGRPCv1alpha1.RegisterBundlesServiceServer(grpcSrv, bundlesServer)
+	err = packagesGRPCv1alpha1.RegisterBundlesServiceHandlerFromEndpoint(gwArgs.Ctx, gwArgs.Mux, gwArgs.Addr, gwArgs.DialOptions)
+	if err != nil {
+		return fmt.Errorf("failed to register core.packages bundles handler for gateway: %v", err)
+	}
+
+	if serveOpts.NotificationsConfigPath != "" {
+		if err = startNotifier(gwArgs.Ctx, serveOpts, packagesServer); err != nil {
+			return fmt.Errorf("failed to start the notifications: %w", err)
+		}
+	}
 	return nil
 }
 
+// startNotifier starts notifying the new versions of the installed packages
+// of all the plugins in the background.
+func startNotifier(ctx context.Context, serveOpts core.ServeOptions, packagesServer packagesGRPCv1alpha1.PackagesServiceServer) error {
+	config, err := notifications.ParseConfig(serveOpts.NotificationsConfigPath)
+	if err != nil {
+		return err
+	}
+	sinks := []notifications.Sink{}
+	for _, w := range config.Webhooks {
+		sink, err := notifications.NewWebhookSink(w, &http.Client{Timeout: 10 * time.Second})
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	// The notified versions and the lease are stored with the service
+	// account of kubeapps-apis in its namespace.
+	restConfig, err := pluginsv1alpha1.InClusterConfig(serveOpts)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create the client to store the notified versions: %w", err)
+	}
+	namespace := os.Getenv("POD_NAMESPACE")
+	if namespace == "" {
+		namespace = serveOpts.GlobalReposNamespace
+	}
+
+	notifier := notifications.NewNotifier(packagesServer, config, notifications.NewConfigMapStore(client, namespace), sinks...)
+	return notifications.RunWithLeaderElection(ctx, notifier, client, namespace)
+}
+
 func registerRepositoriesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1alpha1.PluginsServer, gwArgs core.GatewayHandlerArgs) error {
 	// see comment in registerPackagesServiceServer
 	repositoriesPlugins := pluginsServer.GetPluginsSatisfyingInterface(reflect.TypeOf((*packagesGRPCv1alpha1.RepositoriesServiceServer)(nil)).Elem())
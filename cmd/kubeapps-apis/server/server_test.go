@@ -0,0 +1,62 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGatewayMuxErrorDetails(t *testing.T) {
+	gwmux, err := gatewayMux()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/plugins/helm/packages/v1alpha1/installedpackages", nil)
+	w := httptest.NewRecorder()
+	_, marshaler := runtime.MarshalerForRequest(gwmux, req)
+
+	err = statuserror.ResourceErrorf(codes.NotFound, statuserror.ReasonPackageNotFound, "Chart", "bitnami/apache", "Unable to find the chart %q", "bitnami/apache")
+	runtime.HTTPError(context.Background(), gwmux, marshaler, w, req, err)
+
+	if got, want := w.Code, http.StatusNotFound; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := map[string]interface{}{
+		"code":    float64(codes.NotFound),
+		"message": `Unable to find the chart "bitnami/apache"`,
+		"details": []interface{}{
+			map[string]interface{}{
+				"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": statuserror.ReasonPackageNotFound,
+				"domain": statuserror.ErrorDomain,
+				"metadata": map[string]interface{}{
+					"resourceType": "Chart",
+					"resourceName": "bitnami/apache",
+				},
+			},
+			map[string]interface{}{
+				"@type":        "type.googleapis.com/google.rpc.ResourceInfo",
+				"resourceType": "Chart",
+				"resourceName": "bitnami/apache",
+				"description":  `Unable to find the chart "bitnami/apache"`,
+			},
+		},
+	}
+	if got, want := body, expected; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
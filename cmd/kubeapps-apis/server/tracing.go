@@ -0,0 +1,61 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	klogv2 "k8s.io/klog/v2"
+)
+
+const tracingServiceName = "kubeapps-apis"
+
+// setupTracing configures the global OpenTelemetry tracer provider to export
+// the spans to the configured OTLP endpoint, and the global propagator so that
+// the trace context is propagated in the W3C traceparent header or metadata.
+//
+// The returned func flushes and stops the exporter. Tracing is left disabled,
+// with the default no-op tracer provider, when no endpoint is configured.
+func setupTracing(ctx context.Context, serveOpts core.ServeOptions, exporterOpts ...otlptracegrpc.Option) (func(context.Context) error, error) {
+	if serveOpts.TracingEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	sampleRatio := serveOpts.TracingSampleRatio
+	if sampleRatio < 0 || sampleRatio > 1 {
+		return nil, fmt.Errorf("invalid tracing sample ratio %v, expected a value between 0 and 1", sampleRatio)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(serveOpts.TracingEndpoint)}
+	if serveOpts.TracingInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, append(opts, exporterOpts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the OTLP trace exporter: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(tracingServiceName),
+		)),
+		// Respect the sampling decision of the caller, if any.
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	klogv2.Infof("Exporting traces to %s with a sample ratio of %v", serveOpts.TracingEndpoint, sampleRatio)
+	return tracerProvider.Shutdown, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testCollector is an in-memory stand-in for an OTLP collector, keeping the
+// spans which are exported to it.
+type testCollector struct {
+	coltracepb.UnimplementedTraceServiceServer
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *testCollector) Export(ctx context.Context, request *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, resourceSpans := range request.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			c.spans = append(c.spans, scopeSpans.GetSpans()...)
+		}
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+// startTestCollector serves a test collector in-memory, returning the exporter
+// option to connect to it.
+func startTestCollector(t *testing.T) (*testCollector, otlptracegrpc.Option) {
+	lis := bufconn.Listen(1024 * 1024)
+	collector := &testCollector{}
+	grpcSrv := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(grpcSrv, collector)
+	go func() {
+		if err := grpcSrv.Serve(lis); err != nil {
+			t.Logf("%+v", err)
+		}
+	}()
+	t.Cleanup(grpcSrv.Stop)
+
+	return collector, otlptracegrpc.WithDialOption(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+}
+
+func TestSetupTracingExportsSpans(t *testing.T) {
+	collector, dialOpt := startTestCollector(t)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+
+	shutdown, err := setupTracing(context.Background(), core.ServeOptions{
+		TracingEndpoint:    "bufnet",
+		TracingInsecure:    true,
+		TracingSampleRatio: 1.0,
+	}, dialOpt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// The trace context is received in the metadata, as sent by the gateway
+	// or by grpc-web clients.
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"traceparent": "00-" + traceID + "-00f067aa0ba902b7-01",
+	}))
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+	}
+	_, err = otelgrpc.UnaryServerInterceptor()(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, span := otel.Tracer("test").Start(ctx, "helm.packages/GetInstalledPackageDetail")
+		span.End()
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// Shutting down flushes the spans to the collector.
+	if err = shutdown(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	names := []string{}
+	for _, span := range collector.spans {
+		names = append(names, span.GetName())
+		if got, want := hex.EncodeToString(span.GetTraceId()), traceID; got != want {
+			t.Errorf("got trace id: %s, want: %s for span %q", got, want, span.GetName())
+		}
+	}
+	sort.Strings(names)
+	expectedNames := []string{
+		"helm.packages/GetInstalledPackageDetail",
+		"kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail",
+	}
+	if got, want := names, expectedNames; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestSetupTracingErrors(t *testing.T) {
+	shutdown, err := setupTracing(context.Background(), core.ServeOptions{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = shutdown(context.Background()); err != nil {
+		t.Errorf("%+v", err)
+	}
+
+	_, err = setupTracing(context.Background(), core.ServeOptions{
+		TracingEndpoint:    "otel-collector:4317",
+		TracingSampleRatio: 2,
+	})
+	if err == nil {
+		t.Errorf("got: nil, want: error")
+	}
+}
@@ -0,0 +1,1087 @@
+/* eslint-disable */
+import Long from "long";
+import { grpc } from "@improbable-eng/grpc-web";
+import * as _m0 from "protobufjs/minimal";
+import {
+  InstalledPackageReference,
+  Context,
+  AvailablePackageReference,
+  VersionReference,
+  ReconciliationOptions,
+} from "../../../../kubeappsapis/core/packages/v1alpha1/packages";
+import { BrowserHeaders } from "browser-headers";
+
+export const protobufPackage = "kubeappsapis.core.packages.v1alpha1";
+
+/**
+ * ExportInstalledPackagesRequest
+ *
+ * Request for ExportInstalledPackages
+ */
+export interface ExportInstalledPackagesRequest {
+  /** The references of the installed packages to be exported. Required */
+  installedPackageRefs: InstalledPackageReference[];
+  /** The format of the export. Required */
+  format: ExportInstalledPackagesRequest_ExportFormat;
+}
+
+/**
+ * ExportFormat
+ *
+ * The format in which the installed packages are exported.
+ */
+export enum ExportInstalledPackagesRequest_ExportFormat {
+  /** No format, which is invalid. */
+  EXPORT_FORMAT_UNSPECIFIED = 0,
+  /**
+   * A Flux HelmRelease for each package, together with the HelmRepository
+   * of its chart. Only available for packages which are Helm charts.
+   */
+  EXPORT_FORMAT_FLUX = 1,
+  /**
+   * A Carvel PackageInstall for each package, together with a Secret with
+   * its values. Only available for packages which are Carvel packages.
+   */
+  EXPORT_FORMAT_CARVEL = 2,
+  /**
+   * A helmfile with a release for each package and the repositories of
+   * their charts. Only available for packages which are Helm charts.
+   */
+  EXPORT_FORMAT_HELMFILE = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function exportInstalledPackagesRequest_ExportFormatFromJSON(
+  object: any,
+): ExportInstalledPackagesRequest_ExportFormat {
+  switch (object) {
+    case 0:
+    case "EXPORT_FORMAT_UNSPECIFIED":
+      return ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_UNSPECIFIED;
+    case 1:
+    case "EXPORT_FORMAT_FLUX":
+      return ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_FLUX;
+    case 2:
+    case "EXPORT_FORMAT_CARVEL":
+      return ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_CARVEL;
+    case 3:
+    case "EXPORT_FORMAT_HELMFILE":
+      return ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_HELMFILE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ExportInstalledPackagesRequest_ExportFormat.UNRECOGNIZED;
+  }
+}
+
+export function exportInstalledPackagesRequest_ExportFormatToJSON(
+  object: ExportInstalledPackagesRequest_ExportFormat,
+): string {
+  switch (object) {
+    case ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_UNSPECIFIED:
+      return "EXPORT_FORMAT_UNSPECIFIED";
+    case ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_FLUX:
+      return "EXPORT_FORMAT_FLUX";
+    case ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_CARVEL:
+      return "EXPORT_FORMAT_CARVEL";
+    case ExportInstalledPackagesRequest_ExportFormat.EXPORT_FORMAT_HELMFILE:
+      return "EXPORT_FORMAT_HELMFILE";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * ExportInstalledPackagesResponse
+ *
+ * Response for ExportInstalledPackages
+ */
+export interface ExportInstalledPackagesResponse {
+  /**
+   * Exported packages
+   *
+   * The definition of each installed package, in the order of the request.
+   */
+  exportedPackages: ExportedPackage[];
+  /**
+   * Bundle
+   *
+   * The definitions of all the installed packages as a single file: a
+   * multi-document YAML file with each resource only once for the Flux and
+   * Carvel formats, or a single helmfile.
+   */
+  bundle: string;
+  /**
+   * Packages
+   *
+   * The portable definition of the installed packages, which can be
+   * imported in another context with ImportInstalledPackages.
+   */
+  packages: BundledPackage[];
+}
+
+/**
+ * ExportedPackage
+ *
+ * The declarative definition of an installed package.
+ */
+export interface ExportedPackage {
+  /** The reference of the exported installed package. */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * The YAML definition of the installed package, including the resources
+   * it depends on, such as the repository of a Helm chart.
+   */
+  manifest: string;
+}
+
+/**
+ * ImportInstalledPackagesRequest
+ *
+ * Request for ImportInstalledPackages
+ */
+export interface ImportInstalledPackagesRequest {
+  /** The context (cluster/namespace) in which the packages are installed. Required */
+  targetContext?: Context;
+  /** The packages to be installed. Required */
+  packages: BundledPackage[];
+}
+
+/**
+ * ImportInstalledPackagesResponse
+ *
+ * Response for ImportInstalledPackages
+ */
+export interface ImportInstalledPackagesResponse {
+  /**
+   * Results
+   *
+   * The result of the import of each package, in the order of the request.
+   */
+  results: ImportedPackageResult[];
+}
+
+/**
+ * BundledPackage
+ *
+ * The portable definition of an installed package, independent of the
+ * context in which it is installed.
+ */
+export interface BundledPackage {
+  /** The name of the installed package. */
+  name: string;
+  /**
+   * The reference of the available package it is installed from. Its
+   * context is only a hint: when importing, the package is looked up first
+   * in the target context and then in this one.
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * The version of the available package, as a version constraint where
+   * supported.
+   */
+  pkgVersionReference?: VersionReference;
+  /** The values of the installed package, as a YAML or JSON string. */
+  values: string;
+  /** The reconciliation options of the installed package, if any. */
+  reconciliationOptions?: ReconciliationOptions;
+}
+
+/**
+ * ImportedPackageResult
+ *
+ * The result of the import of a package.
+ */
+export interface ImportedPackageResult {
+  /** The name of the package in the bundle. */
+  name: string;
+  /** The status of the import. */
+  status: ImportedPackageResult_ImportStatus;
+  /**
+   * The reference of the installed package in the target context, unless
+   * the import failed.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * The reference of the available package resolved in the target context,
+   * if it was found.
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * A human-readable description of the result, such as the reason of a
+   * failure.
+   */
+  message: string;
+}
+
+/**
+ * ImportStatus
+ *
+ * The outcome of the import of a package.
+ */
+export enum ImportedPackageResult_ImportStatus {
+  /** No status, which is invalid. */
+  IMPORT_STATUS_UNSPECIFIED = 0,
+  /** The installed package was created. */
+  IMPORT_STATUS_CREATED = 1,
+  /**
+   * An installed package with the same name already existed in the target
+   * context, so it was left untouched.
+   */
+  IMPORT_STATUS_ALREADY_EXISTS = 2,
+  /** The installed package could not be created. See the message. */
+  IMPORT_STATUS_FAILED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function importedPackageResult_ImportStatusFromJSON(
+  object: any,
+): ImportedPackageResult_ImportStatus {
+  switch (object) {
+    case 0:
+    case "IMPORT_STATUS_UNSPECIFIED":
+      return ImportedPackageResult_ImportStatus.IMPORT_STATUS_UNSPECIFIED;
+    case 1:
+    case "IMPORT_STATUS_CREATED":
+      return ImportedPackageResult_ImportStatus.IMPORT_STATUS_CREATED;
+    case 2:
+    case "IMPORT_STATUS_ALREADY_EXISTS":
+      return ImportedPackageResult_ImportStatus.IMPORT_STATUS_ALREADY_EXISTS;
+    case 3:
+    case "IMPORT_STATUS_FAILED":
+      return ImportedPackageResult_ImportStatus.IMPORT_STATUS_FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ImportedPackageResult_ImportStatus.UNRECOGNIZED;
+  }
+}
+
+export function importedPackageResult_ImportStatusToJSON(
+  object: ImportedPackageResult_ImportStatus,
+): string {
+  switch (object) {
+    case ImportedPackageResult_ImportStatus.IMPORT_STATUS_UNSPECIFIED:
+      return "IMPORT_STATUS_UNSPECIFIED";
+    case ImportedPackageResult_ImportStatus.IMPORT_STATUS_CREATED:
+      return "IMPORT_STATUS_CREATED";
+    case ImportedPackageResult_ImportStatus.IMPORT_STATUS_ALREADY_EXISTS:
+      return "IMPORT_STATUS_ALREADY_EXISTS";
+    case ImportedPackageResult_ImportStatus.IMPORT_STATUS_FAILED:
+      return "IMPORT_STATUS_FAILED";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+function createBaseExportInstalledPackagesRequest(): ExportInstalledPackagesRequest {
+  return { installedPackageRefs: [], format: 0 };
+}
+
+export const ExportInstalledPackagesRequest = {
+  encode(
+    message: ExportInstalledPackagesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.installedPackageRefs) {
+      InstalledPackageReference.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.format !== 0) {
+      writer.uint32(16).int32(message.format);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportInstalledPackagesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportInstalledPackagesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRefs.push(
+            InstalledPackageReference.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.format = reader.int32() as any;
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportInstalledPackagesRequest {
+    return {
+      installedPackageRefs: Array.isArray(object?.installedPackageRefs)
+        ? object.installedPackageRefs.map((e: any) => InstalledPackageReference.fromJSON(e))
+        : [],
+      format: isSet(object.format)
+        ? exportInstalledPackagesRequest_ExportFormatFromJSON(object.format)
+        : 0,
+    };
+  },
+
+  toJSON(message: ExportInstalledPackagesRequest): unknown {
+    const obj: any = {};
+    if (message.installedPackageRefs) {
+      obj.installedPackageRefs = message.installedPackageRefs.map(e =>
+        e ? InstalledPackageReference.toJSON(e) : undefined,
+      );
+    } else {
+      obj.installedPackageRefs = [];
+    }
+    message.format !== undefined &&
+      (obj.format = exportInstalledPackagesRequest_ExportFormatToJSON(message.format));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ExportInstalledPackagesRequest>, I>>(
+    object: I,
+  ): ExportInstalledPackagesRequest {
+    const message = createBaseExportInstalledPackagesRequest();
+    message.installedPackageRefs =
+      object.installedPackageRefs?.map(e => InstalledPackageReference.fromPartial(e)) || [];
+    message.format = object.format ?? 0;
+    return message;
+  },
+};
+
+function createBaseExportInstalledPackagesResponse(): ExportInstalledPackagesResponse {
+  return { exportedPackages: [], bundle: "", packages: [] };
+}
+
+export const ExportInstalledPackagesResponse = {
+  encode(
+    message: ExportInstalledPackagesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.exportedPackages) {
+      ExportedPackage.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.bundle !== "") {
+      writer.uint32(18).string(message.bundle);
+    }
+    for (const v of message.packages) {
+      BundledPackage.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportInstalledPackagesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportInstalledPackagesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.exportedPackages.push(ExportedPackage.decode(reader, reader.uint32()));
+          break;
+        case 2:
+          message.bundle = reader.string();
+          break;
+        case 3:
+          message.packages.push(BundledPackage.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportInstalledPackagesResponse {
+    return {
+      exportedPackages: Array.isArray(object?.exportedPackages)
+        ? object.exportedPackages.map((e: any) => ExportedPackage.fromJSON(e))
+        : [],
+      bundle: isSet(object.bundle) ? String(object.bundle) : "",
+      packages: Array.isArray(object?.packages)
+        ? object.packages.map((e: any) => BundledPackage.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ExportInstalledPackagesResponse): unknown {
+    const obj: any = {};
+    if (message.exportedPackages) {
+      obj.exportedPackages = message.exportedPackages.map(e =>
+        e ? ExportedPackage.toJSON(e) : undefined,
+      );
+    } else {
+      obj.exportedPackages = [];
+    }
+    message.bundle !== undefined && (obj.bundle = message.bundle);
+    if (message.packages) {
+      obj.packages = message.packages.map(e => (e ? BundledPackage.toJSON(e) : undefined));
+    } else {
+      obj.packages = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ExportInstalledPackagesResponse>, I>>(
+    object: I,
+  ): ExportInstalledPackagesResponse {
+    const message = createBaseExportInstalledPackagesResponse();
+    message.exportedPackages =
+      object.exportedPackages?.map(e => ExportedPackage.fromPartial(e)) || [];
+    message.bundle = object.bundle ?? "";
+    message.packages = object.packages?.map(e => BundledPackage.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseExportedPackage(): ExportedPackage {
+  return { installedPackageRef: undefined, manifest: "" };
+}
+
+export const ExportedPackage = {
+  encode(message: ExportedPackage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.manifest !== "") {
+      writer.uint32(18).string(message.manifest);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportedPackage {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportedPackage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.manifest = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportedPackage {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      manifest: isSet(object.manifest) ? String(object.manifest) : "",
+    };
+  },
+
+  toJSON(message: ExportedPackage): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ExportedPackage>, I>>(object: I): ExportedPackage {
+    const message = createBaseExportedPackage();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.manifest = object.manifest ?? "";
+    return message;
+  },
+};
+
+function createBaseImportInstalledPackagesRequest(): ImportInstalledPackagesRequest {
+  return { targetContext: undefined, packages: [] };
+}
+
+export const ImportInstalledPackagesRequest = {
+  encode(
+    message: ImportInstalledPackagesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.targetContext !== undefined) {
+      Context.encode(message.targetContext, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.packages) {
+      BundledPackage.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportInstalledPackagesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportInstalledPackagesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.targetContext = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.packages.push(BundledPackage.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportInstalledPackagesRequest {
+    return {
+      targetContext: isSet(object.targetContext)
+        ? Context.fromJSON(object.targetContext)
+        : undefined,
+      packages: Array.isArray(object?.packages)
+        ? object.packages.map((e: any) => BundledPackage.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ImportInstalledPackagesRequest): unknown {
+    const obj: any = {};
+    message.targetContext !== undefined &&
+      (obj.targetContext = message.targetContext
+        ? Context.toJSON(message.targetContext)
+        : undefined);
+    if (message.packages) {
+      obj.packages = message.packages.map(e => (e ? BundledPackage.toJSON(e) : undefined));
+    } else {
+      obj.packages = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ImportInstalledPackagesRequest>, I>>(
+    object: I,
+  ): ImportInstalledPackagesRequest {
+    const message = createBaseImportInstalledPackagesRequest();
+    message.targetContext =
+      object.targetContext !== undefined && object.targetContext !== null
+        ? Context.fromPartial(object.targetContext)
+        : undefined;
+    message.packages = object.packages?.map(e => BundledPackage.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseImportInstalledPackagesResponse(): ImportInstalledPackagesResponse {
+  return { results: [] };
+}
+
+export const ImportInstalledPackagesResponse = {
+  encode(
+    message: ImportInstalledPackagesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.results) {
+      ImportedPackageResult.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportInstalledPackagesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportInstalledPackagesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.results.push(ImportedPackageResult.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportInstalledPackagesResponse {
+    return {
+      results: Array.isArray(object?.results)
+        ? object.results.map((e: any) => ImportedPackageResult.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ImportInstalledPackagesResponse): unknown {
+    const obj: any = {};
+    if (message.results) {
+      obj.results = message.results.map(e => (e ? ImportedPackageResult.toJSON(e) : undefined));
+    } else {
+      obj.results = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ImportInstalledPackagesResponse>, I>>(
+    object: I,
+  ): ImportInstalledPackagesResponse {
+    const message = createBaseImportInstalledPackagesResponse();
+    message.results = object.results?.map(e => ImportedPackageResult.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseBundledPackage(): BundledPackage {
+  return {
+    name: "",
+    availablePackageRef: undefined,
+    pkgVersionReference: undefined,
+    values: "",
+    reconciliationOptions: undefined,
+  };
+}
+
+export const BundledPackage = {
+  encode(message: BundledPackage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.values !== "") {
+      writer.uint32(34).string(message.values);
+    }
+    if (message.reconciliationOptions !== undefined) {
+      ReconciliationOptions.encode(
+        message.reconciliationOptions,
+        writer.uint32(42).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BundledPackage {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBundledPackage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.values = reader.string();
+          break;
+        case 5:
+          message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BundledPackage {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      availablePackageRef: isSet(object.availablePackageRef)
+        ? AvailablePackageReference.fromJSON(object.availablePackageRef)
+        : undefined,
+      pkgVersionReference: isSet(object.pkgVersionReference)
+        ? VersionReference.fromJSON(object.pkgVersionReference)
+        : undefined,
+      values: isSet(object.values) ? String(object.values) : "",
+      reconciliationOptions: isSet(object.reconciliationOptions)
+        ? ReconciliationOptions.fromJSON(object.reconciliationOptions)
+        : undefined,
+    };
+  },
+
+  toJSON(message: BundledPackage): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.values !== undefined && (obj.values = message.values);
+    message.reconciliationOptions !== undefined &&
+      (obj.reconciliationOptions = message.reconciliationOptions
+        ? ReconciliationOptions.toJSON(message.reconciliationOptions)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<BundledPackage>, I>>(object: I): BundledPackage {
+    const message = createBaseBundledPackage();
+    message.name = object.name ?? "";
+    message.availablePackageRef =
+      object.availablePackageRef !== undefined && object.availablePackageRef !== null
+        ? AvailablePackageReference.fromPartial(object.availablePackageRef)
+        : undefined;
+    message.pkgVersionReference =
+      object.pkgVersionReference !== undefined && object.pkgVersionReference !== null
+        ? VersionReference.fromPartial(object.pkgVersionReference)
+        : undefined;
+    message.values = object.values ?? "";
+    message.reconciliationOptions =
+      object.reconciliationOptions !== undefined && object.reconciliationOptions !== null
+        ? ReconciliationOptions.fromPartial(object.reconciliationOptions)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseImportedPackageResult(): ImportedPackageResult {
+  return {
+    name: "",
+    status: 0,
+    installedPackageRef: undefined,
+    availablePackageRef: undefined,
+    message: "",
+  };
+}
+
+export const ImportedPackageResult = {
+  encode(message: ImportedPackageResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(26).fork(),
+      ).ldelim();
+    }
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(34).fork(),
+      ).ldelim();
+    }
+    if (message.message !== "") {
+      writer.uint32(42).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportedPackageResult {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportedPackageResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.status = reader.int32() as any;
+          break;
+        case 3:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.message = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportedPackageResult {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      status: isSet(object.status) ? importedPackageResult_ImportStatusFromJSON(object.status) : 0,
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      availablePackageRef: isSet(object.availablePackageRef)
+        ? AvailablePackageReference.fromJSON(object.availablePackageRef)
+        : undefined,
+      message: isSet(object.message) ? String(object.message) : "",
+    };
+  },
+
+  toJSON(message: ImportedPackageResult): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.status !== undefined &&
+      (obj.status = importedPackageResult_ImportStatusToJSON(message.status));
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.message !== undefined && (obj.message = message.message);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ImportedPackageResult>, I>>(
+    object: I,
+  ): ImportedPackageResult {
+    const message = createBaseImportedPackageResult();
+    message.name = object.name ?? "";
+    message.status = object.status ?? 0;
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.availablePackageRef =
+      object.availablePackageRef !== undefined && object.availablePackageRef !== null
+        ? AvailablePackageReference.fromPartial(object.availablePackageRef)
+        : undefined;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+/**
+ * The BundlesService is implemented by the core packages API only, on top of
+ * the PackagesService rpcs of the plugin of each installed package, so that
+ * it works with the installed packages of any plugin.
+ */
+export interface BundlesService {
+  /**
+   * ExportInstalledPackages returns the declarative definition of the
+   * installed packages, in the requested format, so that they can be stored
+   * in Git and reconciled by a GitOps tool.
+   */
+  ExportInstalledPackages(
+    request: DeepPartial<ExportInstalledPackagesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackagesResponse>;
+  /**
+   * ImportInstalledPackages creates the installed packages of a bundle, such
+   * as the one returned by ExportInstalledPackages, in the target context.
+   * Installed packages which already exist there are left untouched, so
+   * that the same bundle can be imported again.
+   */
+  ImportInstalledPackages(
+    request: DeepPartial<ImportInstalledPackagesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ImportInstalledPackagesResponse>;
+}
+
+export class BundlesServiceClientImpl implements BundlesService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.ExportInstalledPackages = this.ExportInstalledPackages.bind(this);
+    this.ImportInstalledPackages = this.ImportInstalledPackages.bind(this);
+  }
+
+  ExportInstalledPackages(
+    request: DeepPartial<ExportInstalledPackagesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ExportInstalledPackagesResponse> {
+    return this.rpc.unary(
+      BundlesServiceExportInstalledPackagesDesc,
+      ExportInstalledPackagesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ImportInstalledPackages(
+    request: DeepPartial<ImportInstalledPackagesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ImportInstalledPackagesResponse> {
+    return this.rpc.unary(
+      BundlesServiceImportInstalledPackagesDesc,
+      ImportInstalledPackagesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const BundlesServiceDesc = {
+  serviceName: "kubeappsapis.core.packages.v1alpha1.BundlesService",
+};
+
+export const BundlesServiceExportInstalledPackagesDesc: UnaryMethodDefinitionish = {
+  methodName: "ExportInstalledPackages",
+  service: BundlesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ExportInstalledPackagesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ExportInstalledPackagesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const BundlesServiceImportInstalledPackagesDesc: UnaryMethodDefinitionish = {
+  methodName: "ImportInstalledPackages",
+  service: BundlesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ImportInstalledPackagesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ImportInstalledPackagesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
+  requestStream: any;
+  responseStream: any;
+}
+
+type UnaryMethodDefinitionish = UnaryMethodDefinitionishR;
+
+interface Rpc {
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any>;
+}
+
+export class GrpcWebImpl {
+  private host: string;
+  private options: {
+    transport?: grpc.TransportFactory;
+
+    debug?: boolean;
+    metadata?: grpc.Metadata;
+  };
+
+  constructor(
+    host: string,
+    options: {
+      transport?: grpc.TransportFactory;
+
+      debug?: boolean;
+      metadata?: grpc.Metadata;
+    },
+  ) {
+    this.host = host;
+    this.options = options;
+  }
+
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any> {
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata =
+      metadata && this.options.metadata
+        ? new BrowserHeaders({
+            ...this.options?.metadata.headersMap,
+            ...metadata?.headersMap,
+          })
+        : metadata || this.options.metadata;
+    return new Promise((resolve, reject) => {
+      grpc.unary(methodDesc, {
+        request,
+        host: this.host,
+        metadata: maybeCombinedMetadata,
+        transport: this.options.transport,
+        debug: this.options.debug,
+        onEnd: function (response) {
+          if (response.status === grpc.Code.OK) {
+            resolve(response.message);
+          } else {
+            const err = new Error(response.statusMessage) as any;
+            err.code = response.status;
+            err.metadata = response.trailers;
+            reject(err);
+          }
+        },
+      });
+    });
+  }
+}
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin
+  ? T
+  : T extends Array<infer U>
+  ? Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U>
+  ? ReadonlyArray<DeepPartial<U>>
+  : T extends {}
+  ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin
+  ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & Record<Exclude<keyof I, KeysOfUnion<P>>, never>;
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
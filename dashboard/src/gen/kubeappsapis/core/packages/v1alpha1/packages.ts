@@ -2,9 +2,12 @@
 import Long from "long";
 import { grpc } from "@improbable-eng/grpc-web";
 import * as _m0 from "protobufjs/minimal";
+import { Timestamp } from "../../../../google/protobuf/timestamp";
 import { Any } from "../../../../google/protobuf/any";
 import { Plugin } from "../../../../kubeappsapis/core/plugins/v1alpha1/plugins";
+import { Observable } from "rxjs";
 import { BrowserHeaders } from "browser-headers";
+import { share } from "rxjs/operators";
 
 export const protobufPackage = "kubeappsapis.core.packages.v1alpha1";
 
@@ -71,6 +74,18 @@ export interface GetInstalledPackageSummariesRequest {
   context?: Context;
   /** Pagination options specifying where to start and how many results to include. */
   paginationOptions?: PaginationOptions;
+  /** The filters to be applied to the installed packages before paginating. */
+  filterOptions?: InstalledPackageFilterOptions;
+}
+
+/**
+ * WatchInstalledPackageSummariesRequest
+ *
+ * Request for WatchInstalledPackageSummaries
+ */
+export interface WatchInstalledPackageSummariesRequest {
+  /** The context (cluster/namespace) for the request. */
+  context?: Context;
 }
 
 /**
@@ -158,6 +173,43 @@ export interface UpdateInstalledPackageRequest {
   reconciliationOptions?: ReconciliationOptions;
 }
 
+/**
+ * GetInstalledPackageUpdatePreviewRequest
+ *
+ * Request for GetInstalledPackageUpdatePreview. The fields mirror those of the
+ * UpdateInstalledPackageRequest so that a client can preview exactly the
+ * update (or creation) that it is about to request. Nothing is changed on the
+ * cluster as a result of this request.
+ */
+export interface GetInstalledPackageUpdatePreviewRequest {
+  /**
+   * A reference uniquely identifying the installed package for which the
+   * preview is requested. If no such installed package exists yet, the
+   * preview is that of creating it, in which case the available_package_ref
+   * is required.
+   * Required
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * A reference uniquely identifying the package to be rendered. Required
+   * when previewing the creation of an installed package, otherwise the
+   * available package currently used by the installed package is assumed.
+   * Optional
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * The version (or version constraint) to be rendered, with the same
+   * semantics as for CreateInstalledPackage and UpdateInstalledPackage.
+   * Optional
+   */
+  pkgVersionReference?: VersionReference;
+  /**
+   * An optional serialized values string to be used when rendering the
+   * package, with the same semantics as for UpdateInstalledPackage.
+   */
+  values: string;
+}
+
 /**
  * DeleteInstalledPackageRequest
  *
@@ -175,6 +227,76 @@ export interface DeleteInstalledPackageRequest {
  */
 export interface GetInstalledPackageResourceRefsRequest {
   installedPackageRef?: InstalledPackageReference;
+  /**
+   * Include manifest
+   *
+   * Whether to also return the rendered manifest of the resources, which can
+   * be large. Not requested by default.
+   */
+  includeManifest: boolean;
+}
+
+/**
+ * GetInstalledPackageRevisionsRequest
+ *
+ * Request for GetInstalledPackageRevisions
+ */
+export interface GetInstalledPackageRevisionsRequest {
+  /** A reference to uniquely identify the installed package. */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * RollbackInstalledPackageRequest
+ *
+ * Request for RollbackInstalledPackage
+ */
+export interface RollbackInstalledPackageRequest {
+  /** A reference to uniquely identify the installed package to be rolled back. */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Revision
+   *
+   * The number identifying the revision to which to rollback.
+   */
+  revision: number;
+}
+
+/**
+ * CheckInstalledPackagePermissionsRequest
+ *
+ * Request for CheckInstalledPackagePermissions. The fields are those of the
+ * GetInstalledPackageUpdatePreviewRequest, so that a client can check the
+ * permissions required by exactly the creation or update that it is about
+ * to request.
+ */
+export interface CheckInstalledPackagePermissionsRequest {
+  /**
+   * A reference uniquely identifying the installed package to be created or
+   * updated. If no such installed package exists yet, the permissions are
+   * those required to create it, in which case the available_package_ref is
+   * required.
+   * Required
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * A reference uniquely identifying the package to be installed. Required
+   * when checking the creation of an installed package, otherwise the
+   * available package currently used by the installed package is assumed.
+   * Optional
+   */
+  availablePackageRef?: AvailablePackageReference;
+  /**
+   * The version (or version constraint) to be installed, with the same
+   * semantics as for CreateInstalledPackage and UpdateInstalledPackage.
+   * Optional
+   */
+  pkgVersionReference?: VersionReference;
+  /**
+   * An optional serialized values string to be used when rendering the
+   * package, with the same semantics as for UpdateInstalledPackage.
+   */
+  values: string;
 }
 
 /**
@@ -202,6 +324,60 @@ export interface GetAvailablePackageSummariesResponse {
    * This optional field contains the distinct category names considering the FilterOptions.
    */
   categories: string[];
+  /**
+   * Facets
+   *
+   * The number of available packages matching the FilterOptions for each
+   * category, repository and plugin. Only returned with the first page of
+   * results.
+   */
+  facets?: AvailablePackageFacets;
+}
+
+/**
+ * AvailablePackageFacets
+ *
+ * The number of available packages per category, repository and plugin.
+ */
+export interface AvailablePackageFacets {
+  /**
+   * Categories
+   *
+   * The number of available packages for each category.
+   */
+  categories: FacetCount[];
+  /**
+   * Repositories
+   *
+   * The number of available packages for each repository.
+   */
+  repositories: FacetCount[];
+  /**
+   * Plugins
+   *
+   * The number of available packages for each plugin, identified by its name.
+   */
+  plugins: FacetCount[];
+}
+
+/**
+ * FacetCount
+ *
+ * The number of available packages with a given facet value.
+ */
+export interface FacetCount {
+  /**
+   * Value
+   *
+   * The facet value, such as a category or repository name.
+   */
+  value: string;
+  /**
+   * Count
+   *
+   * The number of available packages with this value.
+   */
+  count: number;
 }
 
 /**
@@ -273,6 +449,75 @@ export interface GetInstalledPackageSummariesResponse {
   nextPageToken: string;
 }
 
+/**
+ * WatchInstalledPackageSummariesResponse
+ *
+ * A single event streamed for WatchInstalledPackageSummaries
+ */
+export interface WatchInstalledPackageSummariesResponse {
+  eventType: WatchInstalledPackageSummariesResponse_EventType;
+  /**
+   * Installed package summary
+   *
+   * The current summary of the installed package. For deleted packages, only
+   * the installed package reference and name are guaranteed to be set.
+   */
+  installedPackageSummary?: InstalledPackageSummary;
+}
+
+/**
+ * Event type
+ *
+ * The type of change to the installed package.
+ */
+export enum WatchInstalledPackageSummariesResponse_EventType {
+  EVENT_TYPE_UNSPECIFIED = 0,
+  EVENT_TYPE_ADDED = 1,
+  EVENT_TYPE_MODIFIED = 2,
+  EVENT_TYPE_DELETED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function watchInstalledPackageSummariesResponse_EventTypeFromJSON(
+  object: any,
+): WatchInstalledPackageSummariesResponse_EventType {
+  switch (object) {
+    case 0:
+    case "EVENT_TYPE_UNSPECIFIED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_UNSPECIFIED;
+    case 1:
+    case "EVENT_TYPE_ADDED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_ADDED;
+    case 2:
+    case "EVENT_TYPE_MODIFIED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_MODIFIED;
+    case 3:
+    case "EVENT_TYPE_DELETED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_DELETED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WatchInstalledPackageSummariesResponse_EventType.UNRECOGNIZED;
+  }
+}
+
+export function watchInstalledPackageSummariesResponse_EventTypeToJSON(
+  object: WatchInstalledPackageSummariesResponse_EventType,
+): string {
+  switch (object) {
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_UNSPECIFIED:
+      return "EVENT_TYPE_UNSPECIFIED";
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_ADDED:
+      return "EVENT_TYPE_ADDED";
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_MODIFIED:
+      return "EVENT_TYPE_MODIFIED";
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_DELETED:
+      return "EVENT_TYPE_DELETED";
+    default:
+      return "UNKNOWN";
+  }
+}
+
 /**
  * GetInstalledPackageDetailResponse
  *
@@ -305,6 +550,21 @@ export interface UpdateInstalledPackageResponse {
   installedPackageRef?: InstalledPackageReference;
 }
 
+/**
+ * GetInstalledPackageUpdatePreviewResponse
+ *
+ * Response for GetInstalledPackageUpdatePreview
+ */
+export interface GetInstalledPackageUpdatePreviewResponse {
+  /**
+   * Resource changes
+   *
+   * The resources which would be created, changed or deleted if the
+   * requested update was applied. Unchanged resources are not included.
+   */
+  resourceChanges: ResourceChange[];
+}
+
 /**
  * DeleteInstalledPackageResponse
  *
@@ -320,6 +580,54 @@ export interface DeleteInstalledPackageResponse {}
 export interface GetInstalledPackageResourceRefsResponse {
   context?: Context;
   resourceRefs: ResourceRef[];
+  /**
+   * Manifest
+   *
+   * The rendered yaml manifest of the resources of the installed package, only
+   * when requested with include_manifest and for plugins which know it, such
+   * as those deploying Helm releases. The kapp-controller plugin does not
+   * return it, as the manifest applied by kapp is not stored. Empty otherwise.
+   */
+  manifest: string;
+}
+
+/**
+ * GetInstalledPackageRevisionsResponse
+ *
+ * Response for GetInstalledPackageRevisions
+ */
+export interface GetInstalledPackageRevisionsResponse {
+  /**
+   * Revisions
+   *
+   * The revisions of the installed package, with the most recent first.
+   */
+  revisions: InstalledPackageRevision[];
+}
+
+/**
+ * RollbackInstalledPackageResponse
+ *
+ * Response for RollbackInstalledPackage
+ */
+export interface RollbackInstalledPackageResponse {
+  /** A reference to the installed package which was rolled back. */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * CheckInstalledPackagePermissionsResponse
+ *
+ * Response for CheckInstalledPackagePermissions
+ */
+export interface CheckInstalledPackagePermissionsResponse {
+  /**
+   * Forbidden actions
+   *
+   * The actions which the user is not allowed to perform. Empty when the
+   * user has all the permissions required.
+   */
+  forbiddenActions: ForbiddenAction[];
 }
 
 /**
@@ -373,6 +681,20 @@ export interface AvailablePackageSummary {
    * Plugins can choose not to implement this
    */
   categories: string[];
+  /**
+   * Latest version updated
+   *
+   * When the latest version of the package was released, if known. Used when
+   * sorting by the last updated packages.
+   */
+  latestVersionUpdated?: Date;
+  /**
+   * Repository name
+   *
+   * The name of the repository providing the package, if known. Used when
+   * sorting by repository.
+   */
+  repositoryName: string;
 }
 
 /**
@@ -751,15 +1073,125 @@ export interface FilterOptions {
   /**
    * Package version
    *
-   * Package version for the request
+   * Package version for the request, either an exact version or a semver
+   * constraint expression such as ">=1.2 <2" or "~5.4". Only the versions
+   * of each package matching it are considered, so the latest version of
+   * each summary is the latest matching version.
    */
   pkgVersion: string;
   /**
    * App version
    *
-   * Packaged app version for the request
+   * Packaged app version for the request, either an exact version or a
+   * semver constraint expression such as "<12", with the same semantics as
+   * the package version.
    */
   appVersion: string;
+  /**
+   * Sort by
+   *
+   * The order of the available package summaries. Only used when requesting
+   * available package summaries.
+   */
+  sortBy: FilterOptions_SortBy;
+}
+
+/**
+ * SortBy
+ *
+ * The order in which the available package summaries are returned.
+ */
+export enum FilterOptions_SortBy {
+  /** Sorted by package name, the default. */
+  SORT_BY_UNSPECIFIED = 0,
+  /** Sorted by package name. */
+  SORT_BY_NAME = 1,
+  /**
+   * Sorted by the date of the latest version, most recent first, then by
+   * package name.
+   */
+  SORT_BY_LAST_UPDATED = 2,
+  /** Sorted by repository name, then by package name. */
+  SORT_BY_REPOSITORY = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function filterOptions_SortByFromJSON(object: any): FilterOptions_SortBy {
+  switch (object) {
+    case 0:
+    case "SORT_BY_UNSPECIFIED":
+      return FilterOptions_SortBy.SORT_BY_UNSPECIFIED;
+    case 1:
+    case "SORT_BY_NAME":
+      return FilterOptions_SortBy.SORT_BY_NAME;
+    case 2:
+    case "SORT_BY_LAST_UPDATED":
+      return FilterOptions_SortBy.SORT_BY_LAST_UPDATED;
+    case 3:
+    case "SORT_BY_REPOSITORY":
+      return FilterOptions_SortBy.SORT_BY_REPOSITORY;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return FilterOptions_SortBy.UNRECOGNIZED;
+  }
+}
+
+export function filterOptions_SortByToJSON(object: FilterOptions_SortBy): string {
+  switch (object) {
+    case FilterOptions_SortBy.SORT_BY_UNSPECIFIED:
+      return "SORT_BY_UNSPECIFIED";
+    case FilterOptions_SortBy.SORT_BY_NAME:
+      return "SORT_BY_NAME";
+    case FilterOptions_SortBy.SORT_BY_LAST_UPDATED:
+      return "SORT_BY_LAST_UPDATED";
+    case FilterOptions_SortBy.SORT_BY_REPOSITORY:
+      return "SORT_BY_REPOSITORY";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * InstalledPackageFilterOptions
+ *
+ * InstalledPackageFilterOptions available when requesting summaries of the
+ * installed packages. All the specified filters must match for an installed
+ * package to be included.
+ */
+export interface InstalledPackageFilterOptions {
+  /**
+   * Text query
+   *
+   * Case-insensitive text matched against the name and the package display
+   * name of the installed package
+   */
+  query: string;
+  /**
+   * Status reasons
+   *
+   * Collection of status reasons, any of which the installed package must have
+   */
+  statusReasons: InstalledPackageStatus_StatusReason[];
+  /**
+   * Plugins
+   *
+   * Collection of plugin names, any of which must manage the installed package
+   */
+  plugins: string[];
+  /**
+   * Repositories
+   *
+   * Collection of repositories, any of which the installed package must be from
+   */
+  repositories: string[];
+  /**
+   * Upgrade available
+   *
+   * Only include the installed packages for which the latest version available
+   * is greater than the current version
+   */
+  upgradeAvailable: boolean;
 }
 
 /**
@@ -948,6 +1380,45 @@ export interface ReconciliationOptions {
   serviceAccountName: string;
 }
 
+/**
+ * InstalledPackageRevision
+ *
+ * An InstalledPackageRevision records a single revision in the history of an
+ * installed package.
+ */
+export interface InstalledPackageRevision {
+  /**
+   * Revision
+   *
+   * The number identifying the revision.
+   */
+  revision: number;
+  /**
+   * Version
+   *
+   * The version of the package installed by the revision.
+   */
+  version?: PackageAppVersion;
+  /**
+   * Status
+   *
+   * The status of the installed package as of the revision.
+   */
+  status?: InstalledPackageStatus;
+  /**
+   * Updated
+   *
+   * The time at which the revision was last updated.
+   */
+  updated?: Date;
+  /**
+   * ValuesApplied
+   *
+   * The values applied for the revision.
+   */
+  valuesApplied: string;
+}
+
 /**
  * Package AppVersion
  *
@@ -997,20 +1468,152 @@ export interface ResourceRef {
   namespace: string;
 }
 
-function createBaseGetAvailablePackageSummariesRequest(): GetAvailablePackageSummariesRequest {
-  return {
-    context: undefined,
-    filterOptions: undefined,
-    paginationOptions: undefined,
-  };
-}
-
-export const GetAvailablePackageSummariesRequest = {
-  encode(
-    message: GetAvailablePackageSummariesRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.context !== undefined) {
+/**
+ * ResourceChange
+ *
+ * A change to a single Kubernetes resource which would result from creating
+ * or updating an installed package.
+ */
+export interface ResourceChange {
+  /**
+   * Resource reference
+   *
+   * The reference to the resource which would be changed.
+   */
+  resourceRef?: ResourceRef;
+  /**
+   * Change type
+   *
+   * Whether the resource would be created, changed or deleted.
+   */
+  changeType: ResourceChange_ChangeType;
+  /**
+   * Current manifest
+   *
+   * The yaml manifest of the resource as currently released. Empty
+   * for resources which would be created.
+   */
+  currentManifest: string;
+  /**
+   * Target manifest
+   *
+   * The yaml manifest of the resource as it would be released. Empty
+   * for resources which would be deleted.
+   */
+  targetManifest: string;
+  /**
+   * Diff
+   *
+   * A unified diff between the current and target manifests.
+   */
+  diff: string;
+}
+
+/**
+ * ChangeType
+ *
+ * The type of change to the resource.
+ */
+export enum ResourceChange_ChangeType {
+  CHANGE_TYPE_UNSPECIFIED = 0,
+  CHANGE_TYPE_CREATED = 1,
+  CHANGE_TYPE_CHANGED = 2,
+  CHANGE_TYPE_DELETED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function resourceChange_ChangeTypeFromJSON(object: any): ResourceChange_ChangeType {
+  switch (object) {
+    case 0:
+    case "CHANGE_TYPE_UNSPECIFIED":
+      return ResourceChange_ChangeType.CHANGE_TYPE_UNSPECIFIED;
+    case 1:
+    case "CHANGE_TYPE_CREATED":
+      return ResourceChange_ChangeType.CHANGE_TYPE_CREATED;
+    case 2:
+    case "CHANGE_TYPE_CHANGED":
+      return ResourceChange_ChangeType.CHANGE_TYPE_CHANGED;
+    case 3:
+    case "CHANGE_TYPE_DELETED":
+      return ResourceChange_ChangeType.CHANGE_TYPE_DELETED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ResourceChange_ChangeType.UNRECOGNIZED;
+  }
+}
+
+export function resourceChange_ChangeTypeToJSON(object: ResourceChange_ChangeType): string {
+  switch (object) {
+    case ResourceChange_ChangeType.CHANGE_TYPE_UNSPECIFIED:
+      return "CHANGE_TYPE_UNSPECIFIED";
+    case ResourceChange_ChangeType.CHANGE_TYPE_CREATED:
+      return "CHANGE_TYPE_CREATED";
+    case ResourceChange_ChangeType.CHANGE_TYPE_CHANGED:
+      return "CHANGE_TYPE_CHANGED";
+    case ResourceChange_ChangeType.CHANGE_TYPE_DELETED:
+      return "CHANGE_TYPE_DELETED";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * ForbiddenAction
+ *
+ * A set of verbs which the user is not allowed to use on a type of resource
+ * in a namespace. For plugins which render the package, such as helm, these
+ * are the actions on the rendered resources. For plugins whose packages are
+ * reconciled by a controller, such as flux and kapp-controller, these are the
+ * actions on the custom resources created on behalf of the user.
+ */
+export interface ForbiddenAction {
+  /**
+   * API version
+   *
+   * The API group and version of the resource, such as "apps/v1".
+   */
+  apiVersion: string;
+  /**
+   * Resource
+   *
+   * The plural name of the resource, such as "deployments".
+   */
+  resource: string;
+  /**
+   * Namespace
+   *
+   * The namespace of the resources. Empty for cluster-wide resources.
+   */
+  namespace: string;
+  /**
+   * Cluster wide
+   *
+   * Whether the resource is cluster-wide rather than namespaced.
+   */
+  clusterWide: boolean;
+  /**
+   * Verbs
+   *
+   * The verbs which are forbidden, such as "create" or "update".
+   */
+  verbs: string[];
+}
+
+function createBaseGetAvailablePackageSummariesRequest(): GetAvailablePackageSummariesRequest {
+  return {
+    context: undefined,
+    filterOptions: undefined,
+    paginationOptions: undefined,
+  };
+}
+
+export const GetAvailablePackageSummariesRequest = {
+  encode(
+    message: GetAvailablePackageSummariesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.context !== undefined) {
       Context.encode(message.context, writer.uint32(10).fork()).ldelim();
     }
     if (message.filterOptions !== undefined) {
@@ -1242,7 +1845,11 @@ export const GetAvailablePackageVersionsRequest = {
 };
 
 function createBaseGetInstalledPackageSummariesRequest(): GetInstalledPackageSummariesRequest {
-  return { context: undefined, paginationOptions: undefined };
+  return {
+    context: undefined,
+    paginationOptions: undefined,
+    filterOptions: undefined,
+  };
 }
 
 export const GetInstalledPackageSummariesRequest = {
@@ -1256,6 +1863,12 @@ export const GetInstalledPackageSummariesRequest = {
     if (message.paginationOptions !== undefined) {
       PaginationOptions.encode(message.paginationOptions, writer.uint32(18).fork()).ldelim();
     }
+    if (message.filterOptions !== undefined) {
+      InstalledPackageFilterOptions.encode(
+        message.filterOptions,
+        writer.uint32(26).fork(),
+      ).ldelim();
+    }
     return writer;
   },
 
@@ -1272,6 +1885,9 @@ export const GetInstalledPackageSummariesRequest = {
         case 2:
           message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
           break;
+        case 3:
+          message.filterOptions = InstalledPackageFilterOptions.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1286,6 +1902,9 @@ export const GetInstalledPackageSummariesRequest = {
       paginationOptions: isSet(object.paginationOptions)
         ? PaginationOptions.fromJSON(object.paginationOptions)
         : undefined,
+      filterOptions: isSet(object.filterOptions)
+        ? InstalledPackageFilterOptions.fromJSON(object.filterOptions)
+        : undefined,
     };
   },
 
@@ -1297,6 +1916,10 @@ export const GetInstalledPackageSummariesRequest = {
       (obj.paginationOptions = message.paginationOptions
         ? PaginationOptions.toJSON(message.paginationOptions)
         : undefined);
+    message.filterOptions !== undefined &&
+      (obj.filterOptions = message.filterOptions
+        ? InstalledPackageFilterOptions.toJSON(message.filterOptions)
+        : undefined);
     return obj;
   },
 
@@ -1312,6 +1935,68 @@ export const GetInstalledPackageSummariesRequest = {
       object.paginationOptions !== undefined && object.paginationOptions !== null
         ? PaginationOptions.fromPartial(object.paginationOptions)
         : undefined;
+    message.filterOptions =
+      object.filterOptions !== undefined && object.filterOptions !== null
+        ? InstalledPackageFilterOptions.fromPartial(object.filterOptions)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseWatchInstalledPackageSummariesRequest(): WatchInstalledPackageSummariesRequest {
+  return { context: undefined };
+}
+
+export const WatchInstalledPackageSummariesRequest = {
+  encode(
+    message: WatchInstalledPackageSummariesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchInstalledPackageSummariesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchInstalledPackageSummariesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchInstalledPackageSummariesRequest {
+    return {
+      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
+    };
+  },
+
+  toJSON(message: WatchInstalledPackageSummariesRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchInstalledPackageSummariesRequest>, I>>(
+    object: I,
+  ): WatchInstalledPackageSummariesRequest {
+    const message = createBaseWatchInstalledPackageSummariesRequest();
+    message.context =
+      object.context !== undefined && object.context !== null
+        ? Context.fromPartial(object.context)
+        : undefined;
     return message;
   },
 };
@@ -1641,13 +2326,18 @@ export const UpdateInstalledPackageRequest = {
   },
 };
 
-function createBaseDeleteInstalledPackageRequest(): DeleteInstalledPackageRequest {
-  return { installedPackageRef: undefined };
+function createBaseGetInstalledPackageUpdatePreviewRequest(): GetInstalledPackageUpdatePreviewRequest {
+  return {
+    installedPackageRef: undefined,
+    availablePackageRef: undefined,
+    pkgVersionReference: undefined,
+    values: "",
+  };
 }
 
-export const DeleteInstalledPackageRequest = {
+export const GetInstalledPackageUpdatePreviewRequest = {
   encode(
-    message: DeleteInstalledPackageRequest,
+    message: GetInstalledPackageUpdatePreviewRequest,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
     if (message.installedPackageRef !== undefined) {
@@ -1656,19 +2346,40 @@ export const DeleteInstalledPackageRequest = {
         writer.uint32(10).fork(),
       ).ldelim();
     }
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.values !== "") {
+      writer.uint32(34).string(message.values);
+    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageUpdatePreviewRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeleteInstalledPackageRequest();
+    const message = createBaseGetInstalledPackageUpdatePreviewRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
           message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
+        case 2:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.values = reader.string();
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1677,42 +2388,67 @@ export const DeleteInstalledPackageRequest = {
     return message;
   },
 
-  fromJSON(object: any): DeleteInstalledPackageRequest {
+  fromJSON(object: any): GetInstalledPackageUpdatePreviewRequest {
     return {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
         : undefined,
+      availablePackageRef: isSet(object.availablePackageRef)
+        ? AvailablePackageReference.fromJSON(object.availablePackageRef)
+        : undefined,
+      pkgVersionReference: isSet(object.pkgVersionReference)
+        ? VersionReference.fromJSON(object.pkgVersionReference)
+        : undefined,
+      values: isSet(object.values) ? String(object.values) : "",
     };
   },
 
-  toJSON(message: DeleteInstalledPackageRequest): unknown {
+  toJSON(message: GetInstalledPackageUpdatePreviewRequest): unknown {
     const obj: any = {};
     message.installedPackageRef !== undefined &&
       (obj.installedPackageRef = message.installedPackageRef
         ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.values !== undefined && (obj.values = message.values);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<DeleteInstalledPackageRequest>, I>>(
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageUpdatePreviewRequest>, I>>(
     object: I,
-  ): DeleteInstalledPackageRequest {
-    const message = createBaseDeleteInstalledPackageRequest();
+  ): GetInstalledPackageUpdatePreviewRequest {
+    const message = createBaseGetInstalledPackageUpdatePreviewRequest();
     message.installedPackageRef =
       object.installedPackageRef !== undefined && object.installedPackageRef !== null
         ? InstalledPackageReference.fromPartial(object.installedPackageRef)
         : undefined;
+    message.availablePackageRef =
+      object.availablePackageRef !== undefined && object.availablePackageRef !== null
+        ? AvailablePackageReference.fromPartial(object.availablePackageRef)
+        : undefined;
+    message.pkgVersionReference =
+      object.pkgVersionReference !== undefined && object.pkgVersionReference !== null
+        ? VersionReference.fromPartial(object.pkgVersionReference)
+        : undefined;
+    message.values = object.values ?? "";
     return message;
   },
 };
 
-function createBaseGetInstalledPackageResourceRefsRequest(): GetInstalledPackageResourceRefsRequest {
+function createBaseDeleteInstalledPackageRequest(): DeleteInstalledPackageRequest {
   return { installedPackageRef: undefined };
 }
 
-export const GetInstalledPackageResourceRefsRequest = {
+export const DeleteInstalledPackageRequest = {
   encode(
-    message: GetInstalledPackageResourceRefsRequest,
+    message: DeleteInstalledPackageRequest,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
     if (message.installedPackageRef !== undefined) {
@@ -1724,10 +2460,10 @@ export const GetInstalledPackageResourceRefsRequest = {
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageResourceRefsRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetInstalledPackageResourceRefsRequest();
+    const message = createBaseDeleteInstalledPackageRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1742,7 +2478,7 @@ export const GetInstalledPackageResourceRefsRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetInstalledPackageResourceRefsRequest {
+  fromJSON(object: any): DeleteInstalledPackageRequest {
     return {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
@@ -1750,7 +2486,7 @@ export const GetInstalledPackageResourceRefsRequest = {
     };
   },
 
-  toJSON(message: GetInstalledPackageResourceRefsRequest): unknown {
+  toJSON(message: DeleteInstalledPackageRequest): unknown {
     const obj: any = {};
     message.installedPackageRef !== undefined &&
       (obj.installedPackageRef = message.installedPackageRef
@@ -1759,10 +2495,10 @@ export const GetInstalledPackageResourceRefsRequest = {
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageResourceRefsRequest>, I>>(
+  fromPartial<I extends Exact<DeepPartial<DeleteInstalledPackageRequest>, I>>(
     object: I,
-  ): GetInstalledPackageResourceRefsRequest {
-    const message = createBaseGetInstalledPackageResourceRefsRequest();
+  ): DeleteInstalledPackageRequest {
+    const message = createBaseDeleteInstalledPackageRequest();
     message.installedPackageRef =
       object.installedPackageRef !== undefined && object.installedPackageRef !== null
         ? InstalledPackageReference.fromPartial(object.installedPackageRef)
@@ -1771,44 +2507,384 @@ export const GetInstalledPackageResourceRefsRequest = {
   },
 };
 
-function createBaseGetAvailablePackageSummariesResponse(): GetAvailablePackageSummariesResponse {
-  return { availablePackageSummaries: [], nextPageToken: "", categories: [] };
+function createBaseGetInstalledPackageResourceRefsRequest(): GetInstalledPackageResourceRefsRequest {
+  return { installedPackageRef: undefined, includeManifest: false };
 }
 
-export const GetAvailablePackageSummariesResponse = {
+export const GetInstalledPackageResourceRefsRequest = {
   encode(
-    message: GetAvailablePackageSummariesResponse,
+    message: GetInstalledPackageResourceRefsRequest,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    for (const v of message.availablePackageSummaries) {
-      AvailablePackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
     }
-    for (const v of message.categories) {
-      writer.uint32(26).string(v!);
+    if (message.includeManifest === true) {
+      writer.uint32(16).bool(message.includeManifest);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageResourceRefsRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetAvailablePackageSummariesResponse();
+    const message = createBaseGetInstalledPackageResourceRefsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageSummaries.push(
-            AvailablePackageSummary.decode(reader, reader.uint32()),
-          );
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.nextPageToken = reader.string();
-          break;
-        case 3:
-          message.categories.push(reader.string());
+          message.includeManifest = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageResourceRefsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      includeManifest: isSet(object.includeManifest) ? Boolean(object.includeManifest) : false,
+    };
+  },
+
+  toJSON(message: GetInstalledPackageResourceRefsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.includeManifest !== undefined && (obj.includeManifest = message.includeManifest);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageResourceRefsRequest>, I>>(
+    object: I,
+  ): GetInstalledPackageResourceRefsRequest {
+    const message = createBaseGetInstalledPackageResourceRefsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.includeManifest = object.includeManifest ?? false;
+    return message;
+  },
+};
+
+function createBaseGetInstalledPackageRevisionsRequest(): GetInstalledPackageRevisionsRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const GetInstalledPackageRevisionsRequest = {
+  encode(
+    message: GetInstalledPackageRevisionsRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageRevisionsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageRevisionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageRevisionsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetInstalledPackageRevisionsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageRevisionsRequest>, I>>(
+    object: I,
+  ): GetInstalledPackageRevisionsRequest {
+    const message = createBaseGetInstalledPackageRevisionsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseRollbackInstalledPackageRequest(): RollbackInstalledPackageRequest {
+  return { installedPackageRef: undefined, revision: 0 };
+}
+
+export const RollbackInstalledPackageRequest = {
+  encode(
+    message: RollbackInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.revision !== 0) {
+      writer.uint32(16).int32(message.revision);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RollbackInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRollbackInstalledPackageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.revision = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RollbackInstalledPackageRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      revision: isSet(object.revision) ? Number(object.revision) : 0,
+    };
+  },
+
+  toJSON(message: RollbackInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.revision !== undefined && (obj.revision = Math.round(message.revision));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RollbackInstalledPackageRequest>, I>>(
+    object: I,
+  ): RollbackInstalledPackageRequest {
+    const message = createBaseRollbackInstalledPackageRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.revision = object.revision ?? 0;
+    return message;
+  },
+};
+
+function createBaseCheckInstalledPackagePermissionsRequest(): CheckInstalledPackagePermissionsRequest {
+  return {
+    installedPackageRef: undefined,
+    availablePackageRef: undefined,
+    pkgVersionReference: undefined,
+    values: "",
+  };
+}
+
+export const CheckInstalledPackagePermissionsRequest = {
+  encode(
+    message: CheckInstalledPackagePermissionsRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersionReference !== undefined) {
+      VersionReference.encode(message.pkgVersionReference, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.values !== "") {
+      writer.uint32(34).string(message.values);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CheckInstalledPackagePermissionsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCheckInstalledPackagePermissionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.pkgVersionReference = VersionReference.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.values = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CheckInstalledPackagePermissionsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      availablePackageRef: isSet(object.availablePackageRef)
+        ? AvailablePackageReference.fromJSON(object.availablePackageRef)
+        : undefined,
+      pkgVersionReference: isSet(object.pkgVersionReference)
+        ? VersionReference.fromJSON(object.pkgVersionReference)
+        : undefined,
+      values: isSet(object.values) ? String(object.values) : "",
+    };
+  },
+
+  toJSON(message: CheckInstalledPackagePermissionsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersionReference !== undefined &&
+      (obj.pkgVersionReference = message.pkgVersionReference
+        ? VersionReference.toJSON(message.pkgVersionReference)
+        : undefined);
+    message.values !== undefined && (obj.values = message.values);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CheckInstalledPackagePermissionsRequest>, I>>(
+    object: I,
+  ): CheckInstalledPackagePermissionsRequest {
+    const message = createBaseCheckInstalledPackagePermissionsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.availablePackageRef =
+      object.availablePackageRef !== undefined && object.availablePackageRef !== null
+        ? AvailablePackageReference.fromPartial(object.availablePackageRef)
+        : undefined;
+    message.pkgVersionReference =
+      object.pkgVersionReference !== undefined && object.pkgVersionReference !== null
+        ? VersionReference.fromPartial(object.pkgVersionReference)
+        : undefined;
+    message.values = object.values ?? "";
+    return message;
+  },
+};
+
+function createBaseGetAvailablePackageSummariesResponse(): GetAvailablePackageSummariesResponse {
+  return {
+    availablePackageSummaries: [],
+    nextPageToken: "",
+    categories: [],
+    facets: undefined,
+  };
+}
+
+export const GetAvailablePackageSummariesResponse = {
+  encode(
+    message: GetAvailablePackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.availablePackageSummaries) {
+      AvailablePackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    for (const v of message.categories) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.facets !== undefined) {
+      AvailablePackageFacets.encode(message.facets, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetAvailablePackageSummariesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageSummaries.push(
+            AvailablePackageSummary.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.nextPageToken = reader.string();
+          break;
+        case 3:
+          message.categories.push(reader.string());
+          break;
+        case 4:
+          message.facets = AvailablePackageFacets.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1820,73 +2896,569 @@ export const GetAvailablePackageSummariesResponse = {
 
   fromJSON(object: any): GetAvailablePackageSummariesResponse {
     return {
-      availablePackageSummaries: Array.isArray(object?.availablePackageSummaries)
-        ? object.availablePackageSummaries.map((e: any) => AvailablePackageSummary.fromJSON(e))
-        : [],
-      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
-      categories: Array.isArray(object?.categories)
-        ? object.categories.map((e: any) => String(e))
-        : [],
+      availablePackageSummaries: Array.isArray(object?.availablePackageSummaries)
+        ? object.availablePackageSummaries.map((e: any) => AvailablePackageSummary.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+      categories: Array.isArray(object?.categories)
+        ? object.categories.map((e: any) => String(e))
+        : [],
+      facets: isSet(object.facets) ? AvailablePackageFacets.fromJSON(object.facets) : undefined,
+    };
+  },
+
+  toJSON(message: GetAvailablePackageSummariesResponse): unknown {
+    const obj: any = {};
+    if (message.availablePackageSummaries) {
+      obj.availablePackageSummaries = message.availablePackageSummaries.map(e =>
+        e ? AvailablePackageSummary.toJSON(e) : undefined,
+      );
+    } else {
+      obj.availablePackageSummaries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    message.facets !== undefined &&
+      (obj.facets = message.facets ? AvailablePackageFacets.toJSON(message.facets) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageSummariesResponse>, I>>(
+    object: I,
+  ): GetAvailablePackageSummariesResponse {
+    const message = createBaseGetAvailablePackageSummariesResponse();
+    message.availablePackageSummaries =
+      object.availablePackageSummaries?.map(e => AvailablePackageSummary.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    message.categories = object.categories?.map(e => e) || [];
+    message.facets =
+      object.facets !== undefined && object.facets !== null
+        ? AvailablePackageFacets.fromPartial(object.facets)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseAvailablePackageFacets(): AvailablePackageFacets {
+  return { categories: [], repositories: [], plugins: [] };
+}
+
+export const AvailablePackageFacets = {
+  encode(message: AvailablePackageFacets, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.categories) {
+      FacetCount.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.repositories) {
+      FacetCount.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.plugins) {
+      FacetCount.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AvailablePackageFacets {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAvailablePackageFacets();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.categories.push(FacetCount.decode(reader, reader.uint32()));
+          break;
+        case 2:
+          message.repositories.push(FacetCount.decode(reader, reader.uint32()));
+          break;
+        case 3:
+          message.plugins.push(FacetCount.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AvailablePackageFacets {
+    return {
+      categories: Array.isArray(object?.categories)
+        ? object.categories.map((e: any) => FacetCount.fromJSON(e))
+        : [],
+      repositories: Array.isArray(object?.repositories)
+        ? object.repositories.map((e: any) => FacetCount.fromJSON(e))
+        : [],
+      plugins: Array.isArray(object?.plugins)
+        ? object.plugins.map((e: any) => FacetCount.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: AvailablePackageFacets): unknown {
+    const obj: any = {};
+    if (message.categories) {
+      obj.categories = message.categories.map(e => (e ? FacetCount.toJSON(e) : undefined));
+    } else {
+      obj.categories = [];
+    }
+    if (message.repositories) {
+      obj.repositories = message.repositories.map(e => (e ? FacetCount.toJSON(e) : undefined));
+    } else {
+      obj.repositories = [];
+    }
+    if (message.plugins) {
+      obj.plugins = message.plugins.map(e => (e ? FacetCount.toJSON(e) : undefined));
+    } else {
+      obj.plugins = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AvailablePackageFacets>, I>>(
+    object: I,
+  ): AvailablePackageFacets {
+    const message = createBaseAvailablePackageFacets();
+    message.categories = object.categories?.map(e => FacetCount.fromPartial(e)) || [];
+    message.repositories = object.repositories?.map(e => FacetCount.fromPartial(e)) || [];
+    message.plugins = object.plugins?.map(e => FacetCount.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseFacetCount(): FacetCount {
+  return { value: "", count: 0 };
+}
+
+export const FacetCount = {
+  encode(message: FacetCount, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.value !== "") {
+      writer.uint32(10).string(message.value);
+    }
+    if (message.count !== 0) {
+      writer.uint32(16).int32(message.count);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FacetCount {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFacetCount();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.value = reader.string();
+          break;
+        case 2:
+          message.count = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FacetCount {
+    return {
+      value: isSet(object.value) ? String(object.value) : "",
+      count: isSet(object.count) ? Number(object.count) : 0,
+    };
+  },
+
+  toJSON(message: FacetCount): unknown {
+    const obj: any = {};
+    message.value !== undefined && (obj.value = message.value);
+    message.count !== undefined && (obj.count = Math.round(message.count));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<FacetCount>, I>>(object: I): FacetCount {
+    const message = createBaseFacetCount();
+    message.value = object.value ?? "";
+    message.count = object.count ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetAvailablePackageDetailResponse(): GetAvailablePackageDetailResponse {
+  return { availablePackageDetail: undefined };
+}
+
+export const GetAvailablePackageDetailResponse = {
+  encode(
+    message: GetAvailablePackageDetailResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.availablePackageDetail !== undefined) {
+      AvailablePackageDetail.encode(
+        message.availablePackageDetail,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDetailResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetAvailablePackageDetailResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageDetail = AvailablePackageDetail.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageDetailResponse {
+    return {
+      availablePackageDetail: isSet(object.availablePackageDetail)
+        ? AvailablePackageDetail.fromJSON(object.availablePackageDetail)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetAvailablePackageDetailResponse): unknown {
+    const obj: any = {};
+    message.availablePackageDetail !== undefined &&
+      (obj.availablePackageDetail = message.availablePackageDetail
+        ? AvailablePackageDetail.toJSON(message.availablePackageDetail)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageDetailResponse>, I>>(
+    object: I,
+  ): GetAvailablePackageDetailResponse {
+    const message = createBaseGetAvailablePackageDetailResponse();
+    message.availablePackageDetail =
+      object.availablePackageDetail !== undefined && object.availablePackageDetail !== null
+        ? AvailablePackageDetail.fromPartial(object.availablePackageDetail)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetAvailablePackageVersionsResponse(): GetAvailablePackageVersionsResponse {
+  return { packageAppVersions: [] };
+}
+
+export const GetAvailablePackageVersionsResponse = {
+  encode(
+    message: GetAvailablePackageVersionsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.packageAppVersions) {
+      PackageAppVersion.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageVersionsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetAvailablePackageVersionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageAppVersions.push(PackageAppVersion.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageVersionsResponse {
+    return {
+      packageAppVersions: Array.isArray(object?.packageAppVersions)
+        ? object.packageAppVersions.map((e: any) => PackageAppVersion.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GetAvailablePackageVersionsResponse): unknown {
+    const obj: any = {};
+    if (message.packageAppVersions) {
+      obj.packageAppVersions = message.packageAppVersions.map(e =>
+        e ? PackageAppVersion.toJSON(e) : undefined,
+      );
+    } else {
+      obj.packageAppVersions = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageVersionsResponse>, I>>(
+    object: I,
+  ): GetAvailablePackageVersionsResponse {
+    const message = createBaseGetAvailablePackageVersionsResponse();
+    message.packageAppVersions =
+      object.packageAppVersions?.map(e => PackageAppVersion.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetInstalledPackageSummariesResponse(): GetInstalledPackageSummariesResponse {
+  return { installedPackageSummaries: [], nextPageToken: "" };
+}
+
+export const GetInstalledPackageSummariesResponse = {
+  encode(
+    message: GetInstalledPackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.installedPackageSummaries) {
+      InstalledPackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageSummariesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageSummaries.push(
+            InstalledPackageSummary.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.nextPageToken = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageSummariesResponse {
+    return {
+      installedPackageSummaries: Array.isArray(object?.installedPackageSummaries)
+        ? object.installedPackageSummaries.map((e: any) => InstalledPackageSummary.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: GetInstalledPackageSummariesResponse): unknown {
+    const obj: any = {};
+    if (message.installedPackageSummaries) {
+      obj.installedPackageSummaries = message.installedPackageSummaries.map(e =>
+        e ? InstalledPackageSummary.toJSON(e) : undefined,
+      );
+    } else {
+      obj.installedPackageSummaries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageSummariesResponse>, I>>(
+    object: I,
+  ): GetInstalledPackageSummariesResponse {
+    const message = createBaseGetInstalledPackageSummariesResponse();
+    message.installedPackageSummaries =
+      object.installedPackageSummaries?.map(e => InstalledPackageSummary.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseWatchInstalledPackageSummariesResponse(): WatchInstalledPackageSummariesResponse {
+  return { eventType: 0, installedPackageSummary: undefined };
+}
+
+export const WatchInstalledPackageSummariesResponse = {
+  encode(
+    message: WatchInstalledPackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.eventType !== 0) {
+      writer.uint32(8).int32(message.eventType);
+    }
+    if (message.installedPackageSummary !== undefined) {
+      InstalledPackageSummary.encode(
+        message.installedPackageSummary,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchInstalledPackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchInstalledPackageSummariesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.eventType = reader.int32() as any;
+          break;
+        case 2:
+          message.installedPackageSummary = InstalledPackageSummary.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchInstalledPackageSummariesResponse {
+    return {
+      eventType: isSet(object.eventType)
+        ? watchInstalledPackageSummariesResponse_EventTypeFromJSON(object.eventType)
+        : 0,
+      installedPackageSummary: isSet(object.installedPackageSummary)
+        ? InstalledPackageSummary.fromJSON(object.installedPackageSummary)
+        : undefined,
+    };
+  },
+
+  toJSON(message: WatchInstalledPackageSummariesResponse): unknown {
+    const obj: any = {};
+    message.eventType !== undefined &&
+      (obj.eventType = watchInstalledPackageSummariesResponse_EventTypeToJSON(message.eventType));
+    message.installedPackageSummary !== undefined &&
+      (obj.installedPackageSummary = message.installedPackageSummary
+        ? InstalledPackageSummary.toJSON(message.installedPackageSummary)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchInstalledPackageSummariesResponse>, I>>(
+    object: I,
+  ): WatchInstalledPackageSummariesResponse {
+    const message = createBaseWatchInstalledPackageSummariesResponse();
+    message.eventType = object.eventType ?? 0;
+    message.installedPackageSummary =
+      object.installedPackageSummary !== undefined && object.installedPackageSummary !== null
+        ? InstalledPackageSummary.fromPartial(object.installedPackageSummary)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetInstalledPackageDetailResponse(): GetInstalledPackageDetailResponse {
+  return { installedPackageDetail: undefined };
+}
+
+export const GetInstalledPackageDetailResponse = {
+  encode(
+    message: GetInstalledPackageDetailResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageDetail !== undefined) {
+      InstalledPackageDetail.encode(
+        message.installedPackageDetail,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageDetailResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageDetail = InstalledPackageDetail.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageDetailResponse {
+    return {
+      installedPackageDetail: isSet(object.installedPackageDetail)
+        ? InstalledPackageDetail.fromJSON(object.installedPackageDetail)
+        : undefined,
     };
   },
 
-  toJSON(message: GetAvailablePackageSummariesResponse): unknown {
+  toJSON(message: GetInstalledPackageDetailResponse): unknown {
     const obj: any = {};
-    if (message.availablePackageSummaries) {
-      obj.availablePackageSummaries = message.availablePackageSummaries.map(e =>
-        e ? AvailablePackageSummary.toJSON(e) : undefined,
-      );
-    } else {
-      obj.availablePackageSummaries = [];
-    }
-    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
-    } else {
-      obj.categories = [];
-    }
+    message.installedPackageDetail !== undefined &&
+      (obj.installedPackageDetail = message.installedPackageDetail
+        ? InstalledPackageDetail.toJSON(message.installedPackageDetail)
+        : undefined);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageSummariesResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageDetailResponse>, I>>(
     object: I,
-  ): GetAvailablePackageSummariesResponse {
-    const message = createBaseGetAvailablePackageSummariesResponse();
-    message.availablePackageSummaries =
-      object.availablePackageSummaries?.map(e => AvailablePackageSummary.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
-    message.categories = object.categories?.map(e => e) || [];
+  ): GetInstalledPackageDetailResponse {
+    const message = createBaseGetInstalledPackageDetailResponse();
+    message.installedPackageDetail =
+      object.installedPackageDetail !== undefined && object.installedPackageDetail !== null
+        ? InstalledPackageDetail.fromPartial(object.installedPackageDetail)
+        : undefined;
     return message;
   },
 };
 
-function createBaseGetAvailablePackageDetailResponse(): GetAvailablePackageDetailResponse {
-  return { availablePackageDetail: undefined };
+function createBaseCreateInstalledPackageResponse(): CreateInstalledPackageResponse {
+  return { installedPackageRef: undefined };
 }
 
-export const GetAvailablePackageDetailResponse = {
+export const CreateInstalledPackageResponse = {
   encode(
-    message: GetAvailablePackageDetailResponse,
+    message: CreateInstalledPackageResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    if (message.availablePackageDetail !== undefined) {
-      AvailablePackageDetail.encode(
-        message.availablePackageDetail,
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
         writer.uint32(10).fork(),
       ).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageDetailResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateInstalledPackageResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetAvailablePackageDetailResponse();
+    const message = createBaseCreateInstalledPackageResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageDetail = AvailablePackageDetail.decode(reader, reader.uint32());
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1896,59 +3468,62 @@ export const GetAvailablePackageDetailResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageDetailResponse {
+  fromJSON(object: any): CreateInstalledPackageResponse {
     return {
-      availablePackageDetail: isSet(object.availablePackageDetail)
-        ? AvailablePackageDetail.fromJSON(object.availablePackageDetail)
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
         : undefined,
     };
   },
 
-  toJSON(message: GetAvailablePackageDetailResponse): unknown {
+  toJSON(message: CreateInstalledPackageResponse): unknown {
     const obj: any = {};
-    message.availablePackageDetail !== undefined &&
-      (obj.availablePackageDetail = message.availablePackageDetail
-        ? AvailablePackageDetail.toJSON(message.availablePackageDetail)
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageDetailResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<CreateInstalledPackageResponse>, I>>(
     object: I,
-  ): GetAvailablePackageDetailResponse {
-    const message = createBaseGetAvailablePackageDetailResponse();
-    message.availablePackageDetail =
-      object.availablePackageDetail !== undefined && object.availablePackageDetail !== null
-        ? AvailablePackageDetail.fromPartial(object.availablePackageDetail)
+  ): CreateInstalledPackageResponse {
+    const message = createBaseCreateInstalledPackageResponse();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
         : undefined;
     return message;
   },
 };
 
-function createBaseGetAvailablePackageVersionsResponse(): GetAvailablePackageVersionsResponse {
-  return { packageAppVersions: [] };
+function createBaseUpdateInstalledPackageResponse(): UpdateInstalledPackageResponse {
+  return { installedPackageRef: undefined };
 }
 
-export const GetAvailablePackageVersionsResponse = {
+export const UpdateInstalledPackageResponse = {
   encode(
-    message: GetAvailablePackageVersionsResponse,
+    message: UpdateInstalledPackageResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    for (const v of message.packageAppVersions) {
-      PackageAppVersion.encode(v!, writer.uint32(10).fork()).ldelim();
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageVersionsResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateInstalledPackageResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetAvailablePackageVersionsResponse();
+    const message = createBaseUpdateInstalledPackageResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.packageAppVersions.push(PackageAppVersion.decode(reader, reader.uint32()));
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1958,68 +3533,62 @@ export const GetAvailablePackageVersionsResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageVersionsResponse {
+  fromJSON(object: any): UpdateInstalledPackageResponse {
     return {
-      packageAppVersions: Array.isArray(object?.packageAppVersions)
-        ? object.packageAppVersions.map((e: any) => PackageAppVersion.fromJSON(e))
-        : [],
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
     };
   },
 
-  toJSON(message: GetAvailablePackageVersionsResponse): unknown {
+  toJSON(message: UpdateInstalledPackageResponse): unknown {
     const obj: any = {};
-    if (message.packageAppVersions) {
-      obj.packageAppVersions = message.packageAppVersions.map(e =>
-        e ? PackageAppVersion.toJSON(e) : undefined,
-      );
-    } else {
-      obj.packageAppVersions = [];
-    }
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageVersionsResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<UpdateInstalledPackageResponse>, I>>(
     object: I,
-  ): GetAvailablePackageVersionsResponse {
-    const message = createBaseGetAvailablePackageVersionsResponse();
-    message.packageAppVersions =
-      object.packageAppVersions?.map(e => PackageAppVersion.fromPartial(e)) || [];
+  ): UpdateInstalledPackageResponse {
+    const message = createBaseUpdateInstalledPackageResponse();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
     return message;
   },
 };
 
-function createBaseGetInstalledPackageSummariesResponse(): GetInstalledPackageSummariesResponse {
-  return { installedPackageSummaries: [], nextPageToken: "" };
+function createBaseGetInstalledPackageUpdatePreviewResponse(): GetInstalledPackageUpdatePreviewResponse {
+  return { resourceChanges: [] };
 }
 
-export const GetInstalledPackageSummariesResponse = {
+export const GetInstalledPackageUpdatePreviewResponse = {
   encode(
-    message: GetInstalledPackageSummariesResponse,
+    message: GetInstalledPackageUpdatePreviewResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    for (const v of message.installedPackageSummaries) {
-      InstalledPackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+    for (const v of message.resourceChanges) {
+      ResourceChange.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageSummariesResponse {
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): GetInstalledPackageUpdatePreviewResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetInstalledPackageSummariesResponse();
+    const message = createBaseGetInstalledPackageUpdatePreviewResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageSummaries.push(
-            InstalledPackageSummary.decode(reader, reader.uint32()),
-          );
-          break;
-        case 2:
-          message.nextPageToken = reader.string();
+          message.resourceChanges.push(ResourceChange.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -2029,66 +3598,112 @@ export const GetInstalledPackageSummariesResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetInstalledPackageSummariesResponse {
+  fromJSON(object: any): GetInstalledPackageUpdatePreviewResponse {
     return {
-      installedPackageSummaries: Array.isArray(object?.installedPackageSummaries)
-        ? object.installedPackageSummaries.map((e: any) => InstalledPackageSummary.fromJSON(e))
+      resourceChanges: Array.isArray(object?.resourceChanges)
+        ? object.resourceChanges.map((e: any) => ResourceChange.fromJSON(e))
         : [],
-      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
     };
   },
 
-  toJSON(message: GetInstalledPackageSummariesResponse): unknown {
+  toJSON(message: GetInstalledPackageUpdatePreviewResponse): unknown {
     const obj: any = {};
-    if (message.installedPackageSummaries) {
-      obj.installedPackageSummaries = message.installedPackageSummaries.map(e =>
-        e ? InstalledPackageSummary.toJSON(e) : undefined,
+    if (message.resourceChanges) {
+      obj.resourceChanges = message.resourceChanges.map(e =>
+        e ? ResourceChange.toJSON(e) : undefined,
       );
     } else {
-      obj.installedPackageSummaries = [];
+      obj.resourceChanges = [];
     }
-    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageSummariesResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageUpdatePreviewResponse>, I>>(
     object: I,
-  ): GetInstalledPackageSummariesResponse {
-    const message = createBaseGetInstalledPackageSummariesResponse();
-    message.installedPackageSummaries =
-      object.installedPackageSummaries?.map(e => InstalledPackageSummary.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
+  ): GetInstalledPackageUpdatePreviewResponse {
+    const message = createBaseGetInstalledPackageUpdatePreviewResponse();
+    message.resourceChanges = object.resourceChanges?.map(e => ResourceChange.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseGetInstalledPackageDetailResponse(): GetInstalledPackageDetailResponse {
-  return { installedPackageDetail: undefined };
+function createBaseDeleteInstalledPackageResponse(): DeleteInstalledPackageResponse {
+  return {};
 }
 
-export const GetInstalledPackageDetailResponse = {
+export const DeleteInstalledPackageResponse = {
+  encode(_: DeleteInstalledPackageResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteInstalledPackageResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(_: any): DeleteInstalledPackageResponse {
+    return {};
+  },
+
+  toJSON(_: DeleteInstalledPackageResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<DeleteInstalledPackageResponse>, I>>(
+    _: I,
+  ): DeleteInstalledPackageResponse {
+    const message = createBaseDeleteInstalledPackageResponse();
+    return message;
+  },
+};
+
+function createBaseGetInstalledPackageResourceRefsResponse(): GetInstalledPackageResourceRefsResponse {
+  return { context: undefined, resourceRefs: [], manifest: "" };
+}
+
+export const GetInstalledPackageResourceRefsResponse = {
   encode(
-    message: GetInstalledPackageDetailResponse,
+    message: GetInstalledPackageResourceRefsResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    if (message.installedPackageDetail !== undefined) {
-      InstalledPackageDetail.encode(
-        message.installedPackageDetail,
-        writer.uint32(10).fork(),
-      ).ldelim();
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.resourceRefs) {
+      ResourceRef.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.manifest !== "") {
+      writer.uint32(26).string(message.manifest);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageDetailResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageResourceRefsResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetInstalledPackageDetailResponse();
+    const message = createBaseGetInstalledPackageResourceRefsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageDetail = InstalledPackageDetail.decode(reader, reader.uint32());
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.resourceRefs.push(ResourceRef.decode(reader, reader.uint32()));
+          break;
+        case 3:
+          message.manifest = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -2098,62 +3713,67 @@ export const GetInstalledPackageDetailResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetInstalledPackageDetailResponse {
-    return {
-      installedPackageDetail: isSet(object.installedPackageDetail)
-        ? InstalledPackageDetail.fromJSON(object.installedPackageDetail)
-        : undefined,
+  fromJSON(object: any): GetInstalledPackageResourceRefsResponse {
+    return {
+      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
+      resourceRefs: Array.isArray(object?.resourceRefs)
+        ? object.resourceRefs.map((e: any) => ResourceRef.fromJSON(e))
+        : [],
+      manifest: isSet(object.manifest) ? String(object.manifest) : "",
     };
   },
 
-  toJSON(message: GetInstalledPackageDetailResponse): unknown {
+  toJSON(message: GetInstalledPackageResourceRefsResponse): unknown {
     const obj: any = {};
-    message.installedPackageDetail !== undefined &&
-      (obj.installedPackageDetail = message.installedPackageDetail
-        ? InstalledPackageDetail.toJSON(message.installedPackageDetail)
-        : undefined);
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+    if (message.resourceRefs) {
+      obj.resourceRefs = message.resourceRefs.map(e => (e ? ResourceRef.toJSON(e) : undefined));
+    } else {
+      obj.resourceRefs = [];
+    }
+    message.manifest !== undefined && (obj.manifest = message.manifest);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageDetailResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageResourceRefsResponse>, I>>(
     object: I,
-  ): GetInstalledPackageDetailResponse {
-    const message = createBaseGetInstalledPackageDetailResponse();
-    message.installedPackageDetail =
-      object.installedPackageDetail !== undefined && object.installedPackageDetail !== null
-        ? InstalledPackageDetail.fromPartial(object.installedPackageDetail)
+  ): GetInstalledPackageResourceRefsResponse {
+    const message = createBaseGetInstalledPackageResourceRefsResponse();
+    message.context =
+      object.context !== undefined && object.context !== null
+        ? Context.fromPartial(object.context)
         : undefined;
+    message.resourceRefs = object.resourceRefs?.map(e => ResourceRef.fromPartial(e)) || [];
+    message.manifest = object.manifest ?? "";
     return message;
   },
 };
 
-function createBaseCreateInstalledPackageResponse(): CreateInstalledPackageResponse {
-  return { installedPackageRef: undefined };
+function createBaseGetInstalledPackageRevisionsResponse(): GetInstalledPackageRevisionsResponse {
+  return { revisions: [] };
 }
 
-export const CreateInstalledPackageResponse = {
+export const GetInstalledPackageRevisionsResponse = {
   encode(
-    message: CreateInstalledPackageResponse,
+    message: GetInstalledPackageRevisionsResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    if (message.installedPackageRef !== undefined) {
-      InstalledPackageReference.encode(
-        message.installedPackageRef,
-        writer.uint32(10).fork(),
-      ).ldelim();
+    for (const v of message.revisions) {
+      InstalledPackageRevision.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CreateInstalledPackageResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageRevisionsResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateInstalledPackageResponse();
+    const message = createBaseGetInstalledPackageRevisionsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          message.revisions.push(InstalledPackageRevision.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -2163,42 +3783,42 @@ export const CreateInstalledPackageResponse = {
     return message;
   },
 
-  fromJSON(object: any): CreateInstalledPackageResponse {
+  fromJSON(object: any): GetInstalledPackageRevisionsResponse {
     return {
-      installedPackageRef: isSet(object.installedPackageRef)
-        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
-        : undefined,
+      revisions: Array.isArray(object?.revisions)
+        ? object.revisions.map((e: any) => InstalledPackageRevision.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: CreateInstalledPackageResponse): unknown {
+  toJSON(message: GetInstalledPackageRevisionsResponse): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
+    if (message.revisions) {
+      obj.revisions = message.revisions.map(e =>
+        e ? InstalledPackageRevision.toJSON(e) : undefined,
+      );
+    } else {
+      obj.revisions = [];
+    }
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<CreateInstalledPackageResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageRevisionsResponse>, I>>(
     object: I,
-  ): CreateInstalledPackageResponse {
-    const message = createBaseCreateInstalledPackageResponse();
-    message.installedPackageRef =
-      object.installedPackageRef !== undefined && object.installedPackageRef !== null
-        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
-        : undefined;
+  ): GetInstalledPackageRevisionsResponse {
+    const message = createBaseGetInstalledPackageRevisionsResponse();
+    message.revisions = object.revisions?.map(e => InstalledPackageRevision.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseUpdateInstalledPackageResponse(): UpdateInstalledPackageResponse {
+function createBaseRollbackInstalledPackageResponse(): RollbackInstalledPackageResponse {
   return { installedPackageRef: undefined };
 }
 
-export const UpdateInstalledPackageResponse = {
+export const RollbackInstalledPackageResponse = {
   encode(
-    message: UpdateInstalledPackageResponse,
+    message: RollbackInstalledPackageResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
     if (message.installedPackageRef !== undefined) {
@@ -2210,10 +3830,10 @@ export const UpdateInstalledPackageResponse = {
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateInstalledPackageResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): RollbackInstalledPackageResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateInstalledPackageResponse();
+    const message = createBaseRollbackInstalledPackageResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2228,7 +3848,7 @@ export const UpdateInstalledPackageResponse = {
     return message;
   },
 
-  fromJSON(object: any): UpdateInstalledPackageResponse {
+  fromJSON(object: any): RollbackInstalledPackageResponse {
     return {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
@@ -2236,7 +3856,7 @@ export const UpdateInstalledPackageResponse = {
     };
   },
 
-  toJSON(message: UpdateInstalledPackageResponse): unknown {
+  toJSON(message: RollbackInstalledPackageResponse): unknown {
     const obj: any = {};
     message.installedPackageRef !== undefined &&
       (obj.installedPackageRef = message.installedPackageRef
@@ -2245,10 +3865,10 @@ export const UpdateInstalledPackageResponse = {
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<UpdateInstalledPackageResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<RollbackInstalledPackageResponse>, I>>(
     object: I,
-  ): UpdateInstalledPackageResponse {
-    const message = createBaseUpdateInstalledPackageResponse();
+  ): RollbackInstalledPackageResponse {
+    const message = createBaseRollbackInstalledPackageResponse();
     message.installedPackageRef =
       object.installedPackageRef !== undefined && object.installedPackageRef !== null
         ? InstalledPackageReference.fromPartial(object.installedPackageRef)
@@ -2257,77 +3877,33 @@ export const UpdateInstalledPackageResponse = {
   },
 };
 
-function createBaseDeleteInstalledPackageResponse(): DeleteInstalledPackageResponse {
-  return {};
-}
-
-export const DeleteInstalledPackageResponse = {
-  encode(_: DeleteInstalledPackageResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteInstalledPackageResponse {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeleteInstalledPackageResponse();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        default:
-          reader.skipType(tag & 7);
-          break;
-      }
-    }
-    return message;
-  },
-
-  fromJSON(_: any): DeleteInstalledPackageResponse {
-    return {};
-  },
-
-  toJSON(_: DeleteInstalledPackageResponse): unknown {
-    const obj: any = {};
-    return obj;
-  },
-
-  fromPartial<I extends Exact<DeepPartial<DeleteInstalledPackageResponse>, I>>(
-    _: I,
-  ): DeleteInstalledPackageResponse {
-    const message = createBaseDeleteInstalledPackageResponse();
-    return message;
-  },
-};
-
-function createBaseGetInstalledPackageResourceRefsResponse(): GetInstalledPackageResourceRefsResponse {
-  return { context: undefined, resourceRefs: [] };
+function createBaseCheckInstalledPackagePermissionsResponse(): CheckInstalledPackagePermissionsResponse {
+  return { forbiddenActions: [] };
 }
 
-export const GetInstalledPackageResourceRefsResponse = {
+export const CheckInstalledPackagePermissionsResponse = {
   encode(
-    message: GetInstalledPackageResourceRefsResponse,
+    message: CheckInstalledPackagePermissionsResponse,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    if (message.context !== undefined) {
-      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
-    }
-    for (const v of message.resourceRefs) {
-      ResourceRef.encode(v!, writer.uint32(18).fork()).ldelim();
+    for (const v of message.forbiddenActions) {
+      ForbiddenAction.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageResourceRefsResponse {
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): CheckInstalledPackagePermissionsResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetInstalledPackageResourceRefsResponse();
+    const message = createBaseCheckInstalledPackagePermissionsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.context = Context.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.resourceRefs.push(ResourceRef.decode(reader, reader.uint32()));
+          message.forbiddenActions.push(ForbiddenAction.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -2337,36 +3913,32 @@ export const GetInstalledPackageResourceRefsResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetInstalledPackageResourceRefsResponse {
+  fromJSON(object: any): CheckInstalledPackagePermissionsResponse {
     return {
-      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
-      resourceRefs: Array.isArray(object?.resourceRefs)
-        ? object.resourceRefs.map((e: any) => ResourceRef.fromJSON(e))
+      forbiddenActions: Array.isArray(object?.forbiddenActions)
+        ? object.forbiddenActions.map((e: any) => ForbiddenAction.fromJSON(e))
         : [],
     };
   },
 
-  toJSON(message: GetInstalledPackageResourceRefsResponse): unknown {
+  toJSON(message: CheckInstalledPackagePermissionsResponse): unknown {
     const obj: any = {};
-    message.context !== undefined &&
-      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
-    if (message.resourceRefs) {
-      obj.resourceRefs = message.resourceRefs.map(e => (e ? ResourceRef.toJSON(e) : undefined));
+    if (message.forbiddenActions) {
+      obj.forbiddenActions = message.forbiddenActions.map(e =>
+        e ? ForbiddenAction.toJSON(e) : undefined,
+      );
     } else {
-      obj.resourceRefs = [];
+      obj.forbiddenActions = [];
     }
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageResourceRefsResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<CheckInstalledPackagePermissionsResponse>, I>>(
     object: I,
-  ): GetInstalledPackageResourceRefsResponse {
-    const message = createBaseGetInstalledPackageResourceRefsResponse();
-    message.context =
-      object.context !== undefined && object.context !== null
-        ? Context.fromPartial(object.context)
-        : undefined;
-    message.resourceRefs = object.resourceRefs?.map(e => ResourceRef.fromPartial(e)) || [];
+  ): CheckInstalledPackagePermissionsResponse {
+    const message = createBaseCheckInstalledPackagePermissionsResponse();
+    message.forbiddenActions =
+      object.forbiddenActions?.map(e => ForbiddenAction.fromPartial(e)) || [];
     return message;
   },
 };
@@ -2380,6 +3952,8 @@ function createBaseAvailablePackageSummary(): AvailablePackageSummary {
     displayName: "",
     shortDescription: "",
     categories: [],
+    latestVersionUpdated: undefined,
+    repositoryName: "",
   };
 }
 
@@ -2409,6 +3983,15 @@ export const AvailablePackageSummary = {
     for (const v of message.categories) {
       writer.uint32(58).string(v!);
     }
+    if (message.latestVersionUpdated !== undefined) {
+      Timestamp.encode(
+        toTimestamp(message.latestVersionUpdated),
+        writer.uint32(66).fork(),
+      ).ldelim();
+    }
+    if (message.repositoryName !== "") {
+      writer.uint32(74).string(message.repositoryName);
+    }
     return writer;
   },
 
@@ -2440,6 +4023,12 @@ export const AvailablePackageSummary = {
         case 7:
           message.categories.push(reader.string());
           break;
+        case 8:
+          message.latestVersionUpdated = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 9:
+          message.repositoryName = reader.string();
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -2463,6 +4052,10 @@ export const AvailablePackageSummary = {
       categories: Array.isArray(object?.categories)
         ? object.categories.map((e: any) => String(e))
         : [],
+      latestVersionUpdated: isSet(object.latestVersionUpdated)
+        ? fromJsonTimestamp(object.latestVersionUpdated)
+        : undefined,
+      repositoryName: isSet(object.repositoryName) ? String(object.repositoryName) : "",
     };
   },
 
@@ -2485,6 +4078,9 @@ export const AvailablePackageSummary = {
     } else {
       obj.categories = [];
     }
+    message.latestVersionUpdated !== undefined &&
+      (obj.latestVersionUpdated = message.latestVersionUpdated.toISOString());
+    message.repositoryName !== undefined && (obj.repositoryName = message.repositoryName);
     return obj;
   },
 
@@ -2505,6 +4101,8 @@ export const AvailablePackageSummary = {
     message.displayName = object.displayName ?? "";
     message.shortDescription = object.shortDescription ?? "";
     message.categories = object.categories?.map(e => e) || [];
+    message.latestVersionUpdated = object.latestVersionUpdated ?? undefined;
+    message.repositoryName = object.repositoryName ?? "";
     return message;
   },
 };
@@ -3371,40 +4969,158 @@ export const Maintainer = {
   },
 };
 
-function createBaseFilterOptions(): FilterOptions {
+function createBaseFilterOptions(): FilterOptions {
+  return {
+    query: "",
+    categories: [],
+    repositories: [],
+    pkgVersion: "",
+    appVersion: "",
+    sortBy: 0,
+  };
+}
+
+export const FilterOptions = {
+  encode(message: FilterOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    for (const v of message.categories) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.repositories) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(34).string(message.pkgVersion);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(42).string(message.appVersion);
+    }
+    if (message.sortBy !== 0) {
+      writer.uint32(48).int32(message.sortBy);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FilterOptions {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFilterOptions();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.query = reader.string();
+          break;
+        case 2:
+          message.categories.push(reader.string());
+          break;
+        case 3:
+          message.repositories.push(reader.string());
+          break;
+        case 4:
+          message.pkgVersion = reader.string();
+          break;
+        case 5:
+          message.appVersion = reader.string();
+          break;
+        case 6:
+          message.sortBy = reader.int32() as any;
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FilterOptions {
+    return {
+      query: isSet(object.query) ? String(object.query) : "",
+      categories: Array.isArray(object?.categories)
+        ? object.categories.map((e: any) => String(e))
+        : [],
+      repositories: Array.isArray(object?.repositories)
+        ? object.repositories.map((e: any) => String(e))
+        : [],
+      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
+      appVersion: isSet(object.appVersion) ? String(object.appVersion) : "",
+      sortBy: isSet(object.sortBy) ? filterOptions_SortByFromJSON(object.sortBy) : 0,
+    };
+  },
+
+  toJSON(message: FilterOptions): unknown {
+    const obj: any = {};
+    message.query !== undefined && (obj.query = message.query);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    if (message.repositories) {
+      obj.repositories = message.repositories.map(e => e);
+    } else {
+      obj.repositories = [];
+    }
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.sortBy !== undefined && (obj.sortBy = filterOptions_SortByToJSON(message.sortBy));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<FilterOptions>, I>>(object: I): FilterOptions {
+    const message = createBaseFilterOptions();
+    message.query = object.query ?? "";
+    message.categories = object.categories?.map(e => e) || [];
+    message.repositories = object.repositories?.map(e => e) || [];
+    message.pkgVersion = object.pkgVersion ?? "";
+    message.appVersion = object.appVersion ?? "";
+    message.sortBy = object.sortBy ?? 0;
+    return message;
+  },
+};
+
+function createBaseInstalledPackageFilterOptions(): InstalledPackageFilterOptions {
   return {
     query: "",
-    categories: [],
+    statusReasons: [],
+    plugins: [],
     repositories: [],
-    pkgVersion: "",
-    appVersion: "",
+    upgradeAvailable: false,
   };
 }
 
-export const FilterOptions = {
-  encode(message: FilterOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const InstalledPackageFilterOptions = {
+  encode(
+    message: InstalledPackageFilterOptions,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
     if (message.query !== "") {
       writer.uint32(10).string(message.query);
     }
-    for (const v of message.categories) {
-      writer.uint32(18).string(v!);
+    writer.uint32(18).fork();
+    for (const v of message.statusReasons) {
+      writer.int32(v);
     }
-    for (const v of message.repositories) {
+    writer.ldelim();
+    for (const v of message.plugins) {
       writer.uint32(26).string(v!);
     }
-    if (message.pkgVersion !== "") {
-      writer.uint32(34).string(message.pkgVersion);
+    for (const v of message.repositories) {
+      writer.uint32(34).string(v!);
     }
-    if (message.appVersion !== "") {
-      writer.uint32(42).string(message.appVersion);
+    if (message.upgradeAvailable === true) {
+      writer.uint32(40).bool(message.upgradeAvailable);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FilterOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageFilterOptions {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFilterOptions();
+    const message = createBaseInstalledPackageFilterOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3412,16 +5128,23 @@ export const FilterOptions = {
           message.query = reader.string();
           break;
         case 2:
-          message.categories.push(reader.string());
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.statusReasons.push(reader.int32() as any);
+            }
+          } else {
+            message.statusReasons.push(reader.int32() as any);
+          }
           break;
         case 3:
-          message.repositories.push(reader.string());
+          message.plugins.push(reader.string());
           break;
         case 4:
-          message.pkgVersion = reader.string();
+          message.repositories.push(reader.string());
           break;
         case 5:
-          message.appVersion = reader.string();
+          message.upgradeAvailable = reader.bool();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3431,45 +5154,53 @@ export const FilterOptions = {
     return message;
   },
 
-  fromJSON(object: any): FilterOptions {
+  fromJSON(object: any): InstalledPackageFilterOptions {
     return {
       query: isSet(object.query) ? String(object.query) : "",
-      categories: Array.isArray(object?.categories)
-        ? object.categories.map((e: any) => String(e))
+      statusReasons: Array.isArray(object?.statusReasons)
+        ? object.statusReasons.map((e: any) => installedPackageStatus_StatusReasonFromJSON(e))
         : [],
+      plugins: Array.isArray(object?.plugins) ? object.plugins.map((e: any) => String(e)) : [],
       repositories: Array.isArray(object?.repositories)
         ? object.repositories.map((e: any) => String(e))
         : [],
-      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
-      appVersion: isSet(object.appVersion) ? String(object.appVersion) : "",
+      upgradeAvailable: isSet(object.upgradeAvailable) ? Boolean(object.upgradeAvailable) : false,
     };
   },
 
-  toJSON(message: FilterOptions): unknown {
+  toJSON(message: InstalledPackageFilterOptions): unknown {
     const obj: any = {};
     message.query !== undefined && (obj.query = message.query);
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
+    if (message.statusReasons) {
+      obj.statusReasons = message.statusReasons.map(e =>
+        installedPackageStatus_StatusReasonToJSON(e),
+      );
     } else {
-      obj.categories = [];
+      obj.statusReasons = [];
+    }
+    if (message.plugins) {
+      obj.plugins = message.plugins.map(e => e);
+    } else {
+      obj.plugins = [];
     }
     if (message.repositories) {
       obj.repositories = message.repositories.map(e => e);
     } else {
       obj.repositories = [];
     }
-    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
-    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.upgradeAvailable !== undefined && (obj.upgradeAvailable = message.upgradeAvailable);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<FilterOptions>, I>>(object: I): FilterOptions {
-    const message = createBaseFilterOptions();
+  fromPartial<I extends Exact<DeepPartial<InstalledPackageFilterOptions>, I>>(
+    object: I,
+  ): InstalledPackageFilterOptions {
+    const message = createBaseInstalledPackageFilterOptions();
     message.query = object.query ?? "";
-    message.categories = object.categories?.map(e => e) || [];
+    message.statusReasons = object.statusReasons?.map(e => e) || [];
+    message.plugins = object.plugins?.map(e => e) || [];
     message.repositories = object.repositories?.map(e => e) || [];
-    message.pkgVersion = object.pkgVersion ?? "";
-    message.appVersion = object.appVersion ?? "";
+    message.upgradeAvailable = object.upgradeAvailable ?? false;
     return message;
   },
 };
@@ -3778,53 +5509,312 @@ export const ReconciliationOptions = {
     };
   },
 
-  toJSON(message: ReconciliationOptions): unknown {
+  toJSON(message: ReconciliationOptions): unknown {
+    const obj: any = {};
+    message.interval !== undefined && (obj.interval = Math.round(message.interval));
+    message.suspend !== undefined && (obj.suspend = message.suspend);
+    message.serviceAccountName !== undefined &&
+      (obj.serviceAccountName = message.serviceAccountName);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ReconciliationOptions>, I>>(
+    object: I,
+  ): ReconciliationOptions {
+    const message = createBaseReconciliationOptions();
+    message.interval = object.interval ?? 0;
+    message.suspend = object.suspend ?? false;
+    message.serviceAccountName = object.serviceAccountName ?? "";
+    return message;
+  },
+};
+
+function createBaseInstalledPackageRevision(): InstalledPackageRevision {
+  return {
+    revision: 0,
+    version: undefined,
+    status: undefined,
+    updated: undefined,
+    valuesApplied: "",
+  };
+}
+
+export const InstalledPackageRevision = {
+  encode(message: InstalledPackageRevision, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.revision !== 0) {
+      writer.uint32(8).int32(message.revision);
+    }
+    if (message.version !== undefined) {
+      PackageAppVersion.encode(message.version, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.updated !== undefined) {
+      Timestamp.encode(toTimestamp(message.updated), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.valuesApplied !== "") {
+      writer.uint32(42).string(message.valuesApplied);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageRevision {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseInstalledPackageRevision();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.revision = reader.int32();
+          break;
+        case 2:
+          message.version = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.updated = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 5:
+          message.valuesApplied = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): InstalledPackageRevision {
+    return {
+      revision: isSet(object.revision) ? Number(object.revision) : 0,
+      version: isSet(object.version) ? PackageAppVersion.fromJSON(object.version) : undefined,
+      status: isSet(object.status) ? InstalledPackageStatus.fromJSON(object.status) : undefined,
+      updated: isSet(object.updated) ? fromJsonTimestamp(object.updated) : undefined,
+      valuesApplied: isSet(object.valuesApplied) ? String(object.valuesApplied) : "",
+    };
+  },
+
+  toJSON(message: InstalledPackageRevision): unknown {
+    const obj: any = {};
+    message.revision !== undefined && (obj.revision = Math.round(message.revision));
+    message.version !== undefined &&
+      (obj.version = message.version ? PackageAppVersion.toJSON(message.version) : undefined);
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.updated !== undefined && (obj.updated = message.updated.toISOString());
+    message.valuesApplied !== undefined && (obj.valuesApplied = message.valuesApplied);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<InstalledPackageRevision>, I>>(
+    object: I,
+  ): InstalledPackageRevision {
+    const message = createBaseInstalledPackageRevision();
+    message.revision = object.revision ?? 0;
+    message.version =
+      object.version !== undefined && object.version !== null
+        ? PackageAppVersion.fromPartial(object.version)
+        : undefined;
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? InstalledPackageStatus.fromPartial(object.status)
+        : undefined;
+    message.updated = object.updated ?? undefined;
+    message.valuesApplied = object.valuesApplied ?? "";
+    return message;
+  },
+};
+
+function createBasePackageAppVersion(): PackageAppVersion {
+  return { pkgVersion: "", appVersion: "" };
+}
+
+export const PackageAppVersion = {
+  encode(message: PackageAppVersion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pkgVersion !== "") {
+      writer.uint32(10).string(message.pkgVersion);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(18).string(message.appVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageAppVersion {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePackageAppVersion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.pkgVersion = reader.string();
+          break;
+        case 2:
+          message.appVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PackageAppVersion {
+    return {
+      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
+      appVersion: isSet(object.appVersion) ? String(object.appVersion) : "",
+    };
+  },
+
+  toJSON(message: PackageAppVersion): unknown {
+    const obj: any = {};
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PackageAppVersion>, I>>(object: I): PackageAppVersion {
+    const message = createBasePackageAppVersion();
+    message.pkgVersion = object.pkgVersion ?? "";
+    message.appVersion = object.appVersion ?? "";
+    return message;
+  },
+};
+
+function createBaseResourceRef(): ResourceRef {
+  return { apiVersion: "", kind: "", name: "", namespace: "" };
+}
+
+export const ResourceRef = {
+  encode(message: ResourceRef, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.apiVersion !== "") {
+      writer.uint32(10).string(message.apiVersion);
+    }
+    if (message.kind !== "") {
+      writer.uint32(18).string(message.kind);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.namespace !== "") {
+      writer.uint32(34).string(message.namespace);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceRef {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceRef();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.apiVersion = reader.string();
+          break;
+        case 2:
+          message.kind = reader.string();
+          break;
+        case 3:
+          message.name = reader.string();
+          break;
+        case 4:
+          message.namespace = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceRef {
+    return {
+      apiVersion: isSet(object.apiVersion) ? String(object.apiVersion) : "",
+      kind: isSet(object.kind) ? String(object.kind) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      namespace: isSet(object.namespace) ? String(object.namespace) : "",
+    };
+  },
+
+  toJSON(message: ResourceRef): unknown {
     const obj: any = {};
-    message.interval !== undefined && (obj.interval = Math.round(message.interval));
-    message.suspend !== undefined && (obj.suspend = message.suspend);
-    message.serviceAccountName !== undefined &&
-      (obj.serviceAccountName = message.serviceAccountName);
+    message.apiVersion !== undefined && (obj.apiVersion = message.apiVersion);
+    message.kind !== undefined && (obj.kind = message.kind);
+    message.name !== undefined && (obj.name = message.name);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<ReconciliationOptions>, I>>(
-    object: I,
-  ): ReconciliationOptions {
-    const message = createBaseReconciliationOptions();
-    message.interval = object.interval ?? 0;
-    message.suspend = object.suspend ?? false;
-    message.serviceAccountName = object.serviceAccountName ?? "";
+  fromPartial<I extends Exact<DeepPartial<ResourceRef>, I>>(object: I): ResourceRef {
+    const message = createBaseResourceRef();
+    message.apiVersion = object.apiVersion ?? "";
+    message.kind = object.kind ?? "";
+    message.name = object.name ?? "";
+    message.namespace = object.namespace ?? "";
     return message;
   },
 };
 
-function createBasePackageAppVersion(): PackageAppVersion {
-  return { pkgVersion: "", appVersion: "" };
+function createBaseResourceChange(): ResourceChange {
+  return {
+    resourceRef: undefined,
+    changeType: 0,
+    currentManifest: "",
+    targetManifest: "",
+    diff: "",
+  };
 }
 
-export const PackageAppVersion = {
-  encode(message: PackageAppVersion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pkgVersion !== "") {
-      writer.uint32(10).string(message.pkgVersion);
+export const ResourceChange = {
+  encode(message: ResourceChange, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
     }
-    if (message.appVersion !== "") {
-      writer.uint32(18).string(message.appVersion);
+    if (message.changeType !== 0) {
+      writer.uint32(16).int32(message.changeType);
+    }
+    if (message.currentManifest !== "") {
+      writer.uint32(26).string(message.currentManifest);
+    }
+    if (message.targetManifest !== "") {
+      writer.uint32(34).string(message.targetManifest);
+    }
+    if (message.diff !== "") {
+      writer.uint32(42).string(message.diff);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PackageAppVersion {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceChange {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePackageAppVersion();
+    const message = createBaseResourceChange();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.pkgVersion = reader.string();
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
           break;
         case 2:
-          message.appVersion = reader.string();
+          message.changeType = reader.int32() as any;
+          break;
+        case 3:
+          message.currentManifest = reader.string();
+          break;
+        case 4:
+          message.targetManifest = reader.string();
+          break;
+        case 5:
+          message.diff = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3834,53 +5824,78 @@ export const PackageAppVersion = {
     return message;
   },
 
-  fromJSON(object: any): PackageAppVersion {
+  fromJSON(object: any): ResourceChange {
     return {
-      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
-      appVersion: isSet(object.appVersion) ? String(object.appVersion) : "",
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      changeType: isSet(object.changeType)
+        ? resourceChange_ChangeTypeFromJSON(object.changeType)
+        : 0,
+      currentManifest: isSet(object.currentManifest) ? String(object.currentManifest) : "",
+      targetManifest: isSet(object.targetManifest) ? String(object.targetManifest) : "",
+      diff: isSet(object.diff) ? String(object.diff) : "",
     };
   },
 
-  toJSON(message: PackageAppVersion): unknown {
+  toJSON(message: ResourceChange): unknown {
     const obj: any = {};
-    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
-    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.changeType !== undefined &&
+      (obj.changeType = resourceChange_ChangeTypeToJSON(message.changeType));
+    message.currentManifest !== undefined && (obj.currentManifest = message.currentManifest);
+    message.targetManifest !== undefined && (obj.targetManifest = message.targetManifest);
+    message.diff !== undefined && (obj.diff = message.diff);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<PackageAppVersion>, I>>(object: I): PackageAppVersion {
-    const message = createBasePackageAppVersion();
-    message.pkgVersion = object.pkgVersion ?? "";
-    message.appVersion = object.appVersion ?? "";
+  fromPartial<I extends Exact<DeepPartial<ResourceChange>, I>>(object: I): ResourceChange {
+    const message = createBaseResourceChange();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.changeType = object.changeType ?? 0;
+    message.currentManifest = object.currentManifest ?? "";
+    message.targetManifest = object.targetManifest ?? "";
+    message.diff = object.diff ?? "";
     return message;
   },
 };
 
-function createBaseResourceRef(): ResourceRef {
-  return { apiVersion: "", kind: "", name: "", namespace: "" };
+function createBaseForbiddenAction(): ForbiddenAction {
+  return {
+    apiVersion: "",
+    resource: "",
+    namespace: "",
+    clusterWide: false,
+    verbs: [],
+  };
 }
 
-export const ResourceRef = {
-  encode(message: ResourceRef, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ForbiddenAction = {
+  encode(message: ForbiddenAction, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.apiVersion !== "") {
       writer.uint32(10).string(message.apiVersion);
     }
-    if (message.kind !== "") {
-      writer.uint32(18).string(message.kind);
-    }
-    if (message.name !== "") {
-      writer.uint32(26).string(message.name);
+    if (message.resource !== "") {
+      writer.uint32(18).string(message.resource);
     }
     if (message.namespace !== "") {
-      writer.uint32(34).string(message.namespace);
+      writer.uint32(26).string(message.namespace);
+    }
+    if (message.clusterWide === true) {
+      writer.uint32(32).bool(message.clusterWide);
+    }
+    for (const v of message.verbs) {
+      writer.uint32(42).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceRef {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ForbiddenAction {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResourceRef();
+    const message = createBaseForbiddenAction();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3888,13 +5903,16 @@ export const ResourceRef = {
           message.apiVersion = reader.string();
           break;
         case 2:
-          message.kind = reader.string();
+          message.resource = reader.string();
           break;
         case 3:
-          message.name = reader.string();
+          message.namespace = reader.string();
           break;
         case 4:
-          message.namespace = reader.string();
+          message.clusterWide = reader.bool();
+          break;
+        case 5:
+          message.verbs.push(reader.string());
           break;
         default:
           reader.skipType(tag & 7);
@@ -3904,30 +5922,37 @@ export const ResourceRef = {
     return message;
   },
 
-  fromJSON(object: any): ResourceRef {
+  fromJSON(object: any): ForbiddenAction {
     return {
       apiVersion: isSet(object.apiVersion) ? String(object.apiVersion) : "",
-      kind: isSet(object.kind) ? String(object.kind) : "",
-      name: isSet(object.name) ? String(object.name) : "",
+      resource: isSet(object.resource) ? String(object.resource) : "",
       namespace: isSet(object.namespace) ? String(object.namespace) : "",
+      clusterWide: isSet(object.clusterWide) ? Boolean(object.clusterWide) : false,
+      verbs: Array.isArray(object?.verbs) ? object.verbs.map((e: any) => String(e)) : [],
     };
   },
 
-  toJSON(message: ResourceRef): unknown {
+  toJSON(message: ForbiddenAction): unknown {
     const obj: any = {};
     message.apiVersion !== undefined && (obj.apiVersion = message.apiVersion);
-    message.kind !== undefined && (obj.kind = message.kind);
-    message.name !== undefined && (obj.name = message.name);
+    message.resource !== undefined && (obj.resource = message.resource);
     message.namespace !== undefined && (obj.namespace = message.namespace);
+    message.clusterWide !== undefined && (obj.clusterWide = message.clusterWide);
+    if (message.verbs) {
+      obj.verbs = message.verbs.map(e => e);
+    } else {
+      obj.verbs = [];
+    }
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<ResourceRef>, I>>(object: I): ResourceRef {
-    const message = createBaseResourceRef();
+  fromPartial<I extends Exact<DeepPartial<ForbiddenAction>, I>>(object: I): ForbiddenAction {
+    const message = createBaseForbiddenAction();
     message.apiVersion = object.apiVersion ?? "";
-    message.kind = object.kind ?? "";
-    message.name = object.name ?? "";
+    message.resource = object.resource ?? "";
     message.namespace = object.namespace ?? "";
+    message.clusterWide = object.clusterWide ?? false;
+    message.verbs = object.verbs?.map(e => e) || [];
     return message;
   },
 };
@@ -3950,6 +5975,16 @@ export interface PackagesService {
     request: DeepPartial<GetInstalledPackageSummariesRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageSummariesResponse>;
+  /**
+   * WatchInstalledPackageSummaries streams the additions, updates and
+   * deletions of installed packages in the requested context, aggregated
+   * across the registered plugins. Existing installed packages are sent as
+   * additions when the watch starts.
+   */
+  WatchInstalledPackageSummaries(
+    request: DeepPartial<WatchInstalledPackageSummariesRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<WatchInstalledPackageSummariesResponse>;
   GetInstalledPackageDetail(
     request: DeepPartial<GetInstalledPackageDetailRequest>,
     metadata?: grpc.Metadata,
@@ -3962,14 +5997,49 @@ export interface PackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  GetInstalledPackageUpdatePreview(
+    request: DeepPartial<GetInstalledPackageUpdatePreviewRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdatePreviewResponse>;
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
+  /**
+   * GetInstalledPackageResourceRefs returns the references of the resources
+   * of an installed package and, when requested with include_manifest, their
+   * rendered manifest. The kapp-controller plugin never returns the manifest.
+   */
   GetInstalledPackageResourceRefs(
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  /**
+   * GetInstalledPackageRevisions returns the history of revisions of an
+   * installed package, with the most recent revision first.
+   */
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
+  /**
+   * RollbackInstalledPackage rolls back an installed package to a previous
+   * revision.
+   */
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse>;
+  /**
+   * CheckInstalledPackagePermissions returns the actions which the user is
+   * not allowed to perform when creating or updating an installed package
+   * with the given version and values, so that a client can warn the user
+   * before starting an installation which would fail part way.
+   */
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse>;
 }
 
 export class PackagesServiceClientImpl implements PackagesService {
@@ -3981,11 +6051,16 @@ export class PackagesServiceClientImpl implements PackagesService {
     this.GetAvailablePackageDetail = this.GetAvailablePackageDetail.bind(this);
     this.GetAvailablePackageVersions = this.GetAvailablePackageVersions.bind(this);
     this.GetInstalledPackageSummaries = this.GetInstalledPackageSummaries.bind(this);
+    this.WatchInstalledPackageSummaries = this.WatchInstalledPackageSummaries.bind(this);
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpdatePreview = this.GetInstalledPackageUpdatePreview.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
+    this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
+    this.CheckInstalledPackagePermissions = this.CheckInstalledPackagePermissions.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -4032,6 +6107,17 @@ export class PackagesServiceClientImpl implements PackagesService {
     );
   }
 
+  WatchInstalledPackageSummaries(
+    request: DeepPartial<WatchInstalledPackageSummariesRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<WatchInstalledPackageSummariesResponse> {
+    return this.rpc.invoke(
+      PackagesServiceWatchInstalledPackageSummariesDesc,
+      WatchInstalledPackageSummariesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetInstalledPackageDetail(
     request: DeepPartial<GetInstalledPackageDetailRequest>,
     metadata?: grpc.Metadata,
@@ -4065,6 +6151,17 @@ export class PackagesServiceClientImpl implements PackagesService {
     );
   }
 
+  GetInstalledPackageUpdatePreview(
+    request: DeepPartial<GetInstalledPackageUpdatePreviewRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdatePreviewResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageUpdatePreviewDesc,
+      GetInstalledPackageUpdatePreviewRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -4086,6 +6183,39 @@ export class PackagesServiceClientImpl implements PackagesService {
       metadata,
     );
   }
+
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse> {
+    return this.rpc.unary(
+      PackagesServiceRollbackInstalledPackageDesc,
+      RollbackInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse> {
+    return this.rpc.unary(
+      PackagesServiceCheckInstalledPackagePermissionsDesc,
+      CheckInstalledPackagePermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const PackagesServiceDesc = {
@@ -4180,6 +6310,28 @@ export const PackagesServiceGetInstalledPackageSummariesDesc: UnaryMethodDefinit
   } as any,
 };
 
+export const PackagesServiceWatchInstalledPackageSummariesDesc: UnaryMethodDefinitionish = {
+  methodName: "WatchInstalledPackageSummaries",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return WatchInstalledPackageSummariesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...WatchInstalledPackageSummariesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const PackagesServiceGetInstalledPackageDetailDesc: UnaryMethodDefinitionish = {
   methodName: "GetInstalledPackageDetail",
   service: PackagesServiceDesc,
@@ -4246,6 +6398,28 @@ export const PackagesServiceUpdateInstalledPackageDesc: UnaryMethodDefinitionish
   } as any,
 };
 
+export const PackagesServiceGetInstalledPackageUpdatePreviewDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpdatePreview",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpdatePreviewRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpdatePreviewResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const PackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: PackagesServiceDesc,
@@ -4290,6 +6464,72 @@ export const PackagesServiceGetInstalledPackageResourceRefsDesc: UnaryMethodDefi
   } as any,
 };
 
+export const PackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageRevisions",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageRevisionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageRevisionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RollbackInstalledPackage",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RollbackInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RollbackInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceCheckInstalledPackagePermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "CheckInstalledPackagePermissions",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CheckInstalledPackagePermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...CheckInstalledPackagePermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
@@ -4303,13 +6543,18 @@ interface Rpc {
     request: any,
     metadata: grpc.Metadata | undefined,
   ): Promise<any>;
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any>;
 }
 
 export class GrpcWebImpl {
   private host: string;
   private options: {
     transport?: grpc.TransportFactory;
-
+    streamingTransport?: grpc.TransportFactory;
     debug?: boolean;
     metadata?: grpc.Metadata;
   };
@@ -4318,7 +6563,7 @@ export class GrpcWebImpl {
     host: string,
     options: {
       transport?: grpc.TransportFactory;
-
+      streamingTransport?: grpc.TransportFactory;
       debug?: boolean;
       metadata?: grpc.Metadata;
     },
@@ -4360,6 +6605,47 @@ export class GrpcWebImpl {
       });
     });
   }
+
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any> {
+    // Status Response Codes (https://developers.google.com/maps-booking/reference/grpc-api/status_codes)
+    const upStreamCodes = [2, 4, 8, 9, 10, 13, 14, 15];
+    const DEFAULT_TIMEOUT_TIME: number = 3_000;
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata =
+      metadata && this.options.metadata
+        ? new BrowserHeaders({
+            ...this.options?.metadata.headersMap,
+            ...metadata?.headersMap,
+          })
+        : metadata || this.options.metadata;
+    return new Observable(observer => {
+      const upStream = () => {
+        const client = grpc.invoke(methodDesc, {
+          host: this.host,
+          request,
+          transport: this.options.streamingTransport || this.options.transport,
+          metadata: maybeCombinedMetadata,
+          debug: this.options.debug,
+          onMessage: next => observer.next(next),
+          onEnd: (code: grpc.Code, message: string) => {
+            if (code === 0) {
+              observer.complete();
+            } else if (upStreamCodes.includes(code)) {
+              setTimeout(upStream, DEFAULT_TIMEOUT_TIME);
+            } else {
+              observer.error(new Error(`Error ${code} ${message}`));
+            }
+          },
+        });
+        observer.add(() => client.close());
+      };
+      upStream();
+    }).pipe(share());
+  }
 }
 
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
@@ -4379,6 +6665,28 @@ export type Exact<P, I extends P> = P extends Builtin
   ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & Record<Exclude<keyof I, KeysOfUnion<P>>, never>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = t.seconds * 1_000;
+  millis += t.nanos / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
@@ -25,6 +25,33 @@ export interface GetConfiguredPluginsResponse {
    * List of Plugin
    */
   plugins: Plugin[];
+  /**
+   * Configured plugins
+   *
+   * The same plugins as in plugins, each together with the features it
+   * supports.
+   */
+  configuredPlugins: ConfiguredPlugin[];
+}
+
+/**
+ * ConfiguredPlugin
+ *
+ * A plugin configured on the server together with the features it supports.
+ */
+export interface ConfiguredPlugin {
+  /**
+   * Plugin
+   *
+   * The name and version of the plugin.
+   */
+  plugin?: Plugin;
+  /**
+   * Plugin capabilities
+   *
+   * The features supported by the plugin.
+   */
+  capabilities?: PluginCapabilities;
 }
 
 /**
@@ -47,6 +74,67 @@ export interface Plugin {
   version: string;
 }
 
+/**
+ * PluginCapabilities
+ *
+ * The features supported by a plugin, so that clients can enable or disable
+ * functionality without needing to handle Unimplemented errors.
+ */
+export interface PluginCapabilities {
+  /**
+   * Packages
+   *
+   * Whether the plugin implements the core packages service.
+   */
+  packages: boolean;
+  /**
+   * Repositories
+   *
+   * Whether the plugin implements the core repositories service to create,
+   * read, update and delete package repositories.
+   */
+  repositories: boolean;
+  /**
+   * Rollback
+   *
+   * Whether installed packages can be rolled back to a previous revision.
+   */
+  rollback: boolean;
+  /**
+   * Reconciliation options
+   *
+   * Whether the reconciliation options of an installed package, such as the
+   * interval or whether it is suspended, are supported.
+   */
+  reconciliationOptions: boolean;
+  /**
+   * Service accounts
+   *
+   * Whether installed packages can be reconciled using a service account.
+   */
+  serviceAccounts: boolean;
+  /**
+   * Multi-cluster
+   *
+   * Whether packages can be installed on clusters other than the one on
+   * which Kubeapps is installed.
+   */
+  multiCluster: boolean;
+  /**
+   * Values schema
+   *
+   * Whether available packages provide a JSON schema for their values.
+   */
+  valuesSchema: boolean;
+  /**
+   * Update preview
+   *
+   * Whether the changes to resources resulting from creating or updating an
+   * installed package can be previewed with GetInstalledPackageUpdatePreview.
+   */
+  updatePreview: boolean;
+}
+
 function createBaseGetConfiguredPluginsRequest(): GetConfiguredPluginsRequest {
   return {};
 }
@@ -89,7 +177,7 @@ export const GetConfiguredPluginsRequest = {
 };
 
 function createBaseGetConfiguredPluginsResponse(): GetConfiguredPluginsResponse {
-  return { plugins: [] };
+  return { plugins: [], configuredPlugins: [] };
 }
 
 export const GetConfiguredPluginsResponse = {
@@ -100,6 +188,9 @@ export const GetConfiguredPluginsResponse = {
     for (const v of message.plugins) {
       Plugin.encode(v!, writer.uint32(10).fork()).ldelim();
     }
+    for (const v of message.configuredPlugins) {
+      ConfiguredPlugin.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -113,6 +204,9 @@ export const GetConfiguredPluginsResponse = {
         case 1:
           message.plugins.push(Plugin.decode(reader, reader.uint32()));
           break;
+        case 2:
+          message.configuredPlugins.push(ConfiguredPlugin.decode(reader, reader.uint32()));
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -126,6 +220,9 @@ export const GetConfiguredPluginsResponse = {
       plugins: Array.isArray(object?.plugins)
         ? object.plugins.map((e: any) => Plugin.fromJSON(e))
         : [],
+      configuredPlugins: Array.isArray(object?.configuredPlugins)
+        ? object.configuredPlugins.map((e: any) => ConfiguredPlugin.fromJSON(e))
+        : [],
     };
   },
 
@@ -136,6 +233,13 @@ export const GetConfiguredPluginsResponse = {
     } else {
       obj.plugins = [];
     }
+    if (message.configuredPlugins) {
+      obj.configuredPlugins = message.configuredPlugins.map(e =>
+        e ? ConfiguredPlugin.toJSON(e) : undefined,
+      );
+    } else {
+      obj.configuredPlugins = [];
+    }
     return obj;
   },
 
@@ -144,6 +248,78 @@ export const GetConfiguredPluginsResponse = {
   ): GetConfiguredPluginsResponse {
     const message = createBaseGetConfiguredPluginsResponse();
     message.plugins = object.plugins?.map(e => Plugin.fromPartial(e)) || [];
+    message.configuredPlugins =
+      object.configuredPlugins?.map(e => ConfiguredPlugin.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseConfiguredPlugin(): ConfiguredPlugin {
+  return { plugin: undefined, capabilities: undefined };
+}
+
+export const ConfiguredPlugin = {
+  encode(message: ConfiguredPlugin, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.plugin !== undefined) {
+      Plugin.encode(message.plugin, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.capabilities !== undefined) {
+      PluginCapabilities.encode(message.capabilities, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ConfiguredPlugin {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfiguredPlugin();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.plugin = Plugin.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.capabilities = PluginCapabilities.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfiguredPlugin {
+    return {
+      plugin: isSet(object.plugin) ? Plugin.fromJSON(object.plugin) : undefined,
+      capabilities: isSet(object.capabilities)
+        ? PluginCapabilities.fromJSON(object.capabilities)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ConfiguredPlugin): unknown {
+    const obj: any = {};
+    message.plugin !== undefined &&
+      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
+    message.capabilities !== undefined &&
+      (obj.capabilities = message.capabilities
+        ? PluginCapabilities.toJSON(message.capabilities)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ConfiguredPlugin>, I>>(object: I): ConfiguredPlugin {
+    const message = createBaseConfiguredPlugin();
+    message.plugin =
+      object.plugin !== undefined && object.plugin !== null
+        ? Plugin.fromPartial(object.plugin)
+        : undefined;
+    message.capabilities =
+      object.capabilities !== undefined && object.capabilities !== null
+        ? PluginCapabilities.fromPartial(object.capabilities)
+        : undefined;
     return message;
   },
 };
@@ -206,6 +382,130 @@ export const Plugin = {
   },
 };
 
+function createBasePluginCapabilities(): PluginCapabilities {
+  return {
+    packages: false,
+    repositories: false,
+    rollback: false,
+    reconciliationOptions: false,
+    serviceAccounts: false,
+    multiCluster: false,
+    valuesSchema: false,
+    updatePreview: false,
+  };
+}
+
+export const PluginCapabilities = {
+  encode(message: PluginCapabilities, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.packages === true) {
+      writer.uint32(8).bool(message.packages);
+    }
+    if (message.repositories === true) {
+      writer.uint32(16).bool(message.repositories);
+    }
+    if (message.rollback === true) {
+      writer.uint32(24).bool(message.rollback);
+    }
+    if (message.reconciliationOptions === true) {
+      writer.uint32(32).bool(message.reconciliationOptions);
+    }
+    if (message.serviceAccounts === true) {
+      writer.uint32(40).bool(message.serviceAccounts);
+    }
+    if (message.multiCluster === true) {
+      writer.uint32(48).bool(message.multiCluster);
+    }
+    if (message.valuesSchema === true) {
+      writer.uint32(56).bool(message.valuesSchema);
+    }
+    if (message.updatePreview === true) {
+      writer.uint32(64).bool(message.updatePreview);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PluginCapabilities {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePluginCapabilities();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packages = reader.bool();
+          break;
+        case 2:
+          message.repositories = reader.bool();
+          break;
+        case 3:
+          message.rollback = reader.bool();
+          break;
+        case 4:
+          message.reconciliationOptions = reader.bool();
+          break;
+        case 5:
+          message.serviceAccounts = reader.bool();
+          break;
+        case 6:
+          message.multiCluster = reader.bool();
+          break;
+        case 7:
+          message.valuesSchema = reader.bool();
+          break;
+        case 8:
+          message.updatePreview = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PluginCapabilities {
+    return {
+      packages: isSet(object.packages) ? Boolean(object.packages) : false,
+      repositories: isSet(object.repositories) ? Boolean(object.repositories) : false,
+      rollback: isSet(object.rollback) ? Boolean(object.rollback) : false,
+      reconciliationOptions: isSet(object.reconciliationOptions)
+        ? Boolean(object.reconciliationOptions)
+        : false,
+      serviceAccounts: isSet(object.serviceAccounts) ? Boolean(object.serviceAccounts) : false,
+      multiCluster: isSet(object.multiCluster) ? Boolean(object.multiCluster) : false,
+      valuesSchema: isSet(object.valuesSchema) ? Boolean(object.valuesSchema) : false,
+      updatePreview: isSet(object.updatePreview) ? Boolean(object.updatePreview) : false,
+    };
+  },
+
+  toJSON(message: PluginCapabilities): unknown {
+    const obj: any = {};
+    message.packages !== undefined && (obj.packages = message.packages);
+    message.repositories !== undefined && (obj.repositories = message.repositories);
+    message.rollback !== undefined && (obj.rollback = message.rollback);
+    message.reconciliationOptions !== undefined &&
+      (obj.reconciliationOptions = message.reconciliationOptions);
+    message.serviceAccounts !== undefined && (obj.serviceAccounts = message.serviceAccounts);
+    message.multiCluster !== undefined && (obj.multiCluster = message.multiCluster);
+    message.valuesSchema !== undefined && (obj.valuesSchema = message.valuesSchema);
+    message.updatePreview !== undefined && (obj.updatePreview = message.updatePreview);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PluginCapabilities>, I>>(object: I): PluginCapabilities {
+    const message = createBasePluginCapabilities();
+    message.packages = object.packages ?? false;
+    message.repositories = object.repositories ?? false;
+    message.rollback = object.rollback ?? false;
+    message.reconciliationOptions = object.reconciliationOptions ?? false;
+    message.serviceAccounts = object.serviceAccounts ?? false;
+    message.multiCluster = object.multiCluster ?? false;
+    message.valuesSchema = object.valuesSchema ?? false;
+    message.updatePreview = object.updatePreview ?? false;
+    return message;
+  },
+};
+
 export interface PluginsService {
   /** GetConfiguredPlugins returns a map of short and longnames for the configured plugins. */
   GetConfiguredPlugins(
@@ -10,8 +10,12 @@ import {
   GetInstalledPackageDetailRequest,
   CreateInstalledPackageRequest,
   UpdateInstalledPackageRequest,
+  GetInstalledPackageUpdatePreviewRequest,
   DeleteInstalledPackageRequest,
   GetInstalledPackageResourceRefsRequest,
+  GetInstalledPackageRevisionsRequest,
+  RollbackInstalledPackageRequest,
+  CheckInstalledPackagePermissionsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
@@ -19,8 +23,12 @@ import {
   GetInstalledPackageDetailResponse,
   CreateInstalledPackageResponse,
   UpdateInstalledPackageResponse,
+  GetInstalledPackageUpdatePreviewResponse,
   DeleteInstalledPackageResponse,
   GetInstalledPackageResourceRefsResponse,
+  GetInstalledPackageRevisionsResponse,
+  RollbackInstalledPackageResponse,
+  CheckInstalledPackagePermissionsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import {
   AddPackageRepositoryRequest,
@@ -190,6 +198,11 @@ export interface FluxV2PackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  /** GetInstalledPackageUpdatePreview returns the changes to resources which would result from an update. */
+  GetInstalledPackageUpdatePreview(
+    request: DeepPartial<GetInstalledPackageUpdatePreviewRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdatePreviewResponse>;
   /** DeleteInstalledPackage deletes an installed package based on the request. */
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
@@ -203,6 +216,24 @@ export interface FluxV2PackagesService {
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  /** GetInstalledPackageRevisions returns the history of revisions of an installed package. */
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
+  /** RollbackInstalledPackage rolls back an installed package to a previous revision. */
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse>;
+  /**
+   * CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+   * when creating or updating an installed package.
+   */
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse>;
 }
 
 export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
@@ -217,8 +248,12 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpdatePreview = this.GetInstalledPackageUpdatePreview.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
+    this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
+    this.CheckInstalledPackagePermissions = this.CheckInstalledPackagePermissions.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -298,6 +333,17 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     );
   }
 
+  GetInstalledPackageUpdatePreview(
+    request: DeepPartial<GetInstalledPackageUpdatePreviewRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdatePreviewResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetInstalledPackageUpdatePreviewDesc,
+      GetInstalledPackageUpdatePreviewRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -319,6 +365,39 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
       metadata,
     );
   }
+
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceRollbackInstalledPackageDesc,
+      RollbackInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceCheckInstalledPackagePermissionsDesc,
+      CheckInstalledPackagePermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const FluxV2PackagesServiceDesc = {
@@ -479,6 +558,28 @@ export const FluxV2PackagesServiceUpdateInstalledPackageDesc: UnaryMethodDefinit
   } as any,
 };
 
+export const FluxV2PackagesServiceGetInstalledPackageUpdatePreviewDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpdatePreview",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpdatePreviewRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpdatePreviewResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const FluxV2PackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: FluxV2PackagesServiceDesc,
@@ -523,6 +624,72 @@ export const FluxV2PackagesServiceGetInstalledPackageResourceRefsDesc: UnaryMeth
   } as any,
 };
 
+export const FluxV2PackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageRevisions",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageRevisionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageRevisionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RollbackInstalledPackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RollbackInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RollbackInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceCheckInstalledPackagePermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "CheckInstalledPackagePermissions",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CheckInstalledPackagePermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...CheckInstalledPackagePermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export interface FluxV2RepositoriesService {
   /**
    * AddPackageRepository add an existing package repository to the set of ones already managed by the
@@ -11,8 +11,11 @@ import {
   GetInstalledPackageDetailRequest,
   CreateInstalledPackageRequest,
   UpdateInstalledPackageRequest,
+  GetInstalledPackageUpdatePreviewRequest,
   DeleteInstalledPackageRequest,
+  CheckInstalledPackagePermissionsRequest,
   GetInstalledPackageResourceRefsRequest,
+  GetInstalledPackageRevisionsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
@@ -20,8 +23,11 @@ import {
   GetInstalledPackageDetailResponse,
   CreateInstalledPackageResponse,
   UpdateInstalledPackageResponse,
+  GetInstalledPackageUpdatePreviewResponse,
   DeleteInstalledPackageResponse,
+  CheckInstalledPackagePermissionsResponse,
   GetInstalledPackageResourceRefsResponse,
+  GetInstalledPackageRevisionsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import { BrowserHeaders } from "browser-headers";
 
@@ -42,6 +48,13 @@ export interface InstalledPackageDetailCustomDataHelm {
   releaseRevision: number;
 }
 
+/**
+ * RollbackInstalledPackageRequest
+ *
+ * Request for the deprecated RollbackInstalledPackage RPC of the helm plugin.
+ * The kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageRequest,
+ * whose revision field replaces the release_revision, should be used instead.
+ */
 export interface RollbackInstalledPackageRequest {
   /**
    * Installed package reference
@@ -60,7 +73,7 @@ export interface RollbackInstalledPackageRequest {
 /**
  * RollbackInstalledPackageResponse
  *
- * Response for RollbackInstalledPackage
+ * Response for the deprecated RollbackInstalledPackage RPC of the helm plugin.
  */
 export interface RollbackInstalledPackageResponse {
   /**
@@ -303,16 +316,37 @@ export interface HelmPackagesService {
     request: DeepPartial<UpdateInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<UpdateInstalledPackageResponse>;
+  /** GetInstalledPackageUpdatePreview returns the changes to resources which would result from an update. */
+  GetInstalledPackageUpdatePreview(
+    request: DeepPartial<GetInstalledPackageUpdatePreviewRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdatePreviewResponse>;
   /** DeleteInstalledPackage deletes an installed package based on the request. */
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
-  /** RollbackInstalledPackage updates an installed package based on the request. */
+  /**
+   * RollbackInstalledPackage rolls back an installed package to a previous revision.
+   *
+   * Deprecated: use the RollbackInstalledPackage RPC of the core packages
+   * service, which takes a revision rather than a release_revision. This
+   * RPC will be removed in a future release.
+   *
+   * @deprecated
+   */
   RollbackInstalledPackage(
     request: DeepPartial<RollbackInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<RollbackInstalledPackageResponse>;
+  /**
+   * CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+   * when creating or updating an installed package.
+   */
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse>;
   /**
    * GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
    * an installed package.
@@ -321,6 +355,11 @@ export interface HelmPackagesService {
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  /** GetInstalledPackageRevisions returns the history of revisions of an installed package. */
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
 }
 
 export class HelmPackagesServiceClientImpl implements HelmPackagesService {
@@ -335,9 +374,12 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     this.GetInstalledPackageDetail = this.GetInstalledPackageDetail.bind(this);
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
+    this.GetInstalledPackageUpdatePreview = this.GetInstalledPackageUpdatePreview.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
+    this.CheckInstalledPackagePermissions = this.CheckInstalledPackagePermissions.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -417,6 +459,17 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  GetInstalledPackageUpdatePreview(
+    request: DeepPartial<GetInstalledPackageUpdatePreviewRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageUpdatePreviewResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetInstalledPackageUpdatePreviewDesc,
+      GetInstalledPackageUpdatePreviewRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   DeleteInstalledPackage(
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
@@ -439,6 +492,17 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceCheckInstalledPackagePermissionsDesc,
+      CheckInstalledPackagePermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetInstalledPackageResourceRefs(
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
@@ -449,6 +513,17 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
       metadata,
     );
   }
+
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const HelmPackagesServiceDesc = {
@@ -609,6 +684,28 @@ export const HelmPackagesServiceUpdateInstalledPackageDesc: UnaryMethodDefinitio
   } as any,
 };
 
+export const HelmPackagesServiceGetInstalledPackageUpdatePreviewDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageUpdatePreview",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageUpdatePreviewRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageUpdatePreviewResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "DeleteInstalledPackage",
   service: HelmPackagesServiceDesc,
@@ -653,6 +750,28 @@ export const HelmPackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinit
   } as any,
 };
 
+export const HelmPackagesServiceCheckInstalledPackagePermissionsDesc: UnaryMethodDefinitionish = {
+  methodName: "CheckInstalledPackagePermissions",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CheckInstalledPackagePermissionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...CheckInstalledPackagePermissionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceGetInstalledPackageResourceRefsDesc: UnaryMethodDefinitionish = {
   methodName: "GetInstalledPackageResourceRefs",
   service: HelmPackagesServiceDesc,
@@ -675,6 +794,28 @@ export const HelmPackagesServiceGetInstalledPackageResourceRefsDesc: UnaryMethod
   } as any,
 };
 
+export const HelmPackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageRevisions",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageRevisionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageRevisionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
@@ -13,6 +13,9 @@ import {
   UpdateInstalledPackageRequest,
   DeleteInstalledPackageRequest,
   GetInstalledPackageResourceRefsRequest,
+  GetInstalledPackageRevisionsRequest,
+  RollbackInstalledPackageRequest,
+  CheckInstalledPackagePermissionsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
@@ -22,6 +25,9 @@ import {
   UpdateInstalledPackageResponse,
   DeleteInstalledPackageResponse,
   GetInstalledPackageResourceRefsResponse,
+  GetInstalledPackageRevisionsResponse,
+  RollbackInstalledPackageResponse,
+  CheckInstalledPackagePermissionsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import { Plugin } from "../../../../../kubeappsapis/core/plugins/v1alpha1/plugins";
 import { BrowserHeaders } from "browser-headers";
@@ -338,6 +344,24 @@ export interface KappControllerPackagesService {
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  /** GetInstalledPackageRevisions returns the history of revisions of an installed package. */
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
+  /** RollbackInstalledPackage rolls back an installed package to a previous revision. */
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse>;
+  /**
+   * CheckInstalledPackagePermissions returns the actions which the user is not allowed to perform
+   * when creating or updating an installed package.
+   */
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse>;
 }
 
 export class KappControllerPackagesServiceClientImpl implements KappControllerPackagesService {
@@ -355,6 +379,9 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
+    this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
+    this.CheckInstalledPackagePermissions = this.CheckInstalledPackagePermissions.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -466,6 +493,39 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
       metadata,
     );
   }
+
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceRollbackInstalledPackageDesc,
+      RollbackInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  CheckInstalledPackagePermissions(
+    request: DeepPartial<CheckInstalledPackagePermissionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CheckInstalledPackagePermissionsResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceCheckInstalledPackagePermissionsDesc,
+      CheckInstalledPackagePermissionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const KappControllerPackagesServiceDesc = {
@@ -699,6 +759,74 @@ export const KappControllerPackagesServiceGetInstalledPackageResourceRefsDesc: U
     } as any,
   };
 
+export const KappControllerPackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "GetInstalledPackageRevisions",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return GetInstalledPackageRevisionsRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...GetInstalledPackageRevisionsResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
+export const KappControllerPackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RollbackInstalledPackage",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RollbackInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RollbackInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const KappControllerPackagesServiceCheckInstalledPackagePermissionsDesc: UnaryMethodDefinitionish =
+  {
+    methodName: "CheckInstalledPackagePermissions",
+    service: KappControllerPackagesServiceDesc,
+    requestStream: false,
+    responseStream: false,
+    requestType: {
+      serializeBinary() {
+        return CheckInstalledPackagePermissionsRequest.encode(this).finish();
+      },
+    } as any,
+    responseType: {
+      deserializeBinary(data: Uint8Array) {
+        return {
+          ...CheckInstalledPackagePermissionsResponse.decode(data),
+          toObject() {
+            return this;
+          },
+        };
+      },
+    } as any,
+  };
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
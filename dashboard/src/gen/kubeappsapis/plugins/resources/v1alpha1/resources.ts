@@ -7,6 +7,7 @@ import {
   ResourceRef,
   Context,
 } from "../../../../kubeappsapis/core/packages/v1alpha1/packages";
+import { Timestamp } from "../../../../google/protobuf/timestamp";
 import { Observable } from "rxjs";
 import { BrowserHeaders } from "browser-headers";
 import { share } from "rxjs/operators";
@@ -137,214 +138,2284 @@ export interface GetResourcesResponse {
    * metadata into this message as needed.
    */
   manifest: string;
+  /**
+   * Health
+   *
+   * The health of the resource evaluated from its current manifest, so that
+   * clients do not need to interpret the status of each kind of resource.
+   */
+  health?: ResourceHealth;
 }
 
 /**
- * GetServiceAccountNamesRequest
+ * ResourceHealth
  *
- * Request for GetServiceAccountNames
+ * The health of a resource, following the statuses computed by kstatus.
  */
-export interface GetServiceAccountNamesRequest {
+export interface ResourceHealth {
   /**
-   * Context
+   * Status
    *
-   * The context for which the service account names are being fetched.
+   * The health status of the resource.
    */
-  context?: Context;
+  status: ResourceHealth_HealthStatus;
+  /**
+   * Message
+   *
+   * A human readable message explaining the status, such as
+   * "Ready: 1/3".
+   */
+  message: string;
 }
 
 /**
- * GetServiceAccountNamesResponse
+ * HealthStatus
  *
- * Response for GetServiceAccountNames
+ * The health status of a resource.
  */
-export interface GetServiceAccountNamesResponse {
+export enum ResourceHealth_HealthStatus {
+  /** The health of the resource could not be determined. */
+  HEALTH_STATUS_UNSPECIFIED = 0,
+  /** The resource is fully reconciled and ready. */
+  HEALTH_STATUS_CURRENT = 1,
+  /** The resource is being reconciled, such as a Deployment rolling out. */
+  HEALTH_STATUS_IN_PROGRESS = 2,
+  /** The resource failed to reconcile and will not recover by itself. */
+  HEALTH_STATUS_FAILED = 3,
+  /** The resource is being deleted. */
+  HEALTH_STATUS_TERMINATING = 4,
+  /** The resource does not exist on the cluster. */
+  HEALTH_STATUS_NOT_FOUND = 5,
+  UNRECOGNIZED = -1,
+}
+
+export function resourceHealth_HealthStatusFromJSON(object: any): ResourceHealth_HealthStatus {
+  switch (object) {
+    case 0:
+    case "HEALTH_STATUS_UNSPECIFIED":
+      return ResourceHealth_HealthStatus.HEALTH_STATUS_UNSPECIFIED;
+    case 1:
+    case "HEALTH_STATUS_CURRENT":
+      return ResourceHealth_HealthStatus.HEALTH_STATUS_CURRENT;
+    case 2:
+    case "HEALTH_STATUS_IN_PROGRESS":
+      return ResourceHealth_HealthStatus.HEALTH_STATUS_IN_PROGRESS;
+    case 3:
+    case "HEALTH_STATUS_FAILED":
+      return ResourceHealth_HealthStatus.HEALTH_STATUS_FAILED;
+    case 4:
+    case "HEALTH_STATUS_TERMINATING":
+      return ResourceHealth_HealthStatus.HEALTH_STATUS_TERMINATING;
+    case 5:
+    case "HEALTH_STATUS_NOT_FOUND":
+      return ResourceHealth_HealthStatus.HEALTH_STATUS_NOT_FOUND;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ResourceHealth_HealthStatus.UNRECOGNIZED;
+  }
+}
+
+export function resourceHealth_HealthStatusToJSON(object: ResourceHealth_HealthStatus): string {
+  switch (object) {
+    case ResourceHealth_HealthStatus.HEALTH_STATUS_UNSPECIFIED:
+      return "HEALTH_STATUS_UNSPECIFIED";
+    case ResourceHealth_HealthStatus.HEALTH_STATUS_CURRENT:
+      return "HEALTH_STATUS_CURRENT";
+    case ResourceHealth_HealthStatus.HEALTH_STATUS_IN_PROGRESS:
+      return "HEALTH_STATUS_IN_PROGRESS";
+    case ResourceHealth_HealthStatus.HEALTH_STATUS_FAILED:
+      return "HEALTH_STATUS_FAILED";
+    case ResourceHealth_HealthStatus.HEALTH_STATUS_TERMINATING:
+      return "HEALTH_STATUS_TERMINATING";
+    case ResourceHealth_HealthStatus.HEALTH_STATUS_NOT_FOUND:
+      return "HEALTH_STATUS_NOT_FOUND";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * GetResourcesHealthRequest
+ *
+ * Request for GetResourcesHealth
+ */
+export interface GetResourcesHealthRequest {
   /**
-   * ServiceAccountNames
+   * InstalledPackageRef
    *
-   * The list of Service Account names.
+   * The installed package reference for which the health is evaluated.
    */
-  serviceaccountNames: string[];
+  installedPackageRef?: InstalledPackageReference;
 }
 
 /**
- * GetNamespaceNamesRequest
+ * GetResourcesHealthResponse
  *
- * Request for GetNamespaceNames
+ * Response for GetResourcesHealth
  */
-export interface GetNamespaceNamesRequest {
+export interface GetResourcesHealthResponse {
   /**
-   * Cluster
+   * Health
    *
-   * The context for which the namespace names are being fetched.  The service
-   * will attempt to list namespaces across the cluster, first with the users
-   * credential, then with a configured service account if available.
+   * The health of the installed package as a whole. It is failed if any
+   * resource failed, in progress if any resource is not yet current, and
+   * current once all resources are current.
    */
-  cluster: string;
+  health?: ResourceHealth;
+  /**
+   * ResourceHealths
+   *
+   * The health of each resource of the installed package.
+   */
+  resourceHealths: ResourceRefHealth[];
 }
 
 /**
- * GetNamespaceNamesResponse
+ * ResourceRefHealth
  *
- * Response for GetNamespaceNames
+ * The health of a single resource of an installed package.
  */
-export interface GetNamespaceNamesResponse {
+export interface ResourceRefHealth {
   /**
-   * NamespaceNames
+   * ResourceRef
    *
-   * The list of Namespace names.
+   * The reference to the resource.
    */
-  namespaceNames: string[];
+  resourceRef?: ResourceRef;
+  /**
+   * Health
+   *
+   * The health of the resource. The health of workloads also accounts for
+   * their pods, so that crash-looping containers are reported as failed.
+   */
+  health?: ResourceHealth;
 }
 
 /**
- * CreateNamespaceRequest
+ * GetResourcesDriftRequest
  *
- * Request for CreateNamespace
+ * Request for GetResourcesDrift
  */
-export interface CreateNamespaceRequest {
+export interface GetResourcesDriftRequest {
   /**
-   * Context
+   * InstalledPackageRef
    *
-   * The context of the namespace being created.
+   * The installed package reference for which the drift is computed.
    */
-  context?: Context;
+  installedPackageRef?: InstalledPackageReference;
 }
 
 /**
- * CreateNamespaceResponse
+ * GetResourcesDriftResponse
  *
- * Response for CreateNamespace
+ * Response for GetResourcesDrift
  */
-export interface CreateNamespaceResponse {}
+export interface GetResourcesDriftResponse {
+  /**
+   * Drifted
+   *
+   * Whether any of the resources drifted from its rendered manifest.
+   */
+  drifted: boolean;
+  /**
+   * FieldsCompared
+   *
+   * Whether the fields of the resources were compared. They are not when
+   * the plugin of the installed package does not return the rendered
+   * manifest, in which case only missing resources are reported.
+   */
+  fieldsCompared: boolean;
+  /**
+   * ResourceDrifts
+   *
+   * The drift of each resource of the installed package.
+   */
+  resourceDrifts: ResourceDrift[];
+}
 
 /**
- * CheckNamespaceExistsRequest
+ * ResourceDrift
  *
- * Request for CheckNamespaceExists
+ * The drift of a single resource from its rendered manifest.
  */
-export interface CheckNamespaceExistsRequest {
+export interface ResourceDrift {
   /**
-   * Context
+   * ResourceRef
    *
-   * The context of the namespace being checked for existence.
+   * The reference to the resource.
    */
-  context?: Context;
+  resourceRef?: ResourceRef;
+  /**
+   * DriftType
+   *
+   * The type of the drift of the resource.
+   */
+  driftType: ResourceDrift_DriftType;
+  /**
+   * FieldDrifts
+   *
+   * The fields which drifted, for changed resources. Fields populated by
+   * the API server, such as the status or the resource version, are ignored.
+   */
+  fieldDrifts: FieldDrift[];
 }
 
 /**
- * CheckNamespaceExistsResponse
+ * DriftType
  *
- * Response for CheckNamespaceExists
+ * The type of the drift of the resource.
  */
-export interface CheckNamespaceExistsResponse {
-  exists: boolean;
+export enum ResourceDrift_DriftType {
+  DRIFT_TYPE_UNSPECIFIED = 0,
+  /** The live resource matches its rendered manifest. */
+  DRIFT_TYPE_NONE = 1,
+  /** The resource was deleted from the cluster. */
+  DRIFT_TYPE_MISSING = 2,
+  /** Some fields of the live resource were changed, added or deleted. */
+  DRIFT_TYPE_CHANGED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function resourceDrift_DriftTypeFromJSON(object: any): ResourceDrift_DriftType {
+  switch (object) {
+    case 0:
+    case "DRIFT_TYPE_UNSPECIFIED":
+      return ResourceDrift_DriftType.DRIFT_TYPE_UNSPECIFIED;
+    case 1:
+    case "DRIFT_TYPE_NONE":
+      return ResourceDrift_DriftType.DRIFT_TYPE_NONE;
+    case 2:
+    case "DRIFT_TYPE_MISSING":
+      return ResourceDrift_DriftType.DRIFT_TYPE_MISSING;
+    case 3:
+    case "DRIFT_TYPE_CHANGED":
+      return ResourceDrift_DriftType.DRIFT_TYPE_CHANGED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ResourceDrift_DriftType.UNRECOGNIZED;
+  }
+}
+
+export function resourceDrift_DriftTypeToJSON(object: ResourceDrift_DriftType): string {
+  switch (object) {
+    case ResourceDrift_DriftType.DRIFT_TYPE_UNSPECIFIED:
+      return "DRIFT_TYPE_UNSPECIFIED";
+    case ResourceDrift_DriftType.DRIFT_TYPE_NONE:
+      return "DRIFT_TYPE_NONE";
+    case ResourceDrift_DriftType.DRIFT_TYPE_MISSING:
+      return "DRIFT_TYPE_MISSING";
+    case ResourceDrift_DriftType.DRIFT_TYPE_CHANGED:
+      return "DRIFT_TYPE_CHANGED";
+    default:
+      return "UNKNOWN";
+  }
 }
 
 /**
- * CreateSecretRequest
+ * FieldDrift
  *
- * Request for CreateSecret
+ * The drift of a single field of a resource.
  */
-export interface CreateSecretRequest {
+export interface FieldDrift {
   /**
-   * Context
+   * Path
    *
-   * The context of the secret being created.
+   * The path of the field in the resource, such as
+   * "spec.template.spec.containers[0].image".
    */
-  context?: Context;
+  path: string;
   /**
-   * Type
+   * FieldDriftType
    *
-   * The type of the secret. Valid values are defined by the Type enumeration.
+   * The type of the drift of the field.
    */
-  type: SecretType;
+  fieldDriftType: FieldDrift_FieldDriftType;
   /**
-   * Name
+   * ExpectedValue
    *
-   * The name of the secret.
+   * The JSON value of the field in the rendered manifest, if any. The values
+   * of Secrets are omitted.
    */
-  name: string;
+  expectedValue: string;
   /**
-   * StringData
+   * LiveValue
    *
-   * The map of keys and values. Note that we use StringData here so that
-   * Kubernetes handles the base64 encoding of the key values for us.
-   * See https://kubernetes.io/docs/concepts/configuration/secret/#overview-of-secrets
+   * The JSON value of the field in the live resource, if any. The values of
+   * Secrets are omitted.
    */
-  stringData: { [key: string]: string };
+  liveValue: string;
 }
 
-export interface CreateSecretRequest_StringDataEntry {
-  key: string;
-  value: string;
+/**
+ * FieldDriftType
+ *
+ * The type of the drift of the field.
+ */
+export enum FieldDrift_FieldDriftType {
+  FIELD_DRIFT_TYPE_UNSPECIFIED = 0,
+  /** The live value differs from the rendered one. */
+  FIELD_DRIFT_TYPE_CHANGED = 1,
+  /**
+   * The field is not rendered but was set on the live resource, by a
+   * client rather than as a default of the API server.
+   */
+  FIELD_DRIFT_TYPE_EXTRA = 2,
+  /** The rendered field was deleted from the live resource. */
+  FIELD_DRIFT_TYPE_DELETED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function fieldDrift_FieldDriftTypeFromJSON(object: any): FieldDrift_FieldDriftType {
+  switch (object) {
+    case 0:
+    case "FIELD_DRIFT_TYPE_UNSPECIFIED":
+      return FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_UNSPECIFIED;
+    case 1:
+    case "FIELD_DRIFT_TYPE_CHANGED":
+      return FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_CHANGED;
+    case 2:
+    case "FIELD_DRIFT_TYPE_EXTRA":
+      return FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_EXTRA;
+    case 3:
+    case "FIELD_DRIFT_TYPE_DELETED":
+      return FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_DELETED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return FieldDrift_FieldDriftType.UNRECOGNIZED;
+  }
+}
+
+export function fieldDrift_FieldDriftTypeToJSON(object: FieldDrift_FieldDriftType): string {
+  switch (object) {
+    case FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_UNSPECIFIED:
+      return "FIELD_DRIFT_TYPE_UNSPECIFIED";
+    case FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_CHANGED:
+      return "FIELD_DRIFT_TYPE_CHANGED";
+    case FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_EXTRA:
+      return "FIELD_DRIFT_TYPE_EXTRA";
+    case FieldDrift_FieldDriftType.FIELD_DRIFT_TYPE_DELETED:
+      return "FIELD_DRIFT_TYPE_DELETED";
+    default:
+      return "UNKNOWN";
+  }
 }
 
 /**
- * CreateSecretResponse
+ * GetPodLogsRequest
  *
- * Response for CreateSecret
+ * Request for GetPodLogs
  */
-export interface CreateSecretResponse {}
+export interface GetPodLogsRequest {
+  /**
+   * InstalledPackageRef
+   *
+   * The installed package reference for which the pod logs are streamed.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * PodName
+   *
+   * An optional name of a pod of the installed package, to only stream its
+   * logs rather than the logs of all the pods of the installed package.
+   */
+  podName: string;
+  /**
+   * Container
+   *
+   * An optional container name, to only stream the logs of this container
+   * rather than the logs of all the containers of the pods.
+   */
+  container: string;
+  /**
+   * Follow
+   *
+   * When true, the stream remains open with new log lines being sent as
+   * they are written by the containers.
+   */
+  follow: boolean;
+  /**
+   * SinceTime
+   *
+   * An optional time from which the logs are returned.
+   */
+  sinceTime?: Date;
+  /**
+   * TailLines
+   *
+   * An optional number of lines from the end of the logs of each container
+   * to return. All the lines are returned when unset or 0.
+   */
+  tailLines: number;
+}
 
 /**
- * GetSecretNamesRequest
+ * GetPodLogsResponse
  *
- * Request for GetSecretNames
+ * Response for GetPodLogs, with a single log line.
  */
-export interface GetSecretNamesRequest {
+export interface GetPodLogsResponse {
   /**
-   * Context
+   * PodName
    *
-   * The context for which the secret names are being fetched.
+   * The name of the pod which wrote the log line.
    */
-  context?: Context;
+  podName: string;
+  /**
+   * Namespace
+   *
+   * The namespace of the pod.
+   */
+  namespace: string;
+  /**
+   * Container
+   *
+   * The name of the container which wrote the log line.
+   */
+  container: string;
+  /**
+   * Line
+   *
+   * The log line, without its trailing newline.
+   */
+  line: string;
 }
 
 /**
- * GetSecretNamesResponse
+ * GetResourcesEventsRequest
  *
- * Response for GetSecretNames
+ * Request for GetResourcesEvents
  */
-export interface GetSecretNamesResponse {
+export interface GetResourcesEventsRequest {
   /**
-   * SecretNames
+   * InstalledPackageRef
    *
-   * The list of Service Account names.
+   * The installed package reference for which the events are returned.
    */
-  secretNames: { [key: string]: SecretType };
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Watch
+   *
+   * When true, the stream remains open with events being sent as they are
+   * created or updated. Only the pods and ReplicaSets existing when the
+   * request is received are watched.
+   */
+  watch: boolean;
 }
 
-export interface GetSecretNamesResponse_SecretNamesEntry {
-  key: string;
-  value: SecretType;
+/**
+ * GetResourcesEventsResponse
+ *
+ * Response for GetResourcesEvents, with a single event.
+ */
+export interface GetResourcesEventsResponse {
+  /**
+   * ResourceRef
+   *
+   * The reference to the resource of the installed package to which the
+   * event relates.
+   */
+  resourceRef?: ResourceRef;
+  /**
+   * InvolvedObjectRef
+   *
+   * The reference to the object of the event, which is either the resource
+   * itself or one of its pods or ReplicaSets.
+   */
+  involvedObjectRef?: ResourceRef;
+  /**
+   * Type
+   *
+   * The type of the event, such as "Normal" or "Warning".
+   */
+  type: string;
+  /**
+   * Reason
+   *
+   * The reason of the event, such as "FailedScheduling".
+   */
+  reason: string;
+  /**
+   * Message
+   *
+   * The human readable message of the event.
+   */
+  message: string;
+  /**
+   * Count
+   *
+   * The number of times the event occurred.
+   */
+  count: number;
+  /**
+   * FirstTimestamp
+   *
+   * The time at which the event first occurred.
+   */
+  firstTimestamp?: Date;
+  /**
+   * LastTimestamp
+   *
+   * The time at which the event most recently occurred.
+   */
+  lastTimestamp?: Date;
+  /**
+   * Source
+   *
+   * The component which reported the event, such as "kubelet".
+   */
+  source: string;
 }
 
-function createBaseGetResourcesRequest(): GetResourcesRequest {
+/**
+ * GetResourcesTopologyRequest
+ *
+ * Request for GetResourcesTopology
+ */
+export interface GetResourcesTopologyRequest {
+  /**
+   * InstalledPackageRef
+   *
+   * The installed package reference for which the topology is returned.
+   */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * GetResourcesTopologyResponse
+ *
+ * Response for GetResourcesTopology
+ */
+export interface GetResourcesTopologyResponse {
+  /**
+   * ResourceNodes
+   *
+   * A node for each resource of the installed package, with its dependents
+   * as children.
+   */
+  resourceNodes: ResourceNode[];
+}
+
+/**
+ * ResourceNode
+ *
+ * A resource with the resources depending on it.
+ */
+export interface ResourceNode {
+  /**
+   * ResourceRef
+   *
+   * The reference to the resource.
+   */
+  resourceRef?: ResourceRef;
+  /**
+   * Health
+   *
+   * The health of the resource.
+   */
+  health?: ResourceHealth;
+  /**
+   * Children
+   *
+   * The resources depending on this one: the ReplicaSets of a Deployment,
+   * the pods of a ReplicaSet, StatefulSet, DaemonSet or Job, the Jobs of a
+   * CronJob, the PersistentVolumeClaims of a StatefulSet and the Endpoints
+   * of a Service.
+   */
+  children: ResourceNode[];
+}
+
+/**
+ * ScaleResourceRequest
+ *
+ * Request for ScaleResource
+ */
+export interface ScaleResourceRequest {
+  /**
+   * InstalledPackageRef
+   *
+   * The installed package reference to which the resource belongs.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * ResourceRef
+   *
+   * The reference to the Deployment or StatefulSet to scale.
+   */
+  resourceRef?: ResourceRef;
+  /**
+   * Replicas
+   *
+   * The desired number of replicas.
+   */
+  replicas: number;
+}
+
+/**
+ * ScaleResourceResponse
+ *
+ * Response for ScaleResource
+ */
+export interface ScaleResourceResponse {
+  /**
+   * State
+   *
+   * The state of the resource once scaled.
+   */
+  state?: WorkloadState;
+}
+
+/**
+ * RestartResourceRequest
+ *
+ * Request for RestartResource
+ */
+export interface RestartResourceRequest {
+  /**
+   * InstalledPackageRef
+   *
+   * The installed package reference to which the resource belongs.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * ResourceRef
+   *
+   * The reference to the Deployment, StatefulSet or DaemonSet to restart.
+   */
+  resourceRef?: ResourceRef;
+}
+
+/**
+ * RestartResourceResponse
+ *
+ * Response for RestartResource
+ */
+export interface RestartResourceResponse {
+  /**
+   * State
+   *
+   * The state of the resource once the restart is triggered.
+   */
+  state?: WorkloadState;
+  /**
+   * RestartedAt
+   *
+   * The time of the restart, set in the pod template of the resource.
+   */
+  restartedAt?: Date;
+}
+
+/**
+ * WorkloadState
+ *
+ * The observed state of a workload after an operation. The controller of
+ * the workload may not have observed the operation yet, in which case the
+ * health is in progress until it does.
+ */
+export interface WorkloadState {
+  /**
+   * ResourceRef
+   *
+   * The reference to the workload.
+   */
+  resourceRef?: ResourceRef;
+  /**
+   * DesiredReplicas
+   *
+   * The desired number of replicas, or of scheduled pods for a DaemonSet.
+   */
+  desiredReplicas: number;
+  /**
+   * Replicas
+   *
+   * The current number of replicas, or of scheduled pods for a DaemonSet.
+   */
+  replicas: number;
+  /**
+   * ReadyReplicas
+   *
+   * The number of ready replicas.
+   */
+  readyReplicas: number;
+  /**
+   * UpdatedReplicas
+   *
+   * The number of replicas running the latest pod template.
+   */
+  updatedReplicas: number;
+  /**
+   * Generation
+   *
+   * The generation of the workload spec.
+   */
+  generation: number;
+  /**
+   * ObservedGeneration
+   *
+   * The latest generation observed by the controller of the workload.
+   */
+  observedGeneration: number;
+  /**
+   * Health
+   *
+   * The health of the workload.
+   */
+  health?: ResourceHealth;
+}
+
+/**
+ * GetServiceAccountNamesRequest
+ *
+ * Request for GetServiceAccountNames
+ */
+export interface GetServiceAccountNamesRequest {
+  /**
+   * Context
+   *
+   * The context for which the service account names are being fetched.
+   */
+  context?: Context;
+}
+
+/**
+ * GetServiceAccountNamesResponse
+ *
+ * Response for GetServiceAccountNames
+ */
+export interface GetServiceAccountNamesResponse {
+  /**
+   * ServiceAccountNames
+   *
+   * The list of Service Account names.
+   */
+  serviceaccountNames: string[];
+}
+
+/**
+ * GetNamespaceNamesRequest
+ *
+ * Request for GetNamespaceNames
+ */
+export interface GetNamespaceNamesRequest {
+  /**
+   * Cluster
+   *
+   * The context for which the namespace names are being fetched.  The service
+   * will attempt to list namespaces across the cluster, first with the users
+   * credential, then with a configured service account if available.
+   */
+  cluster: string;
+}
+
+/**
+ * GetNamespaceNamesResponse
+ *
+ * Response for GetNamespaceNames
+ */
+export interface GetNamespaceNamesResponse {
+  /**
+   * NamespaceNames
+   *
+   * The list of Namespace names.
+   */
+  namespaceNames: string[];
+}
+
+/**
+ * CreateNamespaceRequest
+ *
+ * Request for CreateNamespace
+ */
+export interface CreateNamespaceRequest {
+  /**
+   * Context
+   *
+   * The context of the namespace being created.
+   */
+  context?: Context;
+}
+
+/**
+ * CreateNamespaceResponse
+ *
+ * Response for CreateNamespace
+ */
+export interface CreateNamespaceResponse {}
+
+/**
+ * CheckNamespaceExistsRequest
+ *
+ * Request for CheckNamespaceExists
+ */
+export interface CheckNamespaceExistsRequest {
+  /**
+   * Context
+   *
+   * The context of the namespace being checked for existence.
+   */
+  context?: Context;
+}
+
+/**
+ * CheckNamespaceExistsResponse
+ *
+ * Response for CheckNamespaceExists
+ */
+export interface CheckNamespaceExistsResponse {
+  exists: boolean;
+}
+
+/**
+ * CreateSecretRequest
+ *
+ * Request for CreateSecret
+ */
+export interface CreateSecretRequest {
+  /**
+   * Context
+   *
+   * The context of the secret being created.
+   */
+  context?: Context;
+  /**
+   * Type
+   *
+   * The type of the secret. Valid values are defined by the Type enumeration.
+   */
+  type: SecretType;
+  /**
+   * Name
+   *
+   * The name of the secret.
+   */
+  name: string;
+  /**
+   * StringData
+   *
+   * The map of keys and values. Note that we use StringData here so that
+   * Kubernetes handles the base64 encoding of the key values for us.
+   * See https://kubernetes.io/docs/concepts/configuration/secret/#overview-of-secrets
+   */
+  stringData: { [key: string]: string };
+}
+
+export interface CreateSecretRequest_StringDataEntry {
+  key: string;
+  value: string;
+}
+
+/**
+ * CreateSecretResponse
+ *
+ * Response for CreateSecret
+ */
+export interface CreateSecretResponse {}
+
+/**
+ * GetSecretNamesRequest
+ *
+ * Request for GetSecretNames
+ */
+export interface GetSecretNamesRequest {
+  /**
+   * Context
+   *
+   * The context for which the secret names are being fetched.
+   */
+  context?: Context;
+}
+
+/**
+ * GetSecretNamesResponse
+ *
+ * Response for GetSecretNames
+ */
+export interface GetSecretNamesResponse {
+  /**
+   * SecretNames
+   *
+   * The list of Service Account names.
+   */
+  secretNames: { [key: string]: SecretType };
+}
+
+export interface GetSecretNamesResponse_SecretNamesEntry {
+  key: string;
+  value: SecretType;
+}
+
+function createBaseGetResourcesRequest(): GetResourcesRequest {
   return { installedPackageRef: undefined, resourceRefs: [], watch: false };
 }
 
-export const GetResourcesRequest = {
-  encode(message: GetResourcesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const GetResourcesRequest = {
+  encode(message: GetResourcesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    for (const v of message.resourceRefs) {
+      ResourceRef.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.watch === true) {
+      writer.uint32(24).bool(message.watch);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.resourceRefs.push(ResourceRef.decode(reader, reader.uint32()));
+          break;
+        case 3:
+          message.watch = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      resourceRefs: Array.isArray(object?.resourceRefs)
+        ? object.resourceRefs.map((e: any) => ResourceRef.fromJSON(e))
+        : [],
+      watch: isSet(object.watch) ? Boolean(object.watch) : false,
+    };
+  },
+
+  toJSON(message: GetResourcesRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    if (message.resourceRefs) {
+      obj.resourceRefs = message.resourceRefs.map(e => (e ? ResourceRef.toJSON(e) : undefined));
+    } else {
+      obj.resourceRefs = [];
+    }
+    message.watch !== undefined && (obj.watch = message.watch);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesRequest>, I>>(
+    object: I,
+  ): GetResourcesRequest {
+    const message = createBaseGetResourcesRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.resourceRefs = object.resourceRefs?.map(e => ResourceRef.fromPartial(e)) || [];
+    message.watch = object.watch ?? false;
+    return message;
+  },
+};
+
+function createBaseGetResourcesResponse(): GetResourcesResponse {
+  return { resourceRef: undefined, manifest: "", health: undefined };
+}
+
+export const GetResourcesResponse = {
+  encode(message: GetResourcesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.manifest !== "") {
+      writer.uint32(18).string(message.manifest);
+    }
+    if (message.health !== undefined) {
+      ResourceHealth.encode(message.health, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.manifest = reader.string();
+          break;
+        case 3:
+          message.health = ResourceHealth.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesResponse {
+    return {
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      manifest: isSet(object.manifest) ? String(object.manifest) : "",
+      health: isSet(object.health) ? ResourceHealth.fromJSON(object.health) : undefined,
+    };
+  },
+
+  toJSON(message: GetResourcesResponse): unknown {
+    const obj: any = {};
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    message.health !== undefined &&
+      (obj.health = message.health ? ResourceHealth.toJSON(message.health) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesResponse>, I>>(
+    object: I,
+  ): GetResourcesResponse {
+    const message = createBaseGetResourcesResponse();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.manifest = object.manifest ?? "";
+    message.health =
+      object.health !== undefined && object.health !== null
+        ? ResourceHealth.fromPartial(object.health)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseResourceHealth(): ResourceHealth {
+  return { status: 0, message: "" };
+}
+
+export const ResourceHealth = {
+  encode(message: ResourceHealth, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.status !== 0) {
+      writer.uint32(8).int32(message.status);
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceHealth {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceHealth();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = reader.int32() as any;
+          break;
+        case 2:
+          message.message = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceHealth {
+    return {
+      status: isSet(object.status) ? resourceHealth_HealthStatusFromJSON(object.status) : 0,
+      message: isSet(object.message) ? String(object.message) : "",
+    };
+  },
+
+  toJSON(message: ResourceHealth): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = resourceHealth_HealthStatusToJSON(message.status));
+    message.message !== undefined && (obj.message = message.message);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResourceHealth>, I>>(object: I): ResourceHealth {
+    const message = createBaseResourceHealth();
+    message.status = object.status ?? 0;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseGetResourcesHealthRequest(): GetResourcesHealthRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const GetResourcesHealthRequest = {
+  encode(message: GetResourcesHealthRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesHealthRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesHealthRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesHealthRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetResourcesHealthRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesHealthRequest>, I>>(
+    object: I,
+  ): GetResourcesHealthRequest {
+    const message = createBaseGetResourcesHealthRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetResourcesHealthResponse(): GetResourcesHealthResponse {
+  return { health: undefined, resourceHealths: [] };
+}
+
+export const GetResourcesHealthResponse = {
+  encode(
+    message: GetResourcesHealthResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.health !== undefined) {
+      ResourceHealth.encode(message.health, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.resourceHealths) {
+      ResourceRefHealth.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesHealthResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesHealthResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.health = ResourceHealth.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.resourceHealths.push(ResourceRefHealth.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesHealthResponse {
+    return {
+      health: isSet(object.health) ? ResourceHealth.fromJSON(object.health) : undefined,
+      resourceHealths: Array.isArray(object?.resourceHealths)
+        ? object.resourceHealths.map((e: any) => ResourceRefHealth.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GetResourcesHealthResponse): unknown {
+    const obj: any = {};
+    message.health !== undefined &&
+      (obj.health = message.health ? ResourceHealth.toJSON(message.health) : undefined);
+    if (message.resourceHealths) {
+      obj.resourceHealths = message.resourceHealths.map(e =>
+        e ? ResourceRefHealth.toJSON(e) : undefined,
+      );
+    } else {
+      obj.resourceHealths = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesHealthResponse>, I>>(
+    object: I,
+  ): GetResourcesHealthResponse {
+    const message = createBaseGetResourcesHealthResponse();
+    message.health =
+      object.health !== undefined && object.health !== null
+        ? ResourceHealth.fromPartial(object.health)
+        : undefined;
+    message.resourceHealths =
+      object.resourceHealths?.map(e => ResourceRefHealth.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseResourceRefHealth(): ResourceRefHealth {
+  return { resourceRef: undefined, health: undefined };
+}
+
+export const ResourceRefHealth = {
+  encode(message: ResourceRefHealth, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.health !== undefined) {
+      ResourceHealth.encode(message.health, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceRefHealth {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceRefHealth();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.health = ResourceHealth.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceRefHealth {
+    return {
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      health: isSet(object.health) ? ResourceHealth.fromJSON(object.health) : undefined,
+    };
+  },
+
+  toJSON(message: ResourceRefHealth): unknown {
+    const obj: any = {};
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.health !== undefined &&
+      (obj.health = message.health ? ResourceHealth.toJSON(message.health) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResourceRefHealth>, I>>(object: I): ResourceRefHealth {
+    const message = createBaseResourceRefHealth();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.health =
+      object.health !== undefined && object.health !== null
+        ? ResourceHealth.fromPartial(object.health)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetResourcesDriftRequest(): GetResourcesDriftRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const GetResourcesDriftRequest = {
+  encode(message: GetResourcesDriftRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesDriftRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesDriftRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesDriftRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetResourcesDriftRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesDriftRequest>, I>>(
+    object: I,
+  ): GetResourcesDriftRequest {
+    const message = createBaseGetResourcesDriftRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetResourcesDriftResponse(): GetResourcesDriftResponse {
+  return { drifted: false, fieldsCompared: false, resourceDrifts: [] };
+}
+
+export const GetResourcesDriftResponse = {
+  encode(message: GetResourcesDriftResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.drifted === true) {
+      writer.uint32(8).bool(message.drifted);
+    }
+    if (message.fieldsCompared === true) {
+      writer.uint32(16).bool(message.fieldsCompared);
+    }
+    for (const v of message.resourceDrifts) {
+      ResourceDrift.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesDriftResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesDriftResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.drifted = reader.bool();
+          break;
+        case 2:
+          message.fieldsCompared = reader.bool();
+          break;
+        case 3:
+          message.resourceDrifts.push(ResourceDrift.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesDriftResponse {
+    return {
+      drifted: isSet(object.drifted) ? Boolean(object.drifted) : false,
+      fieldsCompared: isSet(object.fieldsCompared) ? Boolean(object.fieldsCompared) : false,
+      resourceDrifts: Array.isArray(object?.resourceDrifts)
+        ? object.resourceDrifts.map((e: any) => ResourceDrift.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GetResourcesDriftResponse): unknown {
+    const obj: any = {};
+    message.drifted !== undefined && (obj.drifted = message.drifted);
+    message.fieldsCompared !== undefined && (obj.fieldsCompared = message.fieldsCompared);
+    if (message.resourceDrifts) {
+      obj.resourceDrifts = message.resourceDrifts.map(e =>
+        e ? ResourceDrift.toJSON(e) : undefined,
+      );
+    } else {
+      obj.resourceDrifts = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesDriftResponse>, I>>(
+    object: I,
+  ): GetResourcesDriftResponse {
+    const message = createBaseGetResourcesDriftResponse();
+    message.drifted = object.drifted ?? false;
+    message.fieldsCompared = object.fieldsCompared ?? false;
+    message.resourceDrifts = object.resourceDrifts?.map(e => ResourceDrift.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseResourceDrift(): ResourceDrift {
+  return { resourceRef: undefined, driftType: 0, fieldDrifts: [] };
+}
+
+export const ResourceDrift = {
+  encode(message: ResourceDrift, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.driftType !== 0) {
+      writer.uint32(16).int32(message.driftType);
+    }
+    for (const v of message.fieldDrifts) {
+      FieldDrift.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceDrift {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceDrift();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.driftType = reader.int32() as any;
+          break;
+        case 3:
+          message.fieldDrifts.push(FieldDrift.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceDrift {
+    return {
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      driftType: isSet(object.driftType) ? resourceDrift_DriftTypeFromJSON(object.driftType) : 0,
+      fieldDrifts: Array.isArray(object?.fieldDrifts)
+        ? object.fieldDrifts.map((e: any) => FieldDrift.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ResourceDrift): unknown {
+    const obj: any = {};
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.driftType !== undefined &&
+      (obj.driftType = resourceDrift_DriftTypeToJSON(message.driftType));
+    if (message.fieldDrifts) {
+      obj.fieldDrifts = message.fieldDrifts.map(e => (e ? FieldDrift.toJSON(e) : undefined));
+    } else {
+      obj.fieldDrifts = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResourceDrift>, I>>(object: I): ResourceDrift {
+    const message = createBaseResourceDrift();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.driftType = object.driftType ?? 0;
+    message.fieldDrifts = object.fieldDrifts?.map(e => FieldDrift.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseFieldDrift(): FieldDrift {
+  return { path: "", fieldDriftType: 0, expectedValue: "", liveValue: "" };
+}
+
+export const FieldDrift = {
+  encode(message: FieldDrift, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.path !== "") {
+      writer.uint32(10).string(message.path);
+    }
+    if (message.fieldDriftType !== 0) {
+      writer.uint32(16).int32(message.fieldDriftType);
+    }
+    if (message.expectedValue !== "") {
+      writer.uint32(26).string(message.expectedValue);
+    }
+    if (message.liveValue !== "") {
+      writer.uint32(34).string(message.liveValue);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FieldDrift {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFieldDrift();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.path = reader.string();
+          break;
+        case 2:
+          message.fieldDriftType = reader.int32() as any;
+          break;
+        case 3:
+          message.expectedValue = reader.string();
+          break;
+        case 4:
+          message.liveValue = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FieldDrift {
+    return {
+      path: isSet(object.path) ? String(object.path) : "",
+      fieldDriftType: isSet(object.fieldDriftType)
+        ? fieldDrift_FieldDriftTypeFromJSON(object.fieldDriftType)
+        : 0,
+      expectedValue: isSet(object.expectedValue) ? String(object.expectedValue) : "",
+      liveValue: isSet(object.liveValue) ? String(object.liveValue) : "",
+    };
+  },
+
+  toJSON(message: FieldDrift): unknown {
+    const obj: any = {};
+    message.path !== undefined && (obj.path = message.path);
+    message.fieldDriftType !== undefined &&
+      (obj.fieldDriftType = fieldDrift_FieldDriftTypeToJSON(message.fieldDriftType));
+    message.expectedValue !== undefined && (obj.expectedValue = message.expectedValue);
+    message.liveValue !== undefined && (obj.liveValue = message.liveValue);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<FieldDrift>, I>>(object: I): FieldDrift {
+    const message = createBaseFieldDrift();
+    message.path = object.path ?? "";
+    message.fieldDriftType = object.fieldDriftType ?? 0;
+    message.expectedValue = object.expectedValue ?? "";
+    message.liveValue = object.liveValue ?? "";
+    return message;
+  },
+};
+
+function createBaseGetPodLogsRequest(): GetPodLogsRequest {
+  return {
+    installedPackageRef: undefined,
+    podName: "",
+    container: "",
+    follow: false,
+    sinceTime: undefined,
+    tailLines: 0,
+  };
+}
+
+export const GetPodLogsRequest = {
+  encode(message: GetPodLogsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.podName !== "") {
+      writer.uint32(18).string(message.podName);
+    }
+    if (message.container !== "") {
+      writer.uint32(26).string(message.container);
+    }
+    if (message.follow === true) {
+      writer.uint32(32).bool(message.follow);
+    }
+    if (message.sinceTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.sinceTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.tailLines !== 0) {
+      writer.uint32(48).int64(message.tailLines);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetPodLogsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetPodLogsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.podName = reader.string();
+          break;
+        case 3:
+          message.container = reader.string();
+          break;
+        case 4:
+          message.follow = reader.bool();
+          break;
+        case 5:
+          message.sinceTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 6:
+          message.tailLines = longToNumber(reader.int64() as Long);
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetPodLogsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      podName: isSet(object.podName) ? String(object.podName) : "",
+      container: isSet(object.container) ? String(object.container) : "",
+      follow: isSet(object.follow) ? Boolean(object.follow) : false,
+      sinceTime: isSet(object.sinceTime) ? fromJsonTimestamp(object.sinceTime) : undefined,
+      tailLines: isSet(object.tailLines) ? Number(object.tailLines) : 0,
+    };
+  },
+
+  toJSON(message: GetPodLogsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.podName !== undefined && (obj.podName = message.podName);
+    message.container !== undefined && (obj.container = message.container);
+    message.follow !== undefined && (obj.follow = message.follow);
+    message.sinceTime !== undefined && (obj.sinceTime = message.sinceTime.toISOString());
+    message.tailLines !== undefined && (obj.tailLines = Math.round(message.tailLines));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetPodLogsRequest>, I>>(object: I): GetPodLogsRequest {
+    const message = createBaseGetPodLogsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.podName = object.podName ?? "";
+    message.container = object.container ?? "";
+    message.follow = object.follow ?? false;
+    message.sinceTime = object.sinceTime ?? undefined;
+    message.tailLines = object.tailLines ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetPodLogsResponse(): GetPodLogsResponse {
+  return { podName: "", namespace: "", container: "", line: "" };
+}
+
+export const GetPodLogsResponse = {
+  encode(message: GetPodLogsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.podName !== "") {
+      writer.uint32(10).string(message.podName);
+    }
+    if (message.namespace !== "") {
+      writer.uint32(18).string(message.namespace);
+    }
+    if (message.container !== "") {
+      writer.uint32(26).string(message.container);
+    }
+    if (message.line !== "") {
+      writer.uint32(34).string(message.line);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetPodLogsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetPodLogsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.podName = reader.string();
+          break;
+        case 2:
+          message.namespace = reader.string();
+          break;
+        case 3:
+          message.container = reader.string();
+          break;
+        case 4:
+          message.line = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetPodLogsResponse {
+    return {
+      podName: isSet(object.podName) ? String(object.podName) : "",
+      namespace: isSet(object.namespace) ? String(object.namespace) : "",
+      container: isSet(object.container) ? String(object.container) : "",
+      line: isSet(object.line) ? String(object.line) : "",
+    };
+  },
+
+  toJSON(message: GetPodLogsResponse): unknown {
+    const obj: any = {};
+    message.podName !== undefined && (obj.podName = message.podName);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
+    message.container !== undefined && (obj.container = message.container);
+    message.line !== undefined && (obj.line = message.line);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetPodLogsResponse>, I>>(object: I): GetPodLogsResponse {
+    const message = createBaseGetPodLogsResponse();
+    message.podName = object.podName ?? "";
+    message.namespace = object.namespace ?? "";
+    message.container = object.container ?? "";
+    message.line = object.line ?? "";
+    return message;
+  },
+};
+
+function createBaseGetResourcesEventsRequest(): GetResourcesEventsRequest {
+  return { installedPackageRef: undefined, watch: false };
+}
+
+export const GetResourcesEventsRequest = {
+  encode(message: GetResourcesEventsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.watch === true) {
+      writer.uint32(16).bool(message.watch);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesEventsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesEventsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.watch = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesEventsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      watch: isSet(object.watch) ? Boolean(object.watch) : false,
+    };
+  },
+
+  toJSON(message: GetResourcesEventsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.watch !== undefined && (obj.watch = message.watch);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesEventsRequest>, I>>(
+    object: I,
+  ): GetResourcesEventsRequest {
+    const message = createBaseGetResourcesEventsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.watch = object.watch ?? false;
+    return message;
+  },
+};
+
+function createBaseGetResourcesEventsResponse(): GetResourcesEventsResponse {
+  return {
+    resourceRef: undefined,
+    involvedObjectRef: undefined,
+    type: "",
+    reason: "",
+    message: "",
+    count: 0,
+    firstTimestamp: undefined,
+    lastTimestamp: undefined,
+    source: "",
+  };
+}
+
+export const GetResourcesEventsResponse = {
+  encode(
+    message: GetResourcesEventsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.involvedObjectRef !== undefined) {
+      ResourceRef.encode(message.involvedObjectRef, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.type !== "") {
+      writer.uint32(26).string(message.type);
+    }
+    if (message.reason !== "") {
+      writer.uint32(34).string(message.reason);
+    }
+    if (message.message !== "") {
+      writer.uint32(42).string(message.message);
+    }
+    if (message.count !== 0) {
+      writer.uint32(48).int32(message.count);
+    }
+    if (message.firstTimestamp !== undefined) {
+      Timestamp.encode(toTimestamp(message.firstTimestamp), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.lastTimestamp !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastTimestamp), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.source !== "") {
+      writer.uint32(74).string(message.source);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesEventsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesEventsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.involvedObjectRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.type = reader.string();
+          break;
+        case 4:
+          message.reason = reader.string();
+          break;
+        case 5:
+          message.message = reader.string();
+          break;
+        case 6:
+          message.count = reader.int32();
+          break;
+        case 7:
+          message.firstTimestamp = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 8:
+          message.lastTimestamp = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 9:
+          message.source = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesEventsResponse {
+    return {
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      involvedObjectRef: isSet(object.involvedObjectRef)
+        ? ResourceRef.fromJSON(object.involvedObjectRef)
+        : undefined,
+      type: isSet(object.type) ? String(object.type) : "",
+      reason: isSet(object.reason) ? String(object.reason) : "",
+      message: isSet(object.message) ? String(object.message) : "",
+      count: isSet(object.count) ? Number(object.count) : 0,
+      firstTimestamp: isSet(object.firstTimestamp)
+        ? fromJsonTimestamp(object.firstTimestamp)
+        : undefined,
+      lastTimestamp: isSet(object.lastTimestamp)
+        ? fromJsonTimestamp(object.lastTimestamp)
+        : undefined,
+      source: isSet(object.source) ? String(object.source) : "",
+    };
+  },
+
+  toJSON(message: GetResourcesEventsResponse): unknown {
+    const obj: any = {};
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.involvedObjectRef !== undefined &&
+      (obj.involvedObjectRef = message.involvedObjectRef
+        ? ResourceRef.toJSON(message.involvedObjectRef)
+        : undefined);
+    message.type !== undefined && (obj.type = message.type);
+    message.reason !== undefined && (obj.reason = message.reason);
+    message.message !== undefined && (obj.message = message.message);
+    message.count !== undefined && (obj.count = Math.round(message.count));
+    message.firstTimestamp !== undefined &&
+      (obj.firstTimestamp = message.firstTimestamp.toISOString());
+    message.lastTimestamp !== undefined &&
+      (obj.lastTimestamp = message.lastTimestamp.toISOString());
+    message.source !== undefined && (obj.source = message.source);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesEventsResponse>, I>>(
+    object: I,
+  ): GetResourcesEventsResponse {
+    const message = createBaseGetResourcesEventsResponse();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.involvedObjectRef =
+      object.involvedObjectRef !== undefined && object.involvedObjectRef !== null
+        ? ResourceRef.fromPartial(object.involvedObjectRef)
+        : undefined;
+    message.type = object.type ?? "";
+    message.reason = object.reason ?? "";
+    message.message = object.message ?? "";
+    message.count = object.count ?? 0;
+    message.firstTimestamp = object.firstTimestamp ?? undefined;
+    message.lastTimestamp = object.lastTimestamp ?? undefined;
+    message.source = object.source ?? "";
+    return message;
+  },
+};
+
+function createBaseGetResourcesTopologyRequest(): GetResourcesTopologyRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const GetResourcesTopologyRequest = {
+  encode(
+    message: GetResourcesTopologyRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesTopologyRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesTopologyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesTopologyRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetResourcesTopologyRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesTopologyRequest>, I>>(
+    object: I,
+  ): GetResourcesTopologyRequest {
+    const message = createBaseGetResourcesTopologyRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetResourcesTopologyResponse(): GetResourcesTopologyResponse {
+  return { resourceNodes: [] };
+}
+
+export const GetResourcesTopologyResponse = {
+  encode(
+    message: GetResourcesTopologyResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.resourceNodes) {
+      ResourceNode.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesTopologyResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetResourcesTopologyResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceNodes.push(ResourceNode.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetResourcesTopologyResponse {
+    return {
+      resourceNodes: Array.isArray(object?.resourceNodes)
+        ? object.resourceNodes.map((e: any) => ResourceNode.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GetResourcesTopologyResponse): unknown {
+    const obj: any = {};
+    if (message.resourceNodes) {
+      obj.resourceNodes = message.resourceNodes.map(e => (e ? ResourceNode.toJSON(e) : undefined));
+    } else {
+      obj.resourceNodes = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetResourcesTopologyResponse>, I>>(
+    object: I,
+  ): GetResourcesTopologyResponse {
+    const message = createBaseGetResourcesTopologyResponse();
+    message.resourceNodes = object.resourceNodes?.map(e => ResourceNode.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseResourceNode(): ResourceNode {
+  return { resourceRef: undefined, health: undefined, children: [] };
+}
+
+export const ResourceNode = {
+  encode(message: ResourceNode, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.health !== undefined) {
+      ResourceHealth.encode(message.health, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.children) {
+      ResourceNode.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceNode {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceNode();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.health = ResourceHealth.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.children.push(ResourceNode.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceNode {
+    return {
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      health: isSet(object.health) ? ResourceHealth.fromJSON(object.health) : undefined,
+      children: Array.isArray(object?.children)
+        ? object.children.map((e: any) => ResourceNode.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ResourceNode): unknown {
+    const obj: any = {};
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.health !== undefined &&
+      (obj.health = message.health ? ResourceHealth.toJSON(message.health) : undefined);
+    if (message.children) {
+      obj.children = message.children.map(e => (e ? ResourceNode.toJSON(e) : undefined));
+    } else {
+      obj.children = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResourceNode>, I>>(object: I): ResourceNode {
+    const message = createBaseResourceNode();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.health =
+      object.health !== undefined && object.health !== null
+        ? ResourceHealth.fromPartial(object.health)
+        : undefined;
+    message.children = object.children?.map(e => ResourceNode.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseScaleResourceRequest(): ScaleResourceRequest {
+  return {
+    installedPackageRef: undefined,
+    resourceRef: undefined,
+    replicas: 0,
+  };
+}
+
+export const ScaleResourceRequest = {
+  encode(message: ScaleResourceRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.installedPackageRef !== undefined) {
       InstalledPackageReference.encode(
         message.installedPackageRef,
         writer.uint32(10).fork(),
       ).ldelim();
     }
-    for (const v of message.resourceRefs) {
-      ResourceRef.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(18).fork()).ldelim();
     }
-    if (message.watch === true) {
-      writer.uint32(24).bool(message.watch);
+    if (message.replicas !== 0) {
+      writer.uint32(24).int32(message.replicas);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ScaleResourceRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetResourcesRequest();
+    const message = createBaseScaleResourceRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -352,10 +2423,10 @@ export const GetResourcesRequest = {
           message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
         case 2:
-          message.resourceRefs.push(ResourceRef.decode(reader, reader.uint32()));
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
           break;
         case 3:
-          message.watch = reader.bool();
+          message.replicas = reader.int32();
           break;
         default:
           reader.skipType(tag & 7);
@@ -365,66 +2436,285 @@ export const GetResourcesRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetResourcesRequest {
+  fromJSON(object: any): ScaleResourceRequest {
     return {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
         : undefined,
-      resourceRefs: Array.isArray(object?.resourceRefs)
-        ? object.resourceRefs.map((e: any) => ResourceRef.fromJSON(e))
-        : [],
-      watch: isSet(object.watch) ? Boolean(object.watch) : false,
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      replicas: isSet(object.replicas) ? Number(object.replicas) : 0,
+    };
+  },
+
+  toJSON(message: ScaleResourceRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.replicas !== undefined && (obj.replicas = Math.round(message.replicas));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ScaleResourceRequest>, I>>(
+    object: I,
+  ): ScaleResourceRequest {
+    const message = createBaseScaleResourceRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.replicas = object.replicas ?? 0;
+    return message;
+  },
+};
+
+function createBaseScaleResourceResponse(): ScaleResourceResponse {
+  return { state: undefined };
+}
+
+export const ScaleResourceResponse = {
+  encode(message: ScaleResourceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.state !== undefined) {
+      WorkloadState.encode(message.state, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ScaleResourceResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseScaleResourceResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.state = WorkloadState.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ScaleResourceResponse {
+    return {
+      state: isSet(object.state) ? WorkloadState.fromJSON(object.state) : undefined,
+    };
+  },
+
+  toJSON(message: ScaleResourceResponse): unknown {
+    const obj: any = {};
+    message.state !== undefined &&
+      (obj.state = message.state ? WorkloadState.toJSON(message.state) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ScaleResourceResponse>, I>>(
+    object: I,
+  ): ScaleResourceResponse {
+    const message = createBaseScaleResourceResponse();
+    message.state =
+      object.state !== undefined && object.state !== null
+        ? WorkloadState.fromPartial(object.state)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseRestartResourceRequest(): RestartResourceRequest {
+  return { installedPackageRef: undefined, resourceRef: undefined };
+}
+
+export const RestartResourceRequest = {
+  encode(message: RestartResourceRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RestartResourceRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRestartResourceRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RestartResourceRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+    };
+  },
+
+  toJSON(message: RestartResourceRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RestartResourceRequest>, I>>(
+    object: I,
+  ): RestartResourceRequest {
+    const message = createBaseRestartResourceRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseRestartResourceResponse(): RestartResourceResponse {
+  return { state: undefined, restartedAt: undefined };
+}
+
+export const RestartResourceResponse = {
+  encode(message: RestartResourceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.state !== undefined) {
+      WorkloadState.encode(message.state, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.restartedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.restartedAt), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RestartResourceResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRestartResourceResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.state = WorkloadState.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.restartedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RestartResourceResponse {
+    return {
+      state: isSet(object.state) ? WorkloadState.fromJSON(object.state) : undefined,
+      restartedAt: isSet(object.restartedAt) ? fromJsonTimestamp(object.restartedAt) : undefined,
     };
   },
 
-  toJSON(message: GetResourcesRequest): unknown {
+  toJSON(message: RestartResourceResponse): unknown {
     const obj: any = {};
-    message.installedPackageRef !== undefined &&
-      (obj.installedPackageRef = message.installedPackageRef
-        ? InstalledPackageReference.toJSON(message.installedPackageRef)
-        : undefined);
-    if (message.resourceRefs) {
-      obj.resourceRefs = message.resourceRefs.map(e => (e ? ResourceRef.toJSON(e) : undefined));
-    } else {
-      obj.resourceRefs = [];
-    }
-    message.watch !== undefined && (obj.watch = message.watch);
+    message.state !== undefined &&
+      (obj.state = message.state ? WorkloadState.toJSON(message.state) : undefined);
+    message.restartedAt !== undefined && (obj.restartedAt = message.restartedAt.toISOString());
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetResourcesRequest>, I>>(
+  fromPartial<I extends Exact<DeepPartial<RestartResourceResponse>, I>>(
     object: I,
-  ): GetResourcesRequest {
-    const message = createBaseGetResourcesRequest();
-    message.installedPackageRef =
-      object.installedPackageRef !== undefined && object.installedPackageRef !== null
-        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+  ): RestartResourceResponse {
+    const message = createBaseRestartResourceResponse();
+    message.state =
+      object.state !== undefined && object.state !== null
+        ? WorkloadState.fromPartial(object.state)
         : undefined;
-    message.resourceRefs = object.resourceRefs?.map(e => ResourceRef.fromPartial(e)) || [];
-    message.watch = object.watch ?? false;
+    message.restartedAt = object.restartedAt ?? undefined;
     return message;
   },
 };
 
-function createBaseGetResourcesResponse(): GetResourcesResponse {
-  return { resourceRef: undefined, manifest: "" };
+function createBaseWorkloadState(): WorkloadState {
+  return {
+    resourceRef: undefined,
+    desiredReplicas: 0,
+    replicas: 0,
+    readyReplicas: 0,
+    updatedReplicas: 0,
+    generation: 0,
+    observedGeneration: 0,
+    health: undefined,
+  };
 }
 
-export const GetResourcesResponse = {
-  encode(message: GetResourcesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const WorkloadState = {
+  encode(message: WorkloadState, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.resourceRef !== undefined) {
       ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
     }
-    if (message.manifest !== "") {
-      writer.uint32(18).string(message.manifest);
+    if (message.desiredReplicas !== 0) {
+      writer.uint32(16).int32(message.desiredReplicas);
+    }
+    if (message.replicas !== 0) {
+      writer.uint32(24).int32(message.replicas);
+    }
+    if (message.readyReplicas !== 0) {
+      writer.uint32(32).int32(message.readyReplicas);
+    }
+    if (message.updatedReplicas !== 0) {
+      writer.uint32(40).int32(message.updatedReplicas);
+    }
+    if (message.generation !== 0) {
+      writer.uint32(48).int64(message.generation);
+    }
+    if (message.observedGeneration !== 0) {
+      writer.uint32(56).int64(message.observedGeneration);
+    }
+    if (message.health !== undefined) {
+      ResourceHealth.encode(message.health, writer.uint32(66).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetResourcesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorkloadState {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetResourcesResponse();
+    const message = createBaseWorkloadState();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -432,7 +2722,25 @@ export const GetResourcesResponse = {
           message.resourceRef = ResourceRef.decode(reader, reader.uint32());
           break;
         case 2:
-          message.manifest = reader.string();
+          message.desiredReplicas = reader.int32();
+          break;
+        case 3:
+          message.replicas = reader.int32();
+          break;
+        case 4:
+          message.readyReplicas = reader.int32();
+          break;
+        case 5:
+          message.updatedReplicas = reader.int32();
+          break;
+        case 6:
+          message.generation = longToNumber(reader.int64() as Long);
+          break;
+        case 7:
+          message.observedGeneration = longToNumber(reader.int64() as Long);
+          break;
+        case 8:
+          message.health = ResourceHealth.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -442,30 +2750,53 @@ export const GetResourcesResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetResourcesResponse {
+  fromJSON(object: any): WorkloadState {
     return {
       resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
-      manifest: isSet(object.manifest) ? String(object.manifest) : "",
+      desiredReplicas: isSet(object.desiredReplicas) ? Number(object.desiredReplicas) : 0,
+      replicas: isSet(object.replicas) ? Number(object.replicas) : 0,
+      readyReplicas: isSet(object.readyReplicas) ? Number(object.readyReplicas) : 0,
+      updatedReplicas: isSet(object.updatedReplicas) ? Number(object.updatedReplicas) : 0,
+      generation: isSet(object.generation) ? Number(object.generation) : 0,
+      observedGeneration: isSet(object.observedGeneration) ? Number(object.observedGeneration) : 0,
+      health: isSet(object.health) ? ResourceHealth.fromJSON(object.health) : undefined,
     };
   },
 
-  toJSON(message: GetResourcesResponse): unknown {
+  toJSON(message: WorkloadState): unknown {
     const obj: any = {};
     message.resourceRef !== undefined &&
       (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
-    message.manifest !== undefined && (obj.manifest = message.manifest);
+    message.desiredReplicas !== undefined &&
+      (obj.desiredReplicas = Math.round(message.desiredReplicas));
+    message.replicas !== undefined && (obj.replicas = Math.round(message.replicas));
+    message.readyReplicas !== undefined && (obj.readyReplicas = Math.round(message.readyReplicas));
+    message.updatedReplicas !== undefined &&
+      (obj.updatedReplicas = Math.round(message.updatedReplicas));
+    message.generation !== undefined && (obj.generation = Math.round(message.generation));
+    message.observedGeneration !== undefined &&
+      (obj.observedGeneration = Math.round(message.observedGeneration));
+    message.health !== undefined &&
+      (obj.health = message.health ? ResourceHealth.toJSON(message.health) : undefined);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetResourcesResponse>, I>>(
-    object: I,
-  ): GetResourcesResponse {
-    const message = createBaseGetResourcesResponse();
+  fromPartial<I extends Exact<DeepPartial<WorkloadState>, I>>(object: I): WorkloadState {
+    const message = createBaseWorkloadState();
     message.resourceRef =
       object.resourceRef !== undefined && object.resourceRef !== null
         ? ResourceRef.fromPartial(object.resourceRef)
         : undefined;
-    message.manifest = object.manifest ?? "";
+    message.desiredReplicas = object.desiredReplicas ?? 0;
+    message.replicas = object.replicas ?? 0;
+    message.readyReplicas = object.readyReplicas ?? 0;
+    message.updatedReplicas = object.updatedReplicas ?? 0;
+    message.generation = object.generation ?? 0;
+    message.observedGeneration = object.observedGeneration ?? 0;
+    message.health =
+      object.health !== undefined && object.health !== null
+        ? ResourceHealth.fromPartial(object.health)
+        : undefined;
     return message;
   },
 };
@@ -1319,6 +3650,64 @@ export interface ResourcesService {
     request: DeepPartial<GetResourcesRequest>,
     metadata?: grpc.Metadata,
   ): Observable<GetResourcesResponse>;
+  /**
+   * GetResourcesDrift compares the resources of an installed package as
+   * rendered with their live state, to find the resources which were edited
+   * or deleted on the cluster since they were deployed.
+   */
+  GetResourcesDrift(
+    request: DeepPartial<GetResourcesDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetResourcesDriftResponse>;
+  /**
+   * GetResourcesHealth rolls the health of the resources of an installed
+   * package up into a single summary.
+   */
+  GetResourcesHealth(
+    request: DeepPartial<GetResourcesHealthRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetResourcesHealthResponse>;
+  /**
+   * GetPodLogs streams the logs of the containers of the pods owned by the
+   * resources of an installed package.
+   */
+  GetPodLogs(
+    request: DeepPartial<GetPodLogsRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetPodLogsResponse>;
+  /**
+   * GetResourcesEvents returns, and optionally watches, the Kubernetes
+   * events of the resources of an installed package and of their pods and
+   * ReplicaSets.
+   */
+  GetResourcesEvents(
+    request: DeepPartial<GetResourcesEventsRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetResourcesEventsResponse>;
+  /**
+   * GetResourcesTopology returns the resources of an installed package as
+   * trees of their dependents, found through their owner references.
+   */
+  GetResourcesTopology(
+    request: DeepPartial<GetResourcesTopologyRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetResourcesTopologyResponse>;
+  /**
+   * ScaleResource sets the replicas of a Deployment or StatefulSet of an
+   * installed package.
+   */
+  ScaleResource(
+    request: DeepPartial<ScaleResourceRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ScaleResourceResponse>;
+  /**
+   * RestartResource triggers a rollout restart of a Deployment, StatefulSet
+   * or DaemonSet of an installed package, as "kubectl rollout restart" does.
+   */
+  RestartResource(
+    request: DeepPartial<RestartResourceRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RestartResourceResponse>;
   GetServiceAccountNames(
     request: DeepPartial<GetServiceAccountNamesRequest>,
     metadata?: grpc.Metadata,
@@ -1351,6 +3740,13 @@ export class ResourcesServiceClientImpl implements ResourcesService {
   constructor(rpc: Rpc) {
     this.rpc = rpc;
     this.GetResources = this.GetResources.bind(this);
+    this.GetResourcesDrift = this.GetResourcesDrift.bind(this);
+    this.GetResourcesHealth = this.GetResourcesHealth.bind(this);
+    this.GetPodLogs = this.GetPodLogs.bind(this);
+    this.GetResourcesEvents = this.GetResourcesEvents.bind(this);
+    this.GetResourcesTopology = this.GetResourcesTopology.bind(this);
+    this.ScaleResource = this.ScaleResource.bind(this);
+    this.RestartResource = this.RestartResource.bind(this);
     this.GetServiceAccountNames = this.GetServiceAccountNames.bind(this);
     this.GetNamespaceNames = this.GetNamespaceNames.bind(this);
     this.CreateNamespace = this.CreateNamespace.bind(this);
@@ -1370,6 +3766,83 @@ export class ResourcesServiceClientImpl implements ResourcesService {
     );
   }
 
+  GetResourcesDrift(
+    request: DeepPartial<GetResourcesDriftRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetResourcesDriftResponse> {
+    return this.rpc.unary(
+      ResourcesServiceGetResourcesDriftDesc,
+      GetResourcesDriftRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetResourcesHealth(
+    request: DeepPartial<GetResourcesHealthRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetResourcesHealthResponse> {
+    return this.rpc.unary(
+      ResourcesServiceGetResourcesHealthDesc,
+      GetResourcesHealthRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetPodLogs(
+    request: DeepPartial<GetPodLogsRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetPodLogsResponse> {
+    return this.rpc.invoke(
+      ResourcesServiceGetPodLogsDesc,
+      GetPodLogsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetResourcesEvents(
+    request: DeepPartial<GetResourcesEventsRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetResourcesEventsResponse> {
+    return this.rpc.invoke(
+      ResourcesServiceGetResourcesEventsDesc,
+      GetResourcesEventsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetResourcesTopology(
+    request: DeepPartial<GetResourcesTopologyRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetResourcesTopologyResponse> {
+    return this.rpc.unary(
+      ResourcesServiceGetResourcesTopologyDesc,
+      GetResourcesTopologyRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ScaleResource(
+    request: DeepPartial<ScaleResourceRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ScaleResourceResponse> {
+    return this.rpc.unary(
+      ResourcesServiceScaleResourceDesc,
+      ScaleResourceRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RestartResource(
+    request: DeepPartial<RestartResourceRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RestartResourceResponse> {
+    return this.rpc.unary(
+      ResourcesServiceRestartResourceDesc,
+      RestartResourceRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetServiceAccountNames(
     request: DeepPartial<GetServiceAccountNamesRequest>,
     metadata?: grpc.Metadata,
@@ -1463,6 +3936,160 @@ export const ResourcesServiceGetResourcesDesc: UnaryMethodDefinitionish = {
   } as any,
 };
 
+export const ResourcesServiceGetResourcesDriftDesc: UnaryMethodDefinitionish = {
+  methodName: "GetResourcesDrift",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetResourcesDriftRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetResourcesDriftResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ResourcesServiceGetResourcesHealthDesc: UnaryMethodDefinitionish = {
+  methodName: "GetResourcesHealth",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetResourcesHealthRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetResourcesHealthResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ResourcesServiceGetPodLogsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetPodLogs",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return GetPodLogsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetPodLogsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ResourcesServiceGetResourcesEventsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetResourcesEvents",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return GetResourcesEventsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetResourcesEventsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ResourcesServiceGetResourcesTopologyDesc: UnaryMethodDefinitionish = {
+  methodName: "GetResourcesTopology",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetResourcesTopologyRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetResourcesTopologyResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ResourcesServiceScaleResourceDesc: UnaryMethodDefinitionish = {
+  methodName: "ScaleResource",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ScaleResourceRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ScaleResourceResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ResourcesServiceRestartResourceDesc: UnaryMethodDefinitionish = {
+  methodName: "RestartResource",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RestartResourceRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RestartResourceResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const ResourcesServiceGetServiceAccountNamesDesc: UnaryMethodDefinitionish = {
   methodName: "GetServiceAccountNames",
   service: ResourcesServiceDesc,
@@ -1713,6 +4340,17 @@ export class GrpcWebImpl {
   }
 }
 
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var globalThis: any = (() => {
+  if (typeof globalThis !== "undefined") return globalThis;
+  if (typeof self !== "undefined") return self;
+  if (typeof window !== "undefined") return window;
+  if (typeof global !== "undefined") return global;
+  throw "Unable to locate global object";
+})();
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin
@@ -1730,6 +4368,35 @@ export type Exact<P, I extends P> = P extends Builtin
   ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & Record<Exclude<keyof I, KeysOfUnion<P>>, never>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = t.seconds * 1_000;
+  millis += t.nanos / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
@@ -8,12 +8,12 @@ import {
   DeleteInstalledPackageResponse,
   GetInstalledPackageResourceRefsResponse,
   InstalledPackageReference,
+  RollbackInstalledPackageResponse,
   UpdateInstalledPackageResponse,
   VersionReference,
 } from "gen/kubeappsapis/core/packages/v1alpha1/packages";
 import { KubeappsGrpcClient } from "./KubeappsGrpcClient";
 import { Plugin } from "gen/kubeappsapis/core/plugins/v1alpha1/plugins";
-import { RollbackInstalledPackageResponse } from "gen/kubeappsapis/plugins/helm/packages/v1alpha1/helm";
 import { InstalledPackage } from "./InstalledPackage";
 import { PluginNames } from "./utils";
 
@@ -152,7 +152,7 @@ describe("InstalledPackage", () => {
             identifier: "foo",
             plugin: { name: PluginNames.PACKAGES_HELM, version: "0.0.1" } as Plugin,
           } as InstalledPackageReference,
-          releaseRevision: 1,
+          revision: 1,
         },
       },
     ].forEach(t => {
@@ -160,11 +160,11 @@ describe("InstalledPackage", () => {
         const mockRollbackInstalledPackage = jest
           .fn()
           .mockImplementation(() => Promise.resolve({} as RollbackInstalledPackageResponse));
-        setMockHelmClient("RollbackInstalledPackage", mockRollbackInstalledPackage);
+        setMockCoreClient("RollbackInstalledPackage", mockRollbackInstalledPackage);
 
         const res = await InstalledPackage.RollbackInstalledPackage(
           t.args.installedPackageRef,
-          t.args.releaseRevision,
+          t.args.revision,
         );
 
         expect(res).toStrictEqual({} as RollbackInstalledPackageResponse);
@@ -207,11 +207,3 @@ function setMockCoreClient(fnToMock: any, mockFn: jest.Mock<any, any>) {
   jest.spyOn(mockClient, fnToMock).mockImplementation(mockFn);
   jest.spyOn(InstalledPackage, "coreClient").mockImplementation(() => mockClient);
 }
-
-function setMockHelmClient(fnToMock: any, mockFn: jest.Mock<any, any>) {
-  // Replace the specified function on the real KubeappsGrpcClient's
-  // helm packages service implementation.
-  const mockClient = new KubeappsGrpcClient().getHelmPackagesServiceClientImpl();
-  jest.spyOn(mockClient, fnToMock).mockImplementation(mockFn);
-  jest.spyOn(InstalledPackage, "helmPluginClient").mockImplementation(() => mockClient);
-}
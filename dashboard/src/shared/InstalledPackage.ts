@@ -8,20 +8,16 @@ import {
   DeleteInstalledPackageRequest,
   InstalledPackageReference,
   ReconciliationOptions,
+  RollbackInstalledPackageRequest,
+  RollbackInstalledPackageResponse,
   UpdateInstalledPackageRequest,
   VersionReference,
 } from "gen/kubeappsapis/core/packages/v1alpha1/packages";
-import {
-  RollbackInstalledPackageRequest,
-  RollbackInstalledPackageResponse,
-} from "gen/kubeappsapis/plugins/helm/packages/v1alpha1/helm";
 import { KubeappsGrpcClient } from "./KubeappsGrpcClient";
 import { getPluginsSupportingRollback } from "./utils";
 
 export class InstalledPackage {
   public static coreClient = () => new KubeappsGrpcClient().getPackagesServiceClientImpl();
-  public static helmPluginClient = () =>
-    new KubeappsGrpcClient().getHelmPackagesServiceClientImpl();
 
   public static async GetInstalledPackageSummaries(
     cluster: string,
@@ -81,16 +77,16 @@ export class InstalledPackage {
 
   public static async RollbackInstalledPackage(
     installedPackageRef: InstalledPackageReference,
-    releaseRevision: number,
+    revision: number,
   ) {
     // rollbackInstalledPackage is currently only available for Helm packages
     if (
       installedPackageRef?.plugin?.name &&
       getPluginsSupportingRollback().includes(installedPackageRef.plugin.name)
     ) {
-      return await this.helmPluginClient().RollbackInstalledPackage({
+      return await this.coreClient().RollbackInstalledPackage({
         installedPackageRef,
-        releaseRevision,
+        revision,
       } as RollbackInstalledPackageRequest);
     } else {
       return {} as RollbackInstalledPackageResponse;
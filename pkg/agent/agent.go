@@ -154,6 +154,51 @@ func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
 	return res, nil
 }
 
+// PreviewCreateRelease renders the release which would be created by
+// CreateRelease without installing anything on the cluster.
+func PreviewCreateRelease(actionConfig *action.Configuration, name, namespace, valueString string,
+	ch *chart.Chart, registrySecrets map[string]string) (*release.Release, error) {
+	cmd, err := newInstallCommand(actionConfig, name, namespace, registrySecrets, 0)
+	if err != nil {
+		return nil, err
+	}
+	cmd.DryRun = true
+	values, err := getValues([]byte(valueString))
+	if err != nil {
+		return nil, err
+	}
+	release, err := cmd.Run(ch, values)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to render the release %q: %v", name, err)
+	}
+	return release, nil
+}
+
+// PreviewUpgradeRelease renders the release which would result from
+// UpgradeRelease without upgrading anything on the cluster.
+func PreviewUpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
+	ch *chart.Chart, registrySecrets map[string]string) (*release.Release, error) {
+	_, err := GetRelease(actionConfig, name)
+	if err != nil {
+		return nil, err
+	}
+	cmd := action.NewUpgrade(actionConfig)
+	cmd.DryRun = true
+	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	if err != nil {
+		return nil, err
+	}
+	values, err := chartutil.ReadValues([]byte(valuesYaml))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to render the release because values could not be parsed: %v", err)
+	}
+	res, err := cmd.Run(name, ch, values)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to render the release: %v", err)
+	}
+	return res, nil
+}
+
 // RollbackRelease rolls back a release to the specified revision.
 func RollbackRelease(actionConfig *action.Configuration, releaseName string, revision int, timeoutSeconds int32) (*release.Release, error) {
 	log.Infof("Rolling back %s to revision %d.", releaseName, revision)
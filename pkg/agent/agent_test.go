@@ -700,6 +700,86 @@ func TestUpgradeRelease(t *testing.T) {
 	}
 }
 
+func TestPreviewReleases(t *testing.T) {
+	const existingRevision = 1
+	testCases := []struct {
+		description      string
+		releases         []releaseStub
+		release          string
+		valuesYaml       string
+		expectedManifest string
+		shouldFail       bool
+	}{
+		{
+			description:      "previews the creation of a release",
+			release:          "myrls",
+			valuesYaml:       "data: baz",
+			expectedManifest: "---\n# Source: mychart/templates/configmap.yaml\nkind: ConfigMap\ndata: baz\n",
+		},
+		{
+			description: "previews the upgrade of a release",
+			releases: []releaseStub{
+				{"myrls", "default", existingRevision, "mychart", release.StatusDeployed},
+			},
+			release:          "myrls",
+			valuesYaml:       "data: baz",
+			expectedManifest: "---\n# Source: mychart/templates/configmap.yaml\nkind: ConfigMap\ndata: baz\n",
+		},
+		{
+			description: "fails to preview with invalid values",
+			releases: []releaseStub{
+				{"myrls", "default", existingRevision, "mychart", release.StatusDeployed},
+			},
+			release:    "myrls",
+			valuesYaml: "\\-xx-@myval:\"test value\"\\\n",
+			shouldFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			makeReleases(t, cfg, tc.releases)
+			ch := &chart.Chart{
+				Metadata: &chart.Metadata{
+					APIVersion: "v2",
+					Name:       "mychart",
+					Version:    "1.0.0",
+				},
+				Templates: []*chart.File{
+					{Name: "templates/configmap.yaml", Data: []byte("kind: ConfigMap\ndata: {{ .Values.data }}")},
+				},
+			}
+
+			var rel *release.Release
+			var err error
+			if len(tc.releases) == 0 {
+				rel, err = PreviewCreateRelease(cfg, tc.release, "default", tc.valuesYaml, ch, nil)
+			} else {
+				rel, err = PreviewUpgradeRelease(cfg, tc.release, tc.valuesYaml, ch, nil)
+			}
+			if got, want := err != nil, tc.shouldFail; got != want {
+				t.Fatalf("Failure: got: %v, want: %v, err: %+v", got, want, err)
+			}
+			if tc.shouldFail {
+				return
+			}
+			if got, want := rel.Manifest, tc.expectedManifest; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			// Nothing is stored by a preview.
+			history, err := cfg.Releases.History(tc.release)
+			if err != nil && err != driver.ErrReleaseNotFound {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(history), len(tc.releases); got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
 func TestNewConfigFlagsFromCluster(t *testing.T) {
 	testCases := []struct {
 		name   string
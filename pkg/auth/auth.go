@@ -102,6 +102,15 @@ func NewAuth(token, clusterName string, clustersConfig kube.ClustersConfig) (*Us
 	return &UserAuth{k8sAuthCli}, nil
 }
 
+// NewAuthForClient creates an auth agent checking the permissions of the user
+// whose credentials are used by the given client.
+func NewAuthForClient(kubeClient kubernetes.Interface) *UserAuth {
+	return &UserAuth{k8sAuth{
+		AuthCli:      kubeClient.AuthorizationV1(),
+		DiscoveryCli: kubeClient.Discovery(),
+	}}
+}
+
 // ValidateForNamespace checks if the user can access secrets in the given
 // namespace, as a check of whether they can view the namespace.
 func (u *UserAuth) ValidateForNamespace(namespace string) (bool, error) {
@@ -221,10 +230,11 @@ func reduceActionsByVerb(actions []Action) []Action {
 		if _, ok := resMap[req]; ok {
 			// Element already exists
 			resMap[req] = Action{
-				APIVersion: action.APIVersion,
-				Resource:   action.Resource,
-				Namespace:  action.Namespace,
-				Verbs:      uniqVerbs(resMap[req].Verbs, action.Verbs),
+				APIVersion:  action.APIVersion,
+				Resource:    action.Resource,
+				Namespace:   action.Namespace,
+				ClusterWide: action.ClusterWide,
+				Verbs:       uniqVerbs(resMap[req].Verbs, action.Verbs),
 			}
 		} else {
 			resMap[req] = action
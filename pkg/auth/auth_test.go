@@ -170,6 +170,17 @@ kind: ClusterRoleBinding
 				{APIVersion: "rbac.authorization.k8s.io/v1", Resource: "clusterrolebindings", ClusterWide: true, Verbs: []string{"get"}},
 			},
 		},
+		{
+			Name:   "it should report if a resource is clusterWide when upgrading",
+			Action: "upgrade",
+			Manifest: `---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+`,
+			ExpectedActions: []Action{
+				{APIVersion: "rbac.authorization.k8s.io/v1", Resource: "clusterrolebindings", ClusterWide: true, Verbs: []string{"create", "update", "delete"}},
+			},
+		},
 		{
 			Name:   "it should allow an unrecognized resource, so that CRDs can be installed before CRs",
 			Action: "get",
@@ -12,7 +12,10 @@ import (
 )
 
 // ChartClient implements Resolver inteface
-type ChartClient struct{}
+type ChartClient struct {
+	schema    []byte
+	templates []*chart.File
+}
 
 // GetChart fake
 func (f *ChartClient) GetChart(details *chartUtils.Details, repoURL string) (*chart.Chart, error) {
@@ -25,7 +28,9 @@ func (f *ChartClient) GetChart(details *chartUtils.Details, repoURL string) (*ch
 			Name:    details.ChartName,
 			Version: details.Version,
 		},
-		Values: vals,
+		Values:    vals,
+		Schema:    f.schema,
+		Templates: f.templates,
 	}, nil
 }
 
@@ -44,9 +49,14 @@ func (f *ChartClient) Init(appRepo *appRepov1.AppRepository, caCertSecret *corev
 }
 
 // ChartClientFactory is a fake implementation of the ChartClientFactory interface.
-type ChartClientFactory struct{}
+type ChartClientFactory struct {
+	// Schema is the values schema of the charts returned by the clients.
+	Schema []byte
+	// Templates are the templates of the charts returned by the clients.
+	Templates []*chart.File
+}
 
 // New returns a fake ChartClient
 func (c *ChartClientFactory) New(repoType, userAgent string) chartUtils.ChartClient {
-	return &ChartClient{}
+	return &ChartClient{schema: c.Schema, templates: c.Templates}
 }
@@ -0,0 +1,63 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package versionfilter matches package and app versions against the version
+// filters of the available packages requests.
+package versionfilter
+
+import (
+	"github.com/Masterminds/semver/v3"
+	chart "github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+)
+
+// Filter matches versions against a semver constraint expression, such as
+// ">=1.2 <2" or "~5.4", or against an exact version when the expression is
+// not a valid constraint (for instance, an app version such as "latest").
+// A nil Filter matches any version.
+type Filter struct {
+	expression string
+	constraint *semver.Constraints
+}
+
+// New returns the filter for the expression, or nil if it is empty.
+func New(expression string) *Filter {
+	if expression == "" {
+		return nil
+	}
+	f := &Filter{expression: expression}
+	if constraint, err := semver.NewConstraint(expression); err == nil {
+		f.constraint = constraint
+	}
+	return f
+}
+
+// Matches returns whether the version matches the filter. Versions which are
+// not semver only match a filter with the same exact version.
+func (f *Filter) Matches(version string) bool {
+	if f == nil || version == f.expression {
+		return true
+	}
+	if f.constraint == nil {
+		return false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return f.constraint.Check(v)
+}
+
+// ChartVersions returns the chart versions whose version matches pkgVersion
+// and whose app version matches appVersion, in the same order.
+func ChartVersions(versions []chart.ChartVersion, pkgVersion, appVersion *Filter) []chart.ChartVersion {
+	if pkgVersion == nil && appVersion == nil {
+		return versions
+	}
+	matching := []chart.ChartVersion{}
+	for _, v := range versions {
+		if pkgVersion.Matches(v.Version) && appVersion.Matches(v.AppVersion) {
+			matching = append(matching, v)
+		}
+	}
+	return matching
+}
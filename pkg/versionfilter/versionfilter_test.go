@@ -0,0 +1,86 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package versionfilter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	chart "github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+)
+
+func TestMatches(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		version    string
+		expected   bool
+	}{
+		{"an empty filter matches any version", "", "1.2.3", true},
+		{"an exact version matches", "1.2.3", "1.2.3", true},
+		{"an exact version does not match another version", "1.2.3", "1.2.4", false},
+		{"a range matches a version within it", ">=1.2 <2", "1.9.0", true},
+		{"a range does not match a version outside it", ">=1.2 <2", "2.0.0", false},
+		{"a tilde constraint matches a patch version", "~5.4", "5.4.7", true},
+		{"a tilde constraint does not match a minor version", "~5.4", "5.5.0", false},
+		{"a constraint matches a version with a v prefix", "<12", "v11.14.0", true},
+		{"a constraint does not match a version which is not semver", "<12", "latest", false},
+		{"a non-semver expression matches the exact version", "latest", "latest", true},
+		{"a non-semver expression does not match another version", "1.0chart", "1.0", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := New(tc.expression).Matches(tc.version), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestChartVersions(t *testing.T) {
+	versions := []chart.ChartVersion{
+		{Version: "11.0.0", AppVersion: "14.4.0"},
+		{Version: "10.1.0", AppVersion: "11.14.0"},
+		{Version: "10.0.0", AppVersion: "11.13.0"},
+		{Version: "9.0.0", AppVersion: "10.0.0"},
+	}
+
+	testCases := []struct {
+		name             string
+		pkgVersion       string
+		appVersion       string
+		expectedVersions []chart.ChartVersion
+	}{
+		{
+			name:             "it returns all the versions without filters",
+			expectedVersions: versions,
+		},
+		{
+			name:             "it returns the versions matching the app version",
+			appVersion:       "<12",
+			expectedVersions: versions[1:],
+		},
+		{
+			name:             "it returns the versions matching both filters",
+			pkgVersion:       "^10",
+			appVersion:       "<12",
+			expectedVersions: versions[1:3],
+		},
+		{
+			name:             "it returns no versions when none match",
+			pkgVersion:       ">=12",
+			expectedVersions: []chart.ChartVersion{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ChartVersions(versions, New(tc.pkgVersion), New(tc.appVersion))
+			if want := tc.expectedVersions; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}